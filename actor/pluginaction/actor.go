@@ -5,6 +5,9 @@ package pluginaction
 type Actor struct {
 	config Config
 	client PluginClient
+
+	cacheRepositories bool
+	offline           bool
 }
 
 // NewActor returns a pluginaction Actor
@@ -0,0 +1,163 @@
+package pluginaction
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// PluginArchitectureMismatchError is returned when a plugin binary was built
+// for a different operating system or architecture than the one the CLI is
+// running on.
+type PluginArchitectureMismatchError struct {
+	Path                 string
+	ExpectedArchitecture string
+	ActualArchitecture   string
+}
+
+func (e PluginArchitectureMismatchError) Error() string {
+	return fmt.Sprintf("Plugin binary %s is built for %s, but this system requires %s.", e.Path, e.ActualArchitecture, e.ExpectedArchitecture)
+}
+
+// binaryArchitecture is the executable format and architectures read from a
+// binary's header. Universal Mach-O binaries contain more than one
+// architecture.
+type binaryArchitecture struct {
+	format string
+	archs  []string
+}
+
+func (a binaryArchitecture) String() string {
+	return fmt.Sprintf("%s %s", a.format, strings.Join(a.archs, "/"))
+}
+
+// ValidatePluginArchitecture reads the ELF, Mach-O or PE header of the binary
+// at path and returns a PluginArchitectureMismatchError when the binary cannot
+// run on runtimeGOOS/runtimeGOARCH. Files that cannot be read or are not in
+// one of these formats are not validated.
+func (Actor) ValidatePluginArchitecture(path string, runtimeGOOS string, runtimeGOARCH string) error {
+	actual, ok := readBinaryArchitecture(path)
+	if !ok {
+		return nil
+	}
+
+	expected := binaryArchitecture{format: executableFormat(runtimeGOOS), archs: []string{runtimeGOARCH}}
+	if actual.format == expected.format {
+		for _, arch := range actual.archs {
+			if archCompatible(arch, runtimeGOOS, runtimeGOARCH) {
+				return nil
+			}
+		}
+	}
+
+	return PluginArchitectureMismatchError{
+		Path:                 path,
+		ExpectedArchitecture: expected.String(),
+		ActualArchitecture:   actual.String(),
+	}
+}
+
+func readBinaryArchitecture(path string) (binaryArchitecture, bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return binaryArchitecture{}, false
+	}
+	defer file.Close()
+
+	if elfFile, err := elf.NewFile(file); err == nil {
+		return binaryArchitecture{format: "ELF", archs: []string{elfArch(elfFile.Machine)}}, true
+	}
+
+	if machoFile, err := macho.NewFile(file); err == nil {
+		return binaryArchitecture{format: "Mach-O", archs: []string{machoArch(machoFile.Cpu)}}, true
+	}
+
+	if fatFile, err := macho.NewFatFile(file); err == nil {
+		var archs []string
+		for _, arch := range fatFile.Arches {
+			archs = append(archs, machoArch(arch.Cpu))
+		}
+		return binaryArchitecture{format: "Mach-O", archs: archs}, true
+	}
+
+	if peFile, err := pe.NewFile(file); err == nil {
+		return binaryArchitecture{format: "PE", archs: []string{peArch(peFile.Machine)}}, true
+	}
+
+	return binaryArchitecture{}, false
+}
+
+func executableFormat(goos string) string {
+	switch goos {
+	case "darwin":
+		return "Mach-O"
+	case "windows":
+		return "PE"
+	default:
+		return "ELF"
+	}
+}
+
+// archCompatible returns true if a binary built for arch can run on
+// goos/goarch. 32-bit x86 binaries run on 64-bit x86 systems and amd64
+// binaries run on arm64 Macs.
+func archCompatible(arch string, goos string, goarch string) bool {
+	switch {
+	case arch == goarch:
+		return true
+	case arch == "386" && goarch == "amd64":
+		return true
+	case arch == "amd64" && goarch == "arm64" && goos == "darwin":
+		return true
+	default:
+		return false
+	}
+}
+
+func elfArch(machine elf.Machine) string {
+	switch machine {
+	case elf.EM_386:
+		return "386"
+	case elf.EM_X86_64:
+		return "amd64"
+	case elf.EM_ARM:
+		return "arm"
+	case elf.EM_AARCH64:
+		return "arm64"
+	default:
+		return machine.String()
+	}
+}
+
+func machoArch(cpu macho.Cpu) string {
+	switch cpu {
+	case macho.Cpu386:
+		return "386"
+	case macho.CpuAmd64:
+		return "amd64"
+	case macho.CpuArm:
+		return "arm"
+	case macho.CpuArm64:
+		return "arm64"
+	default:
+		return cpu.String()
+	}
+}
+
+func peArch(machine uint16) string {
+	switch machine {
+	case pe.IMAGE_FILE_MACHINE_I386:
+		return "386"
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		return "amd64"
+	case pe.IMAGE_FILE_MACHINE_ARMNT:
+		return "arm"
+	case pe.IMAGE_FILE_MACHINE_ARM64:
+		return "arm64"
+	default:
+		return fmt.Sprintf("machine 0x%x", machine)
+	}
+}
@@ -0,0 +1,118 @@
+package pluginaction_test
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func elfHeader(machine elf.Machine) []byte {
+	header := make([]byte, 64)
+	copy(header, elf.ELFMAG)
+	header[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	binary.LittleEndian.PutUint16(header[16:], uint16(elf.ET_EXEC))
+	binary.LittleEndian.PutUint16(header[18:], uint16(machine))
+	binary.LittleEndian.PutUint32(header[20:], uint32(elf.EV_CURRENT))
+	binary.LittleEndian.PutUint16(header[52:], 64)
+	return header
+}
+
+func machoHeader(cpu macho.Cpu) []byte {
+	header := make([]byte, 32)
+	binary.LittleEndian.PutUint32(header[0:], macho.Magic64)
+	binary.LittleEndian.PutUint32(header[4:], uint32(cpu))
+	binary.LittleEndian.PutUint32(header[12:], uint32(macho.TypeExec))
+	return header
+}
+
+func peHeader(machine uint16) []byte {
+	header := make([]byte, 512)
+	copy(header, "MZ")
+	binary.LittleEndian.PutUint32(header[0x3c:], 0x40)
+	copy(header[0x40:], "PE\x00\x00")
+	binary.LittleEndian.PutUint16(header[0x44:], machine)
+	return header
+}
+
+var _ = Describe("architecture actions", func() {
+	var (
+		actor   *Actor
+		tempDir string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "plugin-architecture")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	writeBinary := func(contents []byte) string {
+		path := filepath.Join(tempDir, "some-plugin")
+		Expect(ioutil.WriteFile(path, contents, 0700)).To(Succeed())
+		return path
+	}
+
+	Describe("ValidatePluginArchitecture", func() {
+		DescribeTable("binaries that can run on the system",
+			func(contents []byte, goos string, goarch string) {
+				Expect(actor.ValidatePluginArchitecture(writeBinary(contents), goos, goarch)).To(Succeed())
+			},
+
+			Entry("linux amd64", elfHeader(elf.EM_X86_64), "linux", "amd64"),
+			Entry("linux 386 on amd64", elfHeader(elf.EM_386), "linux", "amd64"),
+			Entry("linux arm64", elfHeader(elf.EM_AARCH64), "linux", "arm64"),
+			Entry("macOS amd64", machoHeader(macho.CpuAmd64), "darwin", "amd64"),
+			Entry("macOS amd64 on arm64", machoHeader(macho.CpuAmd64), "darwin", "arm64"),
+			Entry("windows amd64", peHeader(pe.IMAGE_FILE_MACHINE_AMD64), "windows", "amd64"),
+			Entry("windows 386 on amd64", peHeader(pe.IMAGE_FILE_MACHINE_I386), "windows", "amd64"),
+		)
+
+		DescribeTable("binaries that cannot run on the system",
+			func(contents []byte, goos string, goarch string, expected string, actual string) {
+				path := writeBinary(contents)
+				Expect(actor.ValidatePluginArchitecture(path, goos, goarch)).To(MatchError(PluginArchitectureMismatchError{
+					Path:                 path,
+					ExpectedArchitecture: expected,
+					ActualArchitecture:   actual,
+				}))
+			},
+
+			Entry("windows binary on linux", peHeader(pe.IMAGE_FILE_MACHINE_AMD64), "linux", "amd64", "ELF amd64", "PE amd64"),
+			Entry("macOS binary on windows", machoHeader(macho.CpuAmd64), "windows", "amd64", "PE amd64", "Mach-O amd64"),
+			Entry("linux binary on macOS", elfHeader(elf.EM_X86_64), "darwin", "amd64", "Mach-O amd64", "ELF amd64"),
+			Entry("linux arm64 binary on amd64", elfHeader(elf.EM_AARCH64), "linux", "amd64", "ELF amd64", "ELF arm64"),
+			Entry("linux amd64 binary on 386", elfHeader(elf.EM_X86_64), "linux", "386", "ELF 386", "ELF amd64"),
+			Entry("macOS arm64 binary on amd64", machoHeader(macho.CpuArm64), "darwin", "amd64", "Mach-O amd64", "Mach-O arm64"),
+		)
+
+		Context("when the file is not a recognized executable", func() {
+			It("does not return an error", func() {
+				path := writeBinary([]byte("#!/bin/sh\necho hello\n"))
+				Expect(actor.ValidatePluginArchitecture(path, "linux", "amd64")).To(Succeed())
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("does not return an error", func() {
+				Expect(actor.ValidatePluginArchitecture(filepath.Join(tempDir, "missing"), "linux", "amd64")).To(Succeed())
+			})
+		})
+	})
+})
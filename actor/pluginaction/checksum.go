@@ -1,8 +1,82 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// PluginChecksumMismatchError is returned when the checksum of a downloaded
+// plugin binary does not match the expected checksum.
+type PluginChecksumMismatchError struct {
+	ExpectedChecksum string
+	ActualChecksum   string
+}
+
+func (e PluginChecksumMismatchError) Error() string {
+	return fmt.Sprintf("Plugin checksum %s does not match expected checksum %s.", e.ActualChecksum, e.ExpectedChecksum)
+}
+
+// PluginBinaryMissingError is returned when an installed plugin's binary no
+// longer exists at the location recorded in the plugin config.
+type PluginBinaryMissingError struct {
+	PluginName string
+	Path       string
+}
+
+func (e PluginBinaryMissingError) Error() string {
+	return fmt.Sprintf("Plugin %s binary is missing from %s.", e.PluginName, e.Path)
+}
+
+// CalculatePluginChecksum returns the SHA-1 of the installed plugin's binary.
+func (actor Actor) CalculatePluginChecksum(pluginName string) (string, error) {
+	plugin, found := actor.config.GetPlugin(pluginName)
+	if !found {
+		return "", PluginNotFoundError{PluginName: pluginName}
+	}
+
+	if _, err := os.Stat(plugin.Location); os.IsNotExist(err) {
+		return "", PluginBinaryMissingError{PluginName: plugin.Name, Path: plugin.Location}
+	}
+
+	checksum, err := util.NewSha1Checksum(plugin.Location).ComputeFileSha1()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", checksum), nil
+}
+
+// ValidateFileChecksum returns true if the file at path matches the provided
+// checksum. SHA-256 is used for 64 character checksums, SHA-1 otherwise.
 func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
-	plugin := configv3.Plugin{Location: path}
-	return plugin.CalculateSHA1() == checksum
+	return calculateFileChecksum(path, checksum) == strings.ToLower(checksum)
+}
+
+// calculateFileChecksum returns the digest of the file at path using the
+// algorithm implied by the length of checksum. If an error is encountered,
+// N/A is returned.
+func calculateFileChecksum(path string, checksum string) string {
+	if len(checksum) != sha256.Size*2 {
+		plugin := configv3.Plugin{Location: path}
+		return plugin.CalculateSHA1()
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "N/A"
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "N/A"
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
 }
@@ -6,6 +6,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -44,10 +45,77 @@ var _ = Describe("Checksums", func() {
 			})
 		})
 
+		Context("when the SHA-256 checksums match", func() {
+			It("returns true", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeTrue())
+			})
+		})
+
 		Context("when the checksums do not match", func() {
 			It("returns false", func() {
 				Expect(actor.ValidateFileChecksum(file.Name(), "blah")).To(BeFalse())
 			})
 		})
 	})
+
+	Describe("CalculatePluginChecksum", func() {
+		var (
+			checksum   string
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			checksum, executeErr = actor.CalculatePluginChecksum("some-plugin")
+		})
+
+		Context("when the plugin is installed", func() {
+			var file *os.File
+
+			BeforeEach(func() {
+				var err error
+				file, err = ioutil.TempFile("", "")
+				defer file.Close()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeConfig.GetPluginReturns(configv3.Plugin{Name: "some-plugin", Location: file.Name()}, true)
+			})
+
+			AfterEach(func() {
+				err := os.Remove(file.Name())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the sha1 of the plugin binary", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(checksum).To(Equal("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"))
+
+				Expect(fakeConfig.GetPluginCallCount()).To(Equal(1))
+				Expect(fakeConfig.GetPluginArgsForCall(0)).To(Equal("some-plugin"))
+			})
+		})
+
+		Context("when the plugin binary has been deleted", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginReturns(configv3.Plugin{Name: "some-plugin", Location: "/some/missing/binary"}, true)
+			})
+
+			It("returns a PluginBinaryMissingError", func() {
+				Expect(executeErr).To(MatchError(PluginBinaryMissingError{PluginName: "some-plugin", Path: "/some/missing/binary"}))
+				Expect(checksum).To(BeEmpty())
+			})
+		})
+
+		Context("when the plugin is not installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginReturns(configv3.Plugin{}, false)
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				Expect(executeErr).To(MatchError(PluginNotFoundError{PluginName: "some-plugin"}))
+			})
+		})
+	})
 })
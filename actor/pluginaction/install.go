@@ -1,9 +1,11 @@
 package pluginaction
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 
@@ -49,6 +51,18 @@ func (PluginCommandsConflictError) Error() string {
 	return ""
 }
 
+// NoCompatibleBinaryInDirectoryError is returned when a plugin directory does
+// not contain a binary for the current platform.
+type NoCompatibleBinaryInDirectoryError struct {
+	Path     string
+	Platform string
+	Binaries []string
+}
+
+func (e NoCompatibleBinaryInDirectoryError) Error() string {
+	return fmt.Sprintf("No plugin binary for %s found in %s.", e.Platform, e.Path)
+}
+
 // CreateExecutableCopy makes a temporary copy of a plugin binary and makes it
 // executable.
 //
@@ -81,7 +95,8 @@ func (actor Actor) CreateExecutableCopy(path string, tempPluginDir string) (stri
 }
 
 // DownloadBinaryFromURL fetches a plugin binary from the specified URL, if
-// it exists.
+// it exists. Download progress is reported to proxyReader. The temp file is
+// removed if the download fails.
 func (actor Actor) DownloadExecutableBinaryFromURL(pluginURL string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error) {
 	tempFile, err := makeTempFile(tempPluginDir)
 	if err != nil {
@@ -90,12 +105,54 @@ func (actor Actor) DownloadExecutableBinaryFromURL(pluginURL string, tempPluginD
 
 	err = actor.client.DownloadPlugin(pluginURL, tempFile.Name(), proxyReader)
 	if err != nil {
+		os.Remove(tempFile.Name())
 		return "", err
 	}
 
 	return tempFile.Name(), nil
 }
 
+// FetchPluginFromURLWithChecksum downloads a plugin binary from the specified
+// URL and verifies it against the expected SHA-1 or SHA-256 checksum. A
+// PluginChecksumMismatchError is returned if the checksums do not match.
+func (actor Actor) FetchPluginFromURLWithChecksum(pluginURL string, tempPluginDir string, expectedChecksum string, proxyReader plugin.ProxyReader) (string, error) {
+	path, err := actor.DownloadExecutableBinaryFromURL(pluginURL, tempPluginDir, proxyReader)
+	if err != nil {
+		return "", err
+	}
+
+	err = actor.VerifyPluginChecksum(path, expectedChecksum)
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+
+	return path, nil
+}
+
+// VerifyPluginChecksum verifies the plugin binary at path against the
+// expected SHA-1 or SHA-256 checksum. A PluginChecksumMismatchError is
+// returned if the checksums do not match.
+func (actor Actor) VerifyPluginChecksum(path string, expectedChecksum string) error {
+	actualChecksum := calculateFileChecksum(path, expectedChecksum)
+	if actualChecksum != strings.ToLower(expectedChecksum) {
+		return PluginChecksumMismatchError{
+			ExpectedChecksum: expectedChecksum,
+			ActualChecksum:   actualChecksum,
+		}
+	}
+
+	return nil
+}
+
+// DirectoryExists returns true if the path exists and is a directory. It
+// returns false if it doesn't exist, isn't a directory or there is an error
+// checking.
+func (actor Actor) DirectoryExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
 // FileExists returns true if the file exists. It returns false if the file
 // doesn't exist or there is an error checking.
 func (actor Actor) FileExists(path string) bool {
@@ -104,6 +161,13 @@ func (actor Actor) FileExists(path string) bool {
 }
 
 func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, commandList CommandList, path string) (configv3.Plugin, error) {
+	// Check the binary's format before executing it so that a plugin built
+	// for another platform fails with a clear error instead of an exec error.
+	err := actor.ValidatePluginArchitecture(path, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+
 	plugin, err := pluginMetadata.GetMetadata(path)
 	if err != nil || plugin.Name == "" || len(plugin.Commands) == 0 {
 		return configv3.Plugin{}, PluginInvalidError{Err: err}
@@ -187,6 +251,36 @@ func (actor Actor) InstallPluginFromPath(path string, plugin configv3.Plugin) er
 	return nil
 }
 
+// GetPluginBinaryFromDirectory returns the path of the plugin binary in
+// directory that was built for runtimeGOOS and runtimeGOARCH. Binaries are
+// matched on a _<GOOS>_<GOARCH> suffix, such as plugin_linux_amd64 or
+// plugin_windows_amd64.exe.
+func (actor Actor) GetPluginBinaryFromDirectory(directory string, runtimeGOOS string, runtimeGOARCH string) (string, error) {
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return "", err
+	}
+
+	platform := fmt.Sprintf("%s_%s", runtimeGOOS, runtimeGOARCH)
+	binaries := []string{}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		binaries = append(binaries, file.Name())
+		if strings.HasSuffix(strings.TrimSuffix(file.Name(), ".exe"), "_"+platform) {
+			return filepath.Join(directory, file.Name()), nil
+		}
+	}
+
+	return "", NoCompatibleBinaryInDirectoryError{
+		Path:     directory,
+		Platform: platform,
+		Binaries: binaries,
+	}
+}
+
 func (actor Actor) IsPluginInstalled(pluginName string) bool {
 	_, isInstalled := actor.config.GetPlugin(pluginName)
 	return isInstalled
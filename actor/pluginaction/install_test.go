@@ -1,10 +1,13 @@
 package pluginaction_test
 
 import (
+	"debug/elf"
+	"debug/pe"
 	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 
 	. "code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
@@ -126,8 +129,192 @@ var _ = Describe("install actions", func() {
 				fakeClient.DownloadPluginReturns(expectedErr)
 			})
 
-			It("returns the error", func() {
+			It("returns the error and removes the temp file", func() {
 				Expect(downloadErr).To(MatchError(expectedErr))
+
+				files, err := ioutil.ReadDir(tempPluginDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("FetchPluginFromURLWithChecksum", func() {
+		var (
+			path             string
+			expectedChecksum string
+			fetchErr         error
+			fakeProxyReader  *pluginfakes.FakeProxyReader
+		)
+
+		JustBeforeEach(func() {
+			fakeProxyReader = new(pluginfakes.FakeProxyReader)
+			path, fetchErr = actor.FetchPluginFromURLWithChecksum("some-plugin-url.com", tempPluginDir, expectedChecksum, fakeProxyReader)
+		})
+
+		Context("when the download is successful", func() {
+			BeforeEach(func() {
+				fakeClient.DownloadPluginStub = func(_ string, path string, _ plugin.ProxyReader) error {
+					err := ioutil.WriteFile(path, []byte("some test data"), 0700)
+					Expect(err).ToNot(HaveOccurred())
+					return nil
+				}
+			})
+
+			Context("when the SHA-1 checksum matches", func() {
+				BeforeEach(func() {
+					expectedChecksum = "3c115c5ae8964d8e79d224e56193e3f7aa48df62"
+				})
+
+				It("returns the path to the downloaded file", func() {
+					Expect(fetchErr).ToNot(HaveOccurred())
+					Expect(path).To(HavePrefix(tempPluginDir))
+
+					Expect(fakeClient.DownloadPluginCallCount()).To(Equal(1))
+					pluginURL, downloadPath, proxyReader := fakeClient.DownloadPluginArgsForCall(0)
+					Expect(pluginURL).To(Equal("some-plugin-url.com"))
+					Expect(downloadPath).To(Equal(path))
+					Expect(proxyReader).To(Equal(fakeProxyReader))
+				})
+			})
+
+			Context("when the SHA-256 checksum matches", func() {
+				BeforeEach(func() {
+					expectedChecksum = "F70C5E847D0EA29088216D81D628DF4B4F68F3CCABB2E4031C09CC4D129AE216"
+				})
+
+				It("returns the path to the downloaded file", func() {
+					Expect(fetchErr).ToNot(HaveOccurred())
+					Expect(path).To(HavePrefix(tempPluginDir))
+				})
+			})
+
+			Context("when the checksum does not match", func() {
+				BeforeEach(func() {
+					expectedChecksum = "some-checksum"
+				})
+
+				It("returns a PluginChecksumMismatchError", func() {
+					Expect(fetchErr).To(MatchError(PluginChecksumMismatchError{
+						ExpectedChecksum: "some-checksum",
+						ActualChecksum:   "3c115c5ae8964d8e79d224e56193e3f7aa48df62",
+					}))
+					Expect(path).To(BeEmpty())
+
+					files, err := ioutil.ReadDir(tempPluginDir)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(files).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when there is an error downloading file", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeClient.DownloadPluginReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(fetchErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("VerifyPluginChecksum", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(tempPluginDir, "some-plugin")
+			err := ioutil.WriteFile(path, []byte("some test data"), 0700)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns nil when the checksum matches", func() {
+			Expect(actor.VerifyPluginChecksum(path, "3c115c5ae8964d8e79d224e56193e3f7aa48df62")).To(Succeed())
+		})
+
+		It("returns a PluginChecksumMismatchError and keeps the file when the checksum does not match", func() {
+			err := actor.VerifyPluginChecksum(path, "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9")
+			Expect(err).To(MatchError(PluginChecksumMismatchError{
+				ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+				ActualChecksum:   "3c115c5ae8964d8e79d224e56193e3f7aa48df62",
+			}))
+			Expect(path).To(BeAnExistingFile())
+		})
+	})
+
+	Describe("DirectoryExists", func() {
+		It("returns true when the path is a directory", func() {
+			Expect(actor.DirectoryExists(tempPluginDir)).To(BeTrue())
+		})
+
+		It("returns false when the path is a file", func() {
+			pluginFile, err := ioutil.TempFile(tempPluginDir, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginFile.Close()).To(Succeed())
+
+			Expect(actor.DirectoryExists(pluginFile.Name())).To(BeFalse())
+		})
+
+		It("returns false when the path does not exist", func() {
+			Expect(actor.DirectoryExists("/some/path/that/does/not/exist")).To(BeFalse())
+		})
+	})
+
+	Describe("GetPluginBinaryFromDirectory", func() {
+		var (
+			binaryPath string
+			getErr     error
+		)
+
+		JustBeforeEach(func() {
+			binaryPath, getErr = actor.GetPluginBinaryFromDirectory(tempPluginDir, "linux", "amd64")
+		})
+
+		Context("when the directory contains a binary for the platform", func() {
+			BeforeEach(func() {
+				for _, name := range []string{"plugin_darwin_amd64", "plugin_linux_386", "plugin_linux_amd64", "plugin_windows_amd64.exe"} {
+					Expect(ioutil.WriteFile(filepath.Join(tempPluginDir, name), nil, 0600)).To(Succeed())
+				}
+			})
+
+			It("returns the path to the binary", func() {
+				Expect(getErr).ToNot(HaveOccurred())
+				Expect(binaryPath).To(Equal(filepath.Join(tempPluginDir, "plugin_linux_amd64")))
+			})
+		})
+
+		Context("when the directory does not contain a binary for the platform", func() {
+			BeforeEach(func() {
+				Expect(os.Mkdir(filepath.Join(tempPluginDir, "plugin_linux_amd64"), 0700)).To(Succeed())
+				for _, name := range []string{"plugin_darwin_amd64", "plugin_windows_amd64.exe"} {
+					Expect(ioutil.WriteFile(filepath.Join(tempPluginDir, name), nil, 0600)).To(Succeed())
+				}
+			})
+
+			It("returns a NoCompatibleBinaryInDirectoryError listing the binaries found", func() {
+				Expect(getErr).To(MatchError(NoCompatibleBinaryInDirectoryError{
+					Path:     tempPluginDir,
+					Platform: "linux_amd64",
+					Binaries: []string{"plugin_darwin_amd64", "plugin_windows_amd64.exe"},
+				}))
+			})
+		})
+
+		Context("when the directory cannot be read", func() {
+			BeforeEach(func() {
+				Expect(os.RemoveAll(tempPluginDir)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Mkdir(tempPluginDir, 0700)).To(Succeed())
+			})
+
+			It("returns an os.PathError", func() {
+				_, isPathError := getErr.(*os.PathError)
+				Expect(isPathError).To(BeTrue())
 			})
 		})
 	})
@@ -184,6 +371,7 @@ var _ = Describe("install actions", func() {
 		var (
 			fakePluginMetadata *pluginactionfakes.FakePluginMetadata
 			fakeCommandList    *pluginactionfakes.FakeCommandList
+			pluginPath         string
 			plugin             configv3.Plugin
 			validateErr        error
 		)
@@ -191,10 +379,27 @@ var _ = Describe("install actions", func() {
 		BeforeEach(func() {
 			fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
 			fakeCommandList = new(pluginactionfakes.FakeCommandList)
+			pluginPath = "some-plugin-path"
 		})
 
 		JustBeforeEach(func() {
-			plugin, validateErr = actor.GetAndValidatePlugin(fakePluginMetadata, fakeCommandList, "some-plugin-path")
+			plugin, validateErr = actor.GetAndValidatePlugin(fakePluginMetadata, fakeCommandList, pluginPath)
+		})
+
+		Context("when the plugin binary is built for another platform", func() {
+			BeforeEach(func() {
+				header := peHeader(pe.IMAGE_FILE_MACHINE_AMD64)
+				if runtime.GOOS == "windows" {
+					header = elfHeader(elf.EM_X86_64)
+				}
+				pluginPath = filepath.Join(tempPluginDir, "wrong-platform-plugin")
+				Expect(ioutil.WriteFile(pluginPath, header, 0700)).To(Succeed())
+			})
+
+			It("returns a PluginArchitectureMismatchError without running the plugin", func() {
+				Expect(validateErr).To(BeAssignableToTypeOf(PluginArchitectureMismatchError{}))
+				Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(0))
+			})
 		})
 
 		Context("when getting the plugin metadata returns an error", func() {
@@ -2,7 +2,10 @@ package pluginaction
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
+	"code.cloudfoundry.org/cli/util/configv3"
 	"github.com/blang/semver"
 )
 
@@ -28,7 +31,7 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 
 	repoPlugins := map[string]string{}
 	for _, repo := range actor.config.PluginRepositories() {
-		repository, err := actor.client.GetPluginRepository(repo.URL)
+		repository, err := actor.getPluginRepository(repo.URL)
 		if err != nil {
 			return nil, GettingPluginRepositoryError{Name: repo.Name, Message: err.Error()}
 		}
@@ -72,3 +75,37 @@ func lessThan(version1 string, version2 string) bool {
 
 	return v1.LT(v2)
 }
+
+// GetInstalledPlugins returns the installed plugins sorted by name
+// (case-insensitive). Each plugin's commands are sorted by command name.
+func (actor Actor) GetInstalledPlugins() []configv3.Plugin {
+	plugins := actor.config.Plugins()
+	sort.Slice(plugins, func(i int, j int) bool {
+		return strings.ToLower(plugins[i].Name) < strings.ToLower(plugins[j].Name)
+	})
+
+	for i, plugin := range plugins {
+		plugins[i].Commands = plugin.PluginCommands()
+	}
+
+	return plugins
+}
+
+// CommandExists returns the name of the installed plugin that provides a
+// command or alias called name. If several plugins provide it, the first
+// plugin by name is returned.
+func (actor Actor) CommandExists(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+
+	for _, plugin := range actor.GetInstalledPlugins() {
+		for _, command := range plugin.Commands {
+			if command.Name == name || command.Alias == name {
+				return plugin.Name, true
+			}
+		}
+	}
+
+	return "", false
+}
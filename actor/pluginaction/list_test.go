@@ -108,4 +108,77 @@ var _ = Describe("Plugin actions", func() {
 			})
 		})
 	})
+
+	Context("when two installed plugins define overlapping aliases", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{
+					Name:    "zebra-plugin",
+					Version: configv3.PluginVersion{Major: 2},
+					Commands: []configv3.PluginCommand{
+						{Name: "zebra-stripes", Alias: "zs"},
+						{Name: "zebra-graze", Alias: "zg"},
+					},
+				},
+				{
+					Name:    "Aardvark-plugin",
+					Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+					Commands: []configv3.PluginCommand{
+						{Name: "aardvark-dig", Alias: "zs"},
+					},
+				},
+			})
+		})
+
+		Describe("GetInstalledPlugins", func() {
+			It("returns the plugins sorted by name with their versions and sorted commands", func() {
+				plugins := actor.GetInstalledPlugins()
+				Expect(plugins).To(HaveLen(2))
+
+				Expect(plugins[0].Name).To(Equal("Aardvark-plugin"))
+				Expect(plugins[0].Version.String()).To(Equal("1.2.3"))
+				Expect(plugins[0].Commands).To(Equal([]configv3.PluginCommand{
+					{Name: "aardvark-dig", Alias: "zs"},
+				}))
+
+				Expect(plugins[1].Name).To(Equal("zebra-plugin"))
+				Expect(plugins[1].Version.String()).To(Equal("2.0.0"))
+				Expect(plugins[1].Commands).To(Equal([]configv3.PluginCommand{
+					{Name: "zebra-graze", Alias: "zg"},
+					{Name: "zebra-stripes", Alias: "zs"},
+				}))
+			})
+		})
+
+		Describe("CommandExists", func() {
+			It("returns the plugin providing a command name", func() {
+				pluginName, exists := actor.CommandExists("zebra-graze")
+				Expect(exists).To(BeTrue())
+				Expect(pluginName).To(Equal("zebra-plugin"))
+			})
+
+			It("returns the plugin providing an alias", func() {
+				pluginName, exists := actor.CommandExists("zg")
+				Expect(exists).To(BeTrue())
+				Expect(pluginName).To(Equal("zebra-plugin"))
+			})
+
+			It("returns the first plugin by name when an alias is provided by several plugins", func() {
+				pluginName, exists := actor.CommandExists("zs")
+				Expect(exists).To(BeTrue())
+				Expect(pluginName).To(Equal("Aardvark-plugin"))
+			})
+
+			It("returns false when no plugin provides the command", func() {
+				pluginName, exists := actor.CommandExists("push")
+				Expect(exists).To(BeFalse())
+				Expect(pluginName).To(BeEmpty())
+			})
+
+			It("returns false for an empty name", func() {
+				_, exists := actor.CommandExists("")
+				Expect(exists).To(BeFalse())
+			})
+		})
+	})
 })
@@ -3,6 +3,7 @@ package pluginaction
 import (
 	"fmt"
 	"runtime"
+	"strings"
 
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/generic"
@@ -57,6 +58,20 @@ func (e PluginNotFoundInAnyRepositoryError) Error() string {
 	return fmt.Sprintf("Plugin %s not found in any registered repo", e.PluginName)
 }
 
+// PluginVersionNotFoundInAnyRepositoryError is an error returned when a plugin
+// exists in the repositories but not with the requested version.
+type PluginVersionNotFoundInAnyRepositoryError struct {
+	PluginName        string
+	Version           string
+	AvailableVersions []string
+}
+
+// Error outputs that the plugin version cannot be found and lists the
+// versions that are available.
+func (e PluginVersionNotFoundInAnyRepositoryError) Error() string {
+	return fmt.Sprintf("Plugin %s version %s not found in any registered repo, available versions: %s", e.PluginName, e.Version, strings.Join(e.AvailableVersions, ", "))
+}
+
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
 // and all the repositories that contain that version.
 func (actor Actor) GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (PluginInfo, []string, error) {
@@ -97,6 +112,68 @@ func (actor Actor) GetPluginInfoFromRepositoriesForPlatform(pluginName string, p
 	return newestPluginInfo, reposWithPlugin, nil
 }
 
+// GetPluginVersionInfoFromRepositoriesForPlatform returns the specified
+// version of the plugin and all the repositories that contain that version.
+func (actor Actor) GetPluginVersionInfoFromRepositoriesForPlatform(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (PluginInfo, []string, error) {
+	var reposWithPlugin []string
+	var versionInfo PluginInfo
+	var availableVersions []string
+	var pluginFoundWithIncompatibleBinary bool
+
+	for _, repo := range pluginRepos {
+		pluginRepository, err := actor.getPluginRepository(repo.URL)
+		if err != nil {
+			return PluginInfo{}, nil, FetchingPluginInfoFromRepositoryError{
+				RepositoryName: repo.Name,
+				Err:            err,
+			}
+		}
+
+		for _, plugin := range pluginRepository.Plugins {
+			if plugin.Name != pluginName {
+				continue
+			}
+			if plugin.Version != version {
+				availableVersions = appendUnique(availableVersions, plugin.Version)
+				continue
+			}
+
+			pluginFoundWithIncompatibleBinary = true
+			for _, pluginBinary := range plugin.Binaries {
+				if pluginBinary.Platform == platform {
+					versionInfo = PluginInfo{Name: plugin.Name, Version: plugin.Version, URL: pluginBinary.URL, Checksum: pluginBinary.Checksum}
+					reposWithPlugin = appendUnique(reposWithPlugin, repo.Name)
+					break
+				}
+			}
+		}
+	}
+
+	switch {
+	case len(reposWithPlugin) > 0:
+		return versionInfo, reposWithPlugin, nil
+	case pluginFoundWithIncompatibleBinary:
+		return PluginInfo{}, nil, NoCompatibleBinaryError{}
+	case len(availableVersions) > 0:
+		return PluginInfo{}, nil, PluginVersionNotFoundInAnyRepositoryError{
+			PluginName:        pluginName,
+			Version:           version,
+			AvailableVersions: availableVersions,
+		}
+	default:
+		return PluginInfo{}, nil, PluginNotFoundInAnyRepositoryError{PluginName: pluginName}
+	}
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, existing := range list {
+		if existing == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
 // GetPlatformString exists solely for the purposes of mocking it out for command-layers tests.
 func (actor Actor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string {
 	return generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH)
@@ -105,7 +182,7 @@ func (actor Actor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) s
 // getPluginInfoFromRepositoryForPlatform returns the plugin info, if found, from
 // the specified repository for the specified platform.
 func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, pluginRepo configv3.PluginRepository, platform string) (PluginInfo, error) {
-	pluginRepository, err := actor.client.GetPluginRepository(pluginRepo.URL)
+	pluginRepository, err := actor.getPluginRepository(pluginRepo.URL)
 	if err != nil {
 		return PluginInfo{}, err
 	}
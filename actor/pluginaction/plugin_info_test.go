@@ -325,4 +325,135 @@ var _ = Describe("plugin info actions", func() {
 			})
 		})
 	})
+	Describe("GetPluginVersionInfoFromRepositoriesForPlatform", func() {
+		var (
+			version    string
+			pluginInfo PluginInfo
+			repos      []string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			version = "1.2.0"
+			fakeClient.GetPluginRepositoryStub = func(repositoryURL string) (plugin.PluginRepository, error) {
+				switch repositoryURL {
+				case "repo-1-url":
+					return plugin.PluginRepository{
+						Plugins: []plugin.Plugin{
+							{
+								Name:    "some-plugin",
+								Version: "1.3.0",
+								Binaries: []plugin.PluginBinary{
+									{Platform: "linux64", URL: "http://1.3.0-url", Checksum: "1.3.0-checksum"},
+								},
+							},
+							{
+								Name:    "some-plugin",
+								Version: "1.2.0",
+								Binaries: []plugin.PluginBinary{
+									{Platform: "osx", URL: "http://1.2.0-osx-url", Checksum: "osx-checksum"},
+									{Platform: "linux64", URL: "http://1.2.0-url", Checksum: "1.2.0-checksum"},
+								},
+							},
+						},
+					}, nil
+				default:
+					return plugin.PluginRepository{
+						Plugins: []plugin.Plugin{
+							{
+								Name:    "some-plugin",
+								Version: "1.1.0",
+								Binaries: []plugin.PluginBinary{
+									{Platform: "linux64", URL: "http://1.1.0-url", Checksum: "1.1.0-checksum"},
+								},
+							},
+						},
+					}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			pluginInfo, repos, executeErr = actor.GetPluginVersionInfoFromRepositoriesForPlatform(
+				"some-plugin",
+				version,
+				[]configv3.PluginRepository{
+					{Name: "repo-1", URL: "repo-1-url"},
+					{Name: "repo-2", URL: "repo-2-url"},
+				},
+				"linux64",
+			)
+		})
+
+		Context("when the version exists for the platform", func() {
+			It("returns the info of the requested version instead of the newest one", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pluginInfo).To(Equal(PluginInfo{
+					Name:     "some-plugin",
+					Version:  "1.2.0",
+					URL:      "http://1.2.0-url",
+					Checksum: "1.2.0-checksum",
+				}))
+				Expect(repos).To(Equal([]string{"repo-1"}))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				version = "9.9.9"
+			})
+
+			It("returns a PluginVersionNotFoundInAnyRepositoryError with the available versions", func() {
+				Expect(executeErr).To(MatchError(PluginVersionNotFoundInAnyRepositoryError{
+					PluginName:        "some-plugin",
+					Version:           "9.9.9",
+					AvailableVersions: []string{"1.3.0", "1.2.0", "1.1.0"},
+				}))
+			})
+		})
+
+		Context("when the version has no binary for the platform", func() {
+			BeforeEach(func() {
+				fakeClient.GetPluginRepositoryStub = nil
+				fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:     "some-plugin",
+							Version:  "1.2.0",
+							Binaries: []plugin.PluginBinary{{Platform: "win64", URL: "http://win-url"}},
+						},
+					},
+				}, nil)
+			})
+
+			It("returns a NoCompatibleBinaryError", func() {
+				Expect(executeErr).To(MatchError(NoCompatibleBinaryError{}))
+			})
+		})
+
+		Context("when the plugin does not exist", func() {
+			BeforeEach(func() {
+				fakeClient.GetPluginRepositoryStub = nil
+				fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, nil)
+			})
+
+			It("returns a PluginNotFoundInAnyRepositoryError", func() {
+				Expect(executeErr).To(MatchError(PluginNotFoundInAnyRepositoryError{PluginName: "some-plugin"}))
+			})
+		})
+
+		Context("when getting a repository errors", func() {
+			BeforeEach(func() {
+				fakeClient.GetPluginRepositoryStub = nil
+				fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("some-error"))
+			})
+
+			It("returns a FetchingPluginInfoFromRepositoryError", func() {
+				Expect(executeErr).To(MatchError(FetchingPluginInfoFromRepositoryError{
+					RepositoryName: "repo-1",
+					Err:            errors.New("some-error"),
+				}))
+			})
+		})
+	})
 })
@@ -65,7 +65,7 @@ func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
 		}
 	}
 
-	_, err = actor.client.GetPluginRepository(normalizedURL)
+	repository, err := actor.client.GetPluginRepository(normalizedURL)
 	if err != nil {
 		return AddPluginRepositoryError{
 			Name:    repoName,
@@ -74,6 +74,13 @@ func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
 		}
 	}
 
+	// Catalogs are cached by URL, so a repository registered under a new URL
+	// never reads a catalog cached for its old one. Seed the cache with the
+	// catalog just fetched.
+	if actor.cacheRepositories {
+		actor.writeCachedRepository(normalizedURL, repository)
+	}
+
 	actor.config.AddPluginRepository(repoName, normalizedURL)
 	return nil
 }
@@ -0,0 +1,112 @@
+package pluginaction
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+)
+
+// PluginRepositoryCacheTTL is how long a cached plugin repository catalog is
+// used before it is refreshed from the repository.
+const PluginRepositoryCacheTTL = 24 * time.Hour
+
+// PluginRepositoryCacheDirectory is the directory, relative to the plugin
+// home, that cached plugin repository catalogs are stored in.
+const PluginRepositoryCacheDirectory = "repository_cache"
+
+// PluginRepositoryNotCachedError is returned in offline mode when a plugin
+// repository's catalog has not been cached.
+type PluginRepositoryNotCachedError struct {
+	URL string
+}
+
+func (e PluginRepositoryNotCachedError) Error() string {
+	return fmt.Sprintf("Plugin repository %s has not been cached.", e.URL)
+}
+
+type cachedPluginRepository struct {
+	URL        string                  `json:"url"`
+	FetchedAt  time.Time               `json:"fetched_at"`
+	Repository plugin.PluginRepository `json:"repository"`
+}
+
+// UseRepositoryCache makes the actor cache plugin repository catalogs in the
+// plugin home, keyed by repository URL. Cached catalogs are refreshed once
+// they are older than PluginRepositoryCacheTTL. When offline is true, only
+// cached catalogs are used and the repositories are never contacted.
+func (actor *Actor) UseRepositoryCache(offline bool) {
+	actor.cacheRepositories = true
+	actor.offline = offline
+}
+
+// getPluginRepository returns the catalog of the plugin repository at the
+// given URL, using the cache if it is enabled.
+func (actor Actor) getPluginRepository(repositoryURL string) (plugin.PluginRepository, error) {
+	if !actor.cacheRepositories {
+		return actor.client.GetPluginRepository(repositoryURL)
+	}
+
+	cached, found := actor.readCachedRepository(repositoryURL)
+	if actor.offline {
+		if !found {
+			return plugin.PluginRepository{}, PluginRepositoryNotCachedError{URL: repositoryURL}
+		}
+		return cached.Repository, nil
+	}
+
+	if found && time.Since(cached.FetchedAt) < PluginRepositoryCacheTTL {
+		return cached.Repository, nil
+	}
+
+	repository, err := actor.client.GetPluginRepository(repositoryURL)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	actor.writeCachedRepository(repositoryURL, repository)
+	return repository, nil
+}
+
+func (actor Actor) readCachedRepository(repositoryURL string) (cachedPluginRepository, bool) {
+	raw, err := ioutil.ReadFile(actor.cachedRepositoryPath(repositoryURL))
+	if err != nil {
+		return cachedPluginRepository{}, false
+	}
+
+	var cached cachedPluginRepository
+	if err := json.Unmarshal(raw, &cached); err != nil || cached.URL != repositoryURL {
+		return cachedPluginRepository{}, false
+	}
+	return cached, true
+}
+
+// writeCachedRepository caches the repository catalog. Failing to write the
+// cache is not an error; the catalog is simply fetched again next time.
+func (actor Actor) writeCachedRepository(repositoryURL string, repository plugin.PluginRepository) {
+	raw, err := json.Marshal(cachedPluginRepository{
+		URL:        repositoryURL,
+		FetchedAt:  time.Now(),
+		Repository: repository,
+	})
+	if err != nil {
+		return
+	}
+
+	path := actor.cachedRepositoryPath(repositoryURL)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(path, raw, 0600)
+}
+
+func (actor Actor) cachedRepositoryPath(repositoryURL string) string {
+	sum := sha256.Sum256([]byte(repositoryURL))
+	return filepath.Join(actor.config.PluginHome(), PluginRepositoryCacheDirectory, hex.EncodeToString(sum[:])+".json")
+}
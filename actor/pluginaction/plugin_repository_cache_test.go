@@ -0,0 +1,165 @@
+package pluginaction_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Repository Cache", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+		pluginHome       string
+		repos            []configv3.PluginRepository
+		offline          bool
+
+		pluginInfo PluginInfo
+		executeErr error
+	)
+
+	cachePath := func(url string) string {
+		sum := sha256.Sum256([]byte(url))
+		return filepath.Join(pluginHome, PluginRepositoryCacheDirectory, hex.EncodeToString(sum[:])+".json")
+	}
+
+	writeCache := func(url string, fetchedAt time.Time, version string) {
+		Expect(os.MkdirAll(filepath.Join(pluginHome, PluginRepositoryCacheDirectory), 0700)).To(Succeed())
+		raw := `{"url": "` + url + `", "fetched_at": "` + fetchedAt.Format(time.RFC3339) + `", "repository": {"plugins": [
+			{"name": "some-plugin", "version": "` + version + `", "binaries": [{"platform": "linux64", "url": "http://some-url", "checksum": "some-checksum"}]}
+		]}}`
+		Expect(ioutil.WriteFile(cachePath(url), []byte(raw), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		pluginHome, err = ioutil.TempDir("", "plugin-repository-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+			Plugins: []plugin.Plugin{
+				{
+					Name:     "some-plugin",
+					Version:  "2.0.0",
+					Binaries: []plugin.PluginBinary{{Platform: "linux64", URL: "http://some-url", Checksum: "some-checksum"}},
+				},
+			},
+		}, nil)
+
+		actor = NewActor(fakeConfig, fakePluginClient)
+		repos = []configv3.PluginRepository{{Name: "some-repo", URL: "https://some-repo.com"}}
+		offline = false
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pluginHome)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		actor.UseRepositoryCache(offline)
+		pluginInfo, _, executeErr = actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", repos, "linux64")
+	})
+
+	Context("when the repository has not been cached", func() {
+		It("fetches the catalog and caches it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pluginInfo.Version).To(Equal("2.0.0"))
+			Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(1))
+			Expect(cachePath("https://some-repo.com")).To(BeAnExistingFile())
+		})
+
+		Context("when offline", func() {
+			BeforeEach(func() {
+				offline = true
+			})
+
+			It("returns a PluginRepositoryNotCachedError without contacting the repository", func() {
+				Expect(executeErr).To(MatchError(FetchingPluginInfoFromRepositoryError{
+					RepositoryName: "some-repo",
+					Err:            PluginRepositoryNotCachedError{URL: "https://some-repo.com"},
+				}))
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the cached catalog is fresh", func() {
+		BeforeEach(func() {
+			writeCache("https://some-repo.com", time.Now(), "1.0.0")
+		})
+
+		It("uses the cached catalog", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pluginInfo.Version).To(Equal("1.0.0"))
+			Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the cached catalog is stale", func() {
+		BeforeEach(func() {
+			writeCache("https://some-repo.com", time.Now().Add(-2*PluginRepositoryCacheTTL), "1.0.0")
+		})
+
+		It("refreshes the catalog", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pluginInfo.Version).To(Equal("2.0.0"))
+			Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(1))
+		})
+
+		Context("when offline", func() {
+			BeforeEach(func() {
+				offline = true
+			})
+
+			It("uses the stale cached catalog", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pluginInfo.Version).To(Equal("1.0.0"))
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when refreshing fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(FetchingPluginInfoFromRepositoryError{
+					RepositoryName: "some-repo",
+					Err:            expectedErr,
+				}))
+			})
+		})
+	})
+
+	Context("when the repository's URL has changed", func() {
+		BeforeEach(func() {
+			writeCache("https://old-repo.com", time.Now(), "1.0.0")
+			repos = []configv3.PluginRepository{{Name: "some-repo", URL: "https://new-repo.com"}}
+		})
+
+		It("does not use the catalog cached for the old URL", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pluginInfo.Version).To(Equal("2.0.0"))
+			Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://new-repo.com"))
+		})
+	})
+})
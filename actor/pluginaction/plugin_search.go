@@ -0,0 +1,62 @@
+package pluginaction
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Warnings is a list of warnings returned back from plugin actions.
+type Warnings []string
+
+// PluginSearchResult is a plugin found in a registered plugin repository.
+type PluginSearchResult struct {
+	RepositoryName string
+	Name           string
+	Version        string
+	Description    string
+}
+
+// SearchPluginRepositories returns the plugins, in every registered
+// repository, whose name or description contains query (case insensitive).
+// Repositories that cannot be reached are skipped and reported as warnings;
+// an error is only returned when none of the repositories could be reached.
+func (actor Actor) SearchPluginRepositories(query string) ([]PluginSearchResult, Warnings, error) {
+	var (
+		results  []PluginSearchResult
+		warnings Warnings
+		lastErr  error
+	)
+
+	repositories := actor.config.PluginRepositories()
+	loweredQuery := strings.ToLower(query)
+
+	for _, repository := range repositories {
+		pluginRepository, err := actor.getPluginRepository(repository.URL)
+		if err != nil {
+			lastErr = FetchingPluginInfoFromRepositoryError{
+				RepositoryName: repository.Name,
+				Err:            err,
+			}
+			warnings = append(warnings, fmt.Sprintf("Skipping plugin repository %s: %s", repository.Name, err.Error()))
+			continue
+		}
+
+		for _, plugin := range pluginRepository.Plugins {
+			if strings.Contains(strings.ToLower(plugin.Name), loweredQuery) ||
+				strings.Contains(strings.ToLower(plugin.Description), loweredQuery) {
+				results = append(results, PluginSearchResult{
+					RepositoryName: repository.Name,
+					Name:           plugin.Name,
+					Version:        plugin.Version,
+					Description:    plugin.Description,
+				})
+			}
+		}
+	}
+
+	if len(repositories) > 0 && len(warnings) == len(repositories) {
+		return nil, warnings, lastErr
+	}
+
+	return results, warnings, nil
+}
@@ -0,0 +1,128 @@
+package pluginaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Search Actions", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+	})
+
+	Describe("SearchPluginRepositories", func() {
+		var (
+			results    []PluginSearchResult
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "repo-1", URL: "https://repo-1.com"},
+				{Name: "repo-2", URL: "https://repo-2.com"},
+			})
+		})
+
+		JustBeforeEach(func() {
+			results, warnings, executeErr = actor.SearchPluginRepositories("Log")
+		})
+
+		Context("when all repositories are reachable", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryStub = func(repositoryURL string) (plugin.PluginRepository, error) {
+					switch repositoryURL {
+					case "https://repo-1.com":
+						return plugin.PluginRepository{
+							Plugins: []plugin.Plugin{
+								{Name: "log-tail", Version: "1.0.0", Description: "tails logs"},
+								{Name: "unrelated", Version: "2.0.0", Description: "does other things"},
+							},
+						}, nil
+					default:
+						return plugin.PluginRepository{
+							Plugins: []plugin.Plugin{
+								{Name: "firehose", Version: "0.3.1", Description: "Streams LOGS from the firehose"},
+							},
+						}, nil
+					}
+				}
+			})
+
+			It("returns the plugins whose name or description match, case insensitively", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+				Expect(results).To(Equal([]PluginSearchResult{
+					{RepositoryName: "repo-1", Name: "log-tail", Version: "1.0.0", Description: "tails logs"},
+					{RepositoryName: "repo-2", Name: "firehose", Version: "0.3.1", Description: "Streams LOGS from the firehose"},
+				}))
+
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(2))
+				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://repo-1.com"))
+				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(1)).To(Equal("https://repo-2.com"))
+			})
+		})
+
+		Context("when a repository is unreachable", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryStub = func(repositoryURL string) (plugin.PluginRepository, error) {
+					if repositoryURL == "https://repo-1.com" {
+						return plugin.PluginRepository{}, errors.New("connection refused")
+					}
+					return plugin.PluginRepository{
+						Plugins: []plugin.Plugin{{Name: "log-tail", Version: "1.0.0"}},
+					}, nil
+				}
+			})
+
+			It("skips the repository and returns a warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("Skipping plugin repository repo-1: connection refused"))
+				Expect(results).To(Equal([]PluginSearchResult{
+					{RepositoryName: "repo-2", Name: "log-tail", Version: "1.0.0"},
+				}))
+			})
+		})
+
+		Context("when every repository is unreachable", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("connection refused"))
+			})
+
+			It("returns a FetchingPluginInfoFromRepositoryError and the warnings", func() {
+				Expect(executeErr).To(MatchError(FetchingPluginInfoFromRepositoryError{
+					RepositoryName: "repo-2",
+					Err:            errors.New("connection refused"),
+				}))
+				Expect(warnings).To(HaveLen(2))
+				Expect(results).To(BeEmpty())
+			})
+		})
+
+		Context("when there are no registered repositories", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns no results", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(results).To(BeEmpty())
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
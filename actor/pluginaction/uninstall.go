@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/exec"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 // PluginNotFoundError is an error returned when a plugin is not found.
@@ -41,51 +43,125 @@ func (p PluginExecuteError) Error() string {
 	return p.Err.Error()
 }
 
+// PluginUninstallError wraps an error encountered while uninstalling one of
+// the plugins in UninstallAllPlugins.
+type PluginUninstallError struct {
+	PluginName string
+	Path       string
+	Err        error
+}
+
+func (e PluginUninstallError) Error() string {
+	return fmt.Sprintf("Failed to uninstall plugin %s from %s: %s", e.PluginName, e.Path, e.Err)
+}
+
 func (actor Actor) UninstallPlugin(uninstaller PluginUninstaller, name string) error {
 	plugin, exist := actor.config.GetPlugin(name)
 	if !exist {
 		return PluginNotFoundError{PluginName: name}
 	}
 
-	var binaryErr error
+	binaryErr := actor.uninstallPluginBinary(uninstaller, plugin)
+	if binaryErr != nil && !isPluginBinaryError(binaryErr) {
+		return binaryErr
+	}
 
-	if actor.FileExists(plugin.Location) {
-		err := uninstaller.Run(plugin.Location, "CLI-MESSAGE-UNINSTALL")
+	actor.config.RemovePlugin(name)
+	err := actor.config.WritePluginConfig()
+	if err != nil {
+		return err
+	}
+
+	return binaryErr
+}
+
+// UninstallAllPlugins runs the uninstall hook of every installed plugin and
+// removes them from the plugin config. Errors are collected, wrapped in a
+// PluginUninstallError, rather than stopping the uninstall of the remaining
+// plugins; the plugin config is only written once all plugins have been
+// processed.
+func (actor Actor) UninstallAllPlugins(uninstaller PluginUninstaller) ([]string, []error) {
+	var (
+		uninstalled []string
+		errs        []error
+	)
+
+	for _, plugin := range actor.config.Plugins() {
+		err := actor.uninstallPluginBinary(uninstaller, plugin)
 		if err != nil {
-			switch err.(type) {
-			case *exec.ExitError:
-				binaryErr = PluginExecuteError{
-					Err: err,
-				}
-			case *os.PathError:
-				binaryErr = PluginExecuteError{
-					Err: err,
-				}
-			default:
-				return err
+			errs = append(errs, PluginUninstallError{
+				PluginName: plugin.Name,
+				Path:       plugin.Location,
+				Err:        err,
+			})
+			if !isPluginBinaryError(err) {
+				continue
 			}
 		}
 
-		// No test for sleeping for 500 ms for parity with pre-refactored behavior.
-		time.Sleep(500 * time.Millisecond)
-
-		err = os.Remove(plugin.Location)
-		if err != nil && !os.IsNotExist(err) {
-			if _, isPathError := err.(*os.PathError); isPathError {
-				binaryErr = PluginBinaryRemoveFailedError{
-					Err: err,
-				}
-			} else {
-				return err
-			}
+		actor.config.RemovePlugin(plugin.Name)
+		uninstalled = append(uninstalled, plugin.Name)
+	}
+
+	if len(uninstalled) > 0 {
+		err := actor.config.WritePluginConfig()
+		if err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	actor.config.RemovePlugin(name)
-	err := actor.config.WritePluginConfig()
+	return uninstalled, errs
+}
+
+// uninstallPluginBinary runs the plugin's uninstall hook and removes its
+// binary. Failures of the hook or of the binary removal are returned as
+// PluginExecuteError and PluginBinaryRemoveFailedError respectively; any
+// other error means the plugin should be left installed.
+func (actor Actor) uninstallPluginBinary(uninstaller PluginUninstaller, plugin configv3.Plugin) error {
+	if !actor.FileExists(plugin.Location) {
+		return nil
+	}
+
+	var binaryErr error
+
+	err := uninstaller.Run(plugin.Location, "CLI-MESSAGE-UNINSTALL")
 	if err != nil {
-		return err
+		switch err.(type) {
+		case *exec.ExitError:
+			binaryErr = PluginExecuteError{
+				Err: err,
+			}
+		case *os.PathError:
+			binaryErr = PluginExecuteError{
+				Err: err,
+			}
+		default:
+			return err
+		}
+	}
+
+	// No test for sleeping for 500 ms for parity with pre-refactored behavior.
+	time.Sleep(500 * time.Millisecond)
+
+	err = os.Remove(plugin.Location)
+	if err != nil && !os.IsNotExist(err) {
+		if _, isPathError := err.(*os.PathError); isPathError {
+			binaryErr = PluginBinaryRemoveFailedError{
+				Err: err,
+			}
+		} else {
+			return err
+		}
 	}
 
 	return binaryErr
 }
+
+func isPluginBinaryError(err error) bool {
+	switch err.(type) {
+	case PluginExecuteError, PluginBinaryRemoveFailedError:
+		return true
+	default:
+		return false
+	}
+}
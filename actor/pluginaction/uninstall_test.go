@@ -223,4 +223,139 @@ var _ = Describe("Plugin actor", func() {
 			})
 		})
 	})
+
+	Describe("UninstallAllPlugins", func() {
+		var (
+			fakePluginUninstaller *pluginactionfakes.FakePluginUninstaller
+			pluginHome            string
+			binaryPath1           string
+			binaryPath2           string
+			err                   error
+
+			uninstalled []string
+			errs        []error
+		)
+
+		BeforeEach(func() {
+			pluginHome, err = ioutil.TempDir("", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			binaryPath1 = filepath.Join(pluginHome, "plugin-1")
+			err = ioutil.WriteFile(binaryPath1, nil, 0600)
+			Expect(err).ToNot(HaveOccurred())
+			binaryPath2 = filepath.Join(pluginHome, "plugin-2")
+			err = ioutil.WriteFile(binaryPath2, nil, 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "plugin-1", Location: binaryPath1},
+				{Name: "plugin-2", Location: binaryPath2},
+			})
+
+			fakePluginUninstaller = new(pluginactionfakes.FakePluginUninstaller)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(pluginHome)
+		})
+
+		JustBeforeEach(func() {
+			uninstalled, errs = actor.UninstallAllPlugins(fakePluginUninstaller)
+		})
+
+		Context("when no errors are encountered", func() {
+			It("uninstalls every plugin and writes the config once", func() {
+				Expect(errs).To(BeEmpty())
+				Expect(uninstalled).To(Equal([]string{"plugin-1", "plugin-2"}))
+
+				Expect(fakePluginUninstaller.RunCallCount()).To(Equal(2))
+				path, command := fakePluginUninstaller.RunArgsForCall(0)
+				Expect(path).To(Equal(binaryPath1))
+				Expect(command).To(Equal("CLI-MESSAGE-UNINSTALL"))
+				path, _ = fakePluginUninstaller.RunArgsForCall(1)
+				Expect(path).To(Equal(binaryPath2))
+
+				_, err = os.Stat(binaryPath1)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+				_, err = os.Stat(binaryPath2)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+
+				Expect(fakeConfig.RemovePluginCallCount()).To(Equal(2))
+				Expect(fakeConfig.RemovePluginArgsForCall(0)).To(Equal("plugin-1"))
+				Expect(fakeConfig.RemovePluginArgsForCall(1)).To(Equal("plugin-2"))
+				Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when no plugins are installed", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns(nil)
+			})
+
+			It("does not write the config", func() {
+				Expect(errs).To(BeEmpty())
+				Expect(uninstalled).To(BeEmpty())
+				Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a plugin uninstaller returns an exec.ExitError", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = &exec.ExitError{}
+				fakePluginUninstaller.RunReturnsOnCall(0, expectedErr)
+			})
+
+			It("returns the error and still removes every plugin", func() {
+				Expect(errs).To(ConsistOf(PluginUninstallError{
+					PluginName: "plugin-1",
+					Path:       binaryPath1,
+					Err:        PluginExecuteError{Err: expectedErr},
+				}))
+				Expect(uninstalled).To(Equal([]string{"plugin-1", "plugin-2"}))
+
+				Expect(fakeConfig.RemovePluginCallCount()).To(Equal(2))
+				Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a plugin uninstaller returns any other error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakePluginUninstaller.RunReturnsOnCall(0, expectedErr)
+			})
+
+			It("returns the error, leaves that plugin installed and continues with the rest", func() {
+				Expect(errs).To(ConsistOf(PluginUninstallError{
+					PluginName: "plugin-1",
+					Path:       binaryPath1,
+					Err:        expectedErr,
+				}))
+				Expect(uninstalled).To(Equal([]string{"plugin-2"}))
+
+				_, err = os.Stat(binaryPath1)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.RemovePluginCallCount()).To(Equal(1))
+				Expect(fakeConfig.RemovePluginArgsForCall(0)).To(Equal("plugin-2"))
+				Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when writing the config returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some plugin config write error")
+				fakeConfig.WritePluginConfigReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(errs).To(ConsistOf(expectedErr))
+			})
+		})
+	})
 })
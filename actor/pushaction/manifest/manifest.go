@@ -5,21 +5,37 @@ import (
 	"io/ioutil"
 	"path/filepath"
 
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"github.com/cloudfoundry/bytefmt"
 
 	yaml "gopkg.in/yaml.v2"
 )
 
 type Manifest struct {
+	// Inherit is the path of a parent manifest, relative to this manifest.
+	Inherit      string        `yaml:"inherit"`
 	Applications []Application `yaml:"applications"`
 }
 
+// InheritanceCycleError is returned when a manifest inherits from itself,
+// directly or through its parents.
+type InheritanceCycleError struct {
+	Path string
+}
+
+func (e InheritanceCycleError) Error() string {
+	return fmt.Sprintf("Manifest '%s' inherits from itself.", e.Path)
+}
+
 type Application struct {
 	BuildpackName string
 	Command       string
 	// DiskQuota is the disk size in megabytes.
-	DiskQuota               uint64
-	DockerImage             string
+	DiskQuota   uint64
+	DockerImage string
+	// Env is the application's environment variables. Values keep the type
+	// they have in the manifest.
+	Env                     map[string]interface{}
 	HealthCheckHTTPEndpoint string
 	// HealthCheckType attribute defines the number of seconds that is allocated
 	// for starting an application.
@@ -27,9 +43,15 @@ type Application struct {
 	HealthCheckType    string
 	Instances          int
 	// Memory is the amount of memory in megabytes.
-	Memory    uint64
-	Name      string
-	Path      string
+	Memory uint64
+	Name   string
+	Path   string
+	// Routes are the application's routes, as host.domain/path for HTTP routes
+	// and domain:port for TCP routes.
+	Routes []string
+	// Services are the names of the service instances bound to the
+	// application.
+	Services  []string
 	StackName string
 }
 
@@ -53,17 +75,20 @@ func (app Application) String() string {
 
 func (a *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error {
 	var manifestApp struct {
-		Buildpack               string `yaml:"buildpack"`
-		Command                 string `yaml:"command"`
-		DiskQuota               string `yaml:"disk_quota"`
-		HealthCheckHTTPEndpoint string `yaml:"health-check-http-endpoint"`
-		HealthCheckType         string `yaml:"health-check-type"`
-		Instances               int    `yaml:"instances"`
-		Memory                  string `yaml:"memory"`
-		Name                    string `yaml:"name"`
-		Path                    string `yaml:"path"`
-		StackName               string `yaml:"stack"`
-		Timeout                 int    `yaml:"timeout"`
+		Buildpack               string                   `yaml:"buildpack"`
+		Command                 string                   `yaml:"command"`
+		DiskQuota               string                   `yaml:"disk_quota"`
+		Env                     map[string]interface{}   `yaml:"env"`
+		HealthCheckHTTPEndpoint string                   `yaml:"health-check-http-endpoint"`
+		HealthCheckType         string                   `yaml:"health-check-type"`
+		Instances               int                      `yaml:"instances"`
+		Memory                  string                   `yaml:"memory"`
+		Name                    string                   `yaml:"name"`
+		Path                    string                   `yaml:"path"`
+		Routes                  []struct{ Route string } `yaml:"routes"`
+		Services                []string                 `yaml:"services"`
+		StackName               string                   `yaml:"stack"`
+		Timeout                 int                      `yaml:"timeout"`
 	}
 
 	err := unmarshaller(&manifestApp)
@@ -78,9 +103,21 @@ func (a *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error
 	a.Instances = manifestApp.Instances
 	a.Name = manifestApp.Name
 	a.Path = manifestApp.Path
+	a.Services = manifestApp.Services
 	a.StackName = manifestApp.StackName
 	a.HealthCheckTimeout = manifestApp.Timeout
 
+	for _, route := range manifestApp.Routes {
+		a.Routes = append(a.Routes, route.Route)
+	}
+
+	if manifestApp.Env != nil {
+		a.Env = map[string]interface{}{}
+		for name, value := range manifestApp.Env {
+			a.Env[name] = jsonCompatible(value)
+		}
+	}
+
 	if manifestApp.DiskQuota != "" {
 		disk, err := bytefmt.ToMegabytes(manifestApp.DiskQuota)
 		if err != nil {
@@ -100,13 +137,37 @@ func (a *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error
 	return nil
 }
 
-func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
+// ReadAndMergeManifests reads the manifest at pathToManifest, replacing its
+// ((variable)) placeholders with their values from vars. When the manifest
+// inherits from a parent manifest, the parent is read the same way and each
+// of its applications is merged with the application of the same name;
+// settings in the child take precedence and applications only in the parent
+// are kept.
+func ReadAndMergeManifests(pathToManifest string, vars map[string]interface{}) ([]Application, error) {
+	return readAndMergeManifests(pathToManifest, vars, map[string]bool{})
+}
+
+func readAndMergeManifests(pathToManifest string, vars map[string]interface{}, seen map[string]bool) ([]Application, error) {
+	absPath, err := filepath.Abs(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+	if seen[absPath] {
+		return nil, InheritanceCycleError{Path: pathToManifest}
+	}
+	seen[absPath] = true
+
 	// Read all manifest files
 	raw, err := ioutil.ReadFile(pathToManifest)
 	if err != nil {
 		return nil, err
 	}
 
+	raw, err = manifestparser.InterpolateVariables(raw, vars)
+	if err != nil {
+		return nil, err
+	}
+
 	var manifest Manifest
 	err = yaml.Unmarshal(raw, &manifest)
 	if err != nil {
@@ -119,6 +180,123 @@ func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
 		}
 	}
 
+	if manifest.Inherit == "" {
+		return manifest.Applications, nil
+	}
+
+	parentPath := manifest.Inherit
+	if !filepath.IsAbs(parentPath) {
+		parentPath = filepath.Join(filepath.Dir(pathToManifest), parentPath)
+	}
+	parentApps, err := readAndMergeManifests(parentPath, vars, seen)
+	if err != nil {
+		return nil, err
+	}
+
 	// Merge all manifest files
-	return manifest.Applications, err
+	return mergeApplications(parentApps, manifest.Applications), nil
+}
+
+func mergeApplications(parentApps []Application, childApps []Application) []Application {
+	var merged []Application
+
+	childAppsByName := map[string]Application{}
+	for _, app := range childApps {
+		childAppsByName[app.Name] = app
+	}
+
+	for _, parentApp := range parentApps {
+		if childApp, ok := childAppsByName[parentApp.Name]; ok {
+			merged = append(merged, mergeApplication(parentApp, childApp))
+			delete(childAppsByName, parentApp.Name)
+		} else {
+			merged = append(merged, parentApp)
+		}
+	}
+
+	for _, app := range childApps {
+		if _, ok := childAppsByName[app.Name]; ok {
+			merged = append(merged, app)
+		}
+	}
+
+	return merged
+}
+
+func mergeApplication(parent Application, child Application) Application {
+	merged := parent
+
+	if child.BuildpackName != "" {
+		merged.BuildpackName = child.BuildpackName
+	}
+	if child.Command != "" {
+		merged.Command = child.Command
+	}
+	if child.DiskQuota != 0 {
+		merged.DiskQuota = child.DiskQuota
+	}
+	if child.DockerImage != "" {
+		merged.DockerImage = child.DockerImage
+	}
+	if child.HealthCheckHTTPEndpoint != "" {
+		merged.HealthCheckHTTPEndpoint = child.HealthCheckHTTPEndpoint
+	}
+	if child.HealthCheckTimeout != 0 {
+		merged.HealthCheckTimeout = child.HealthCheckTimeout
+	}
+	if child.HealthCheckType != "" {
+		merged.HealthCheckType = child.HealthCheckType
+	}
+	if child.Instances != 0 {
+		merged.Instances = child.Instances
+	}
+	if child.Memory != 0 {
+		merged.Memory = child.Memory
+	}
+	if child.Path != "" {
+		merged.Path = child.Path
+	}
+	if len(child.Routes) > 0 {
+		merged.Routes = child.Routes
+	}
+	if len(child.Services) > 0 {
+		merged.Services = child.Services
+	}
+	if child.StackName != "" {
+		merged.StackName = child.StackName
+	}
+
+	if len(child.Env) > 0 {
+		merged.Env = map[string]interface{}{}
+		for name, value := range parent.Env {
+			merged.Env[name] = value
+		}
+		for name, value := range child.Env {
+			merged.Env[name] = value
+		}
+	}
+
+	return merged
+}
+
+// jsonCompatible converts the map[interface{}]interface{} values produced by
+// the YAML parser to map[string]interface{}, so that the value can be sent to
+// the Cloud Controller as JSON.
+func jsonCompatible(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, nestedValue := range typedValue {
+			converted[fmt.Sprint(key)] = jsonCompatible(nestedValue)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typedValue))
+		for i, nestedValue := range typedValue {
+			converted[i] = jsonCompatible(nestedValue)
+		}
+		return converted
+	default:
+		return value
+	}
 }
@@ -3,8 +3,10 @@ package manifest_test
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -32,12 +34,17 @@ var _ = Describe("Manifest", func() {
 
 	Describe("ReadAndMergeManifests", func() {
 		var (
+			vars       map[string]interface{}
 			apps       []Application
 			executeErr error
 		)
 
+		BeforeEach(func() {
+			vars = nil
+		})
+
 		JustBeforeEach(func() {
-			apps, executeErr = ReadAndMergeManifests(pathToManifest)
+			apps, executeErr = ReadAndMergeManifests(pathToManifest, vars)
 		})
 
 		BeforeEach(func() {
@@ -83,5 +90,141 @@ applications:
 				Application{Name: "app-3"},
 			))
 		})
+
+		Context("when the manifest contains variables", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: ((name))
+  instances: ((instances))
+  memory: ((memory))
+`
+				vars = map[string]interface{}{
+					"name":      "app-1",
+					"instances": 3,
+					"memory":    "512M",
+				}
+			})
+
+			It("substitutes their values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(Application{
+					Name:      "app-1",
+					Instances: 3,
+					Memory:    512,
+				}))
+			})
+
+			Context("when a variable has no value", func() {
+				BeforeEach(func() {
+					delete(vars, "memory")
+				})
+
+				It("returns an UnresolvedVariablesError", func() {
+					Expect(executeErr).To(MatchError(manifestparser.UnresolvedVariablesError{Names: []string{"memory"}}))
+				})
+			})
+		})
+
+		Context("when the manifest contains env, routes and services", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  env:
+    STRING: some-value
+    PORT: 8080
+    NESTED:
+      key: value
+  routes:
+  - route: app-1.example.com/some-path
+  - route: tcp.example.com:1024
+  services:
+  - some-service
+`
+			})
+
+			It("reads them, keeping the env value types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(Application{
+					Name: "app-1",
+					Env: map[string]interface{}{
+						"STRING": "some-value",
+						"PORT":   8080,
+						"NESTED": map[string]interface{}{"key": "value"},
+					},
+					Routes:   []string{"app-1.example.com/some-path", "tcp.example.com:1024"},
+					Services: []string{"some-service"},
+				}))
+			})
+		})
+
+		Context("when the manifest inherits from a parent", func() {
+			var parentPath string
+
+			BeforeEach(func() {
+				tempFile, err := ioutil.TempFile("", "manifest-parent-test-")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).ToNot(HaveOccurred())
+				parentPath = tempFile.Name()
+
+				err = ioutil.WriteFile(parentPath, []byte(`---
+applications:
+- name: app-1
+  memory: 256M
+  instances: 2
+  env:
+    FROM_PARENT: parent
+    OVERRIDDEN: parent
+- name: parent-only-app
+`), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				manifest = `---
+inherit: ` + filepath.Base(parentPath) + `
+applications:
+- name: app-1
+  instances: ((instances))
+  env:
+    OVERRIDDEN: child
+- name: child-only-app
+`
+				vars = map[string]interface{}{"instances": 3}
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(parentPath)).ToNot(HaveOccurred())
+			})
+
+			It("merges the parent's applications into the child's", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{
+						Name:      "app-1",
+						Memory:    256,
+						Instances: 3,
+						Env: map[string]interface{}{
+							"FROM_PARENT": "parent",
+							"OVERRIDDEN":  "child",
+						},
+					},
+					{Name: "parent-only-app"},
+					{Name: "child-only-app"},
+				}))
+			})
+
+			Context("when the parent inherits from the child", func() {
+				JustBeforeEach(func() {
+					err := ioutil.WriteFile(parentPath, []byte("inherit: "+filepath.Base(pathToManifest)+"\n"), 0666)
+					Expect(err).ToNot(HaveOccurred())
+
+					apps, executeErr = ReadAndMergeManifests(pathToManifest, vars)
+				})
+
+				It("returns an InheritanceCycleError", func() {
+					Expect(executeErr).To(MatchError(InheritanceCycleError{Path: pathToManifest}))
+				})
+			})
+		})
 	})
 })
@@ -2,7 +2,7 @@ package pushaction
 
 import "code.cloudfoundry.org/cli/actor/pushaction/manifest"
 
-func (*Actor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+func (*Actor) ReadManifest(pathToManifest string, vars map[string]interface{}) ([]manifest.Application, error) {
 	// Cover method to make testing easier
-	return manifest.ReadAndMergeManifests(pathToManifest)
+	return manifest.ReadAndMergeManifests(pathToManifest, vars)
 }
@@ -0,0 +1,26 @@
+package sharedaction
+
+// Warnings accumulates the warnings returned by a sequence of actor or client
+// calls so that none of them are dropped along the way.
+type Warnings []string
+
+// Append adds the given warnings to the accumulated warnings. It accepts the
+// Warnings type of any actor or client.
+func (w *Warnings) Append(warnings []string) {
+	*w = append(*w, warnings...)
+}
+
+// Collect runs the given calls in order, appending the warnings returned by
+// each of them. It stops at, and returns, the first error encountered; the
+// warnings of the failing call are still appended.
+func (w *Warnings) Collect(calls ...func() ([]string, error)) error {
+	for _, call := range calls {
+		warnings, err := call()
+		w.Append(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
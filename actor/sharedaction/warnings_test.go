@@ -0,0 +1,88 @@
+package sharedaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Warnings", func() {
+	var warnings Warnings
+
+	BeforeEach(func() {
+		warnings = nil
+	})
+
+	Describe("Append", func() {
+		It("adds the warnings to the accumulated warnings", func() {
+			warnings.Append([]string{"warning-1"})
+			warnings.Append(nil)
+			warnings.Append([]string{"warning-2", "warning-3"})
+
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+		})
+	})
+
+	Describe("Collect", func() {
+		var (
+			calls      []string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			calls = nil
+		})
+
+		Context("when none of the calls return an error", func() {
+			BeforeEach(func() {
+				executeErr = warnings.Collect(
+					func() ([]string, error) {
+						calls = append(calls, "call-1")
+						return []string{"warning-1"}, nil
+					},
+					func() ([]string, error) {
+						calls = append(calls, "call-2")
+						return []string{"warning-2"}, nil
+					},
+				)
+			})
+
+			It("runs every call and collects all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(calls).To(Equal([]string{"call-1", "call-2"}))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2"}))
+			})
+		})
+
+		Context("when a call returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				executeErr = warnings.Collect(
+					func() ([]string, error) {
+						calls = append(calls, "call-1")
+						return []string{"warning-1"}, nil
+					},
+					func() ([]string, error) {
+						calls = append(calls, "call-2")
+						return []string{"warning-2"}, expectedErr
+					},
+					func() ([]string, error) {
+						calls = append(calls, "call-3")
+						return []string{"warning-3"}, nil
+					},
+				)
+			})
+
+			It("stops at the error and keeps the warnings collected so far", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(calls).To(Equal([]string{"call-1", "call-2"}))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2"}))
+			})
+		})
+	})
+})
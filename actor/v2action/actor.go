@@ -11,6 +11,9 @@ type Actor struct {
 	UAAClient             UAAClient
 
 	domainCache map[string]Domain
+	stackCache  map[string]Stack
+
+	caseInsensitiveAppNames bool
 }
 
 // NewActor returns a new actor.
@@ -2,10 +2,14 @@ package v2action
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/poll"
 )
 
 type ApplicationState string
@@ -16,6 +20,20 @@ const (
 	ApplicationStateStarting = ApplicationState("starting")
 )
 
+// MinimumScaledMemory is the smallest amount of memory, in megabytes, that
+// ScaleApplicationBy will give each instance of an application.
+const MinimumScaledMemory = 64
+
+// ApplicationAlreadyExistsError is returned when an application with the
+// requested name already exists in the space.
+type ApplicationAlreadyExistsError struct {
+	Name string
+}
+
+func (e ApplicationAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Application '%s' already exists.", e.Name)
+}
+
 // ApplicationInstanceCrashedError is returned when an instance crashes.
 type ApplicationInstanceCrashedError struct {
 	Name string
@@ -49,6 +67,55 @@ func (e ApplicationNotFoundError) Error() string {
 	return fmt.Sprintf("Application '%s' not found.", e.Name)
 }
 
+// MultipleApplicationsFoundError is returned when a case-insensitive
+// application lookup matches more than one application.
+type MultipleApplicationsFoundError struct {
+	Name  string
+	Names []string
+}
+
+func (e MultipleApplicationsFoundError) Error() string {
+	return fmt.Sprintf("Application name '%s' matches multiple applications: %s", e.Name, strings.Join(e.Names, ", "))
+}
+
+// ApplicationNotStagedError is returned when an application that has never
+// been staged is used as a source of application bits.
+type ApplicationNotStagedError struct {
+	Name string
+}
+
+func (e ApplicationNotStagedError) Error() string {
+	return fmt.Sprintf("Application '%s' has not been staged.", e.Name)
+}
+
+// DeleteApplicationsError is returned when one or more applications could not
+// be deleted. Errors is keyed by application GUID.
+type DeleteApplicationsError struct {
+	Errors map[string]error
+}
+
+func (e DeleteApplicationsError) Error() string {
+	guids := make([]string, 0, len(e.Errors))
+	for guid := range e.Errors {
+		guids = append(guids, guid)
+	}
+	sort.Strings(guids)
+
+	failures := make([]string, len(guids))
+	for i, guid := range guids {
+		failures[i] = fmt.Sprintf("%s: %s", guid, e.Errors[guid])
+	}
+	return fmt.Sprintf("Failed to delete %d application(s): %s", len(guids), strings.Join(failures, "; "))
+}
+
+// EmptyApplicationNameError is returned when an application is given an empty
+// name.
+type EmptyApplicationNameError struct{}
+
+func (EmptyApplicationNameError) Error() string {
+	return "Application name cannot be empty."
+}
+
 // HTTPHealthCheckInvalidError is returned when an HTTP endpoint is used with a
 // health check type that is not HTTP.
 type HTTPHealthCheckInvalidError struct {
@@ -58,6 +125,18 @@ func (e HTTPHealthCheckInvalidError) Error() string {
 	return "Health check type must be 'http' to set a health check HTTP endpoint"
 }
 
+// ScaleBelowMinimumError is returned when scaling an application by a
+// percentage would leave it with fewer than one instance or less than
+// MinimumScaledMemory megabytes of memory per instance.
+type ScaleBelowMinimumError struct {
+	Resource string
+	Percent  float64
+}
+
+func (e ScaleBelowMinimumError) Error() string {
+	return fmt.Sprintf("Scaling %s by %g%% would go below the minimum allowed.", e.Resource, e.Percent)
+}
+
 // StagingFailedError is returned when staging an application fails.
 type StagingFailedError struct {
 	Reason string
@@ -88,15 +167,29 @@ func (e StagingTimeoutError) Error() string {
 }
 
 // StartupTimeoutError is returned when startup timeout is reached waiting for
-// an application to start.
+// an application to start. InstanceStates holds the last observed state of
+// each instance, when it is known.
 type StartupTimeoutError struct {
-	Name string
+	Name           string
+	InstanceStates map[int]ApplicationInstanceState
 }
 
 func (e StartupTimeoutError) Error() string {
 	return fmt.Sprintf("Timed out waiting for application '%s' to start", e.Name)
 }
 
+// StopTimeoutError is returned when startup timeout is reached waiting for
+// the instances of an application to stop. InstanceStates holds the last
+// observed state of each instance.
+type StopTimeoutError struct {
+	Name           string
+	InstanceStates map[int]ApplicationInstanceState
+}
+
+func (e StopTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for application '%s' to stop", e.Name)
+}
+
 // Application represents an application.
 type Application ccv2.Application
 
@@ -171,12 +264,102 @@ func (app Application) String() string {
 	)
 }
 
+// CopyApplicationBits copies the bits of the source application to the
+// target application and waits for the copy to complete. Both applications
+// must exist, and the source application must have been staged.
+func (actor Actor) CopyApplicationBits(sourceAppGUID string, targetAppGUID string) (Warnings, error) {
+	sourceApp, allWarnings, err := actor.GetApplication(sourceAppGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if !sourceApp.StagingCompleted() {
+		return allWarnings, ApplicationNotStagedError{Name: sourceApp.Name}
+	}
+
+	_, warnings, err := actor.GetApplication(targetAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	job, ccWarnings, err := actor.CloudControllerClient.CopyApplicationBits(sourceAppGUID, targetAppGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.PollJob(Job(job))
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
 // CreateApplication creates an application.
 func (actor Actor) CreateApplication(application Application) (Application, Warnings, error) {
 	app, warnings, err := actor.CloudControllerClient.CreateApplication(ccv2.Application(application))
 	return Application(app), Warnings(warnings), err
 }
 
+// CreateApplicationIfNotExists returns the application with the same name in
+// the application's space, creating it only when it does not exist yet. The
+// returned bool is true when the application was created. If another client
+// creates the application between the lookup and the create, the existing
+// application is returned.
+func (actor Actor) CreateApplicationIfNotExists(application Application) (Application, bool, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(application.Name, application.SpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err == nil {
+		return app, false, allWarnings, nil
+	}
+	if _, ok := err.(ApplicationNotFoundError); !ok {
+		return Application{}, false, allWarnings, err
+	}
+
+	app, warnings, err = actor.CreateApplication(application)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.NameNotUniqueInSpaceError); ok {
+		app, warnings, err = actor.GetApplicationByNameAndSpace(application.Name, application.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		return app, false, allWarnings, err
+	}
+	if err != nil {
+		return Application{}, false, allWarnings, err
+	}
+
+	return app, true, allWarnings, nil
+}
+
+// DeleteApplications deletes the applications with the provided GUIDs along
+// with their service bindings and routes. A failure to delete one application
+// does not stop the others from being deleted; the GUIDs of the applications
+// that were fully deleted are returned alongside a DeleteApplicationsError
+// describing the failures.
+func (actor Actor) DeleteApplications(guids []string) ([]string, Warnings, error) {
+	var (
+		allWarnings Warnings
+		deleted     []string
+	)
+	failures := map[string]error{}
+
+	for _, guid := range guids {
+		warnings, err := actor.deleteApplicationAndRoutes(guid)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			failures[guid] = err
+			continue
+		}
+		deleted = append(deleted, guid)
+	}
+
+	if len(failures) > 0 {
+		return deleted, allWarnings, DeleteApplicationsError{Errors: failures}
+	}
+
+	return deleted, allWarnings, nil
+}
+
 // GetApplication returns the application.
 func (actor Actor) GetApplication(guid string) (Application, Warnings, error) {
 	app, warnings, err := actor.CloudControllerClient.GetApplication(guid)
@@ -209,6 +392,10 @@ func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (
 	}
 
 	if len(app) == 0 {
+		if actor.caseInsensitiveAppNames {
+			return actor.getApplicationByNameIgnoringCase(name, spaceGUID, Warnings(warnings))
+		}
+
 		return Application{}, Warnings(warnings), ApplicationNotFoundError{
 			Name: name,
 		}
@@ -217,7 +404,49 @@ func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (
 	return Application(app[0]), Warnings(warnings), nil
 }
 
-// GetApplicationsBySpace returns all applications in a space.
+// EnableCaseInsensitiveAppNames makes GetApplicationByNameAndSpace fall back
+// to matching application names regardless of case when there is no exact
+// match. Application names are case sensitive in the Cloud Controller, so
+// exact matching remains the default.
+func (actor *Actor) EnableCaseInsensitiveAppNames() {
+	actor.caseInsensitiveAppNames = true
+}
+
+// getApplicationByNameIgnoringCase returns the only application in the space
+// whose name matches name regardless of case.
+func (actor Actor) getApplicationByNameIgnoringCase(name string, spaceGUID string, allWarnings Warnings) (Application, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	var matches []Application
+	for _, app := range apps {
+		if strings.EqualFold(app.Name, name) {
+			matches = append(matches, app)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return Application{}, allWarnings, ApplicationNotFoundError{Name: name}
+	case 1:
+		return matches[0], allWarnings, nil
+	default:
+		names := make([]string, 0, len(matches))
+		for _, app := range matches {
+			names = append(names, app.Name)
+		}
+		sort.Strings(names)
+		return Application{}, allWarnings, MultipleApplicationsFoundError{Name: name, Names: names}
+	}
+}
+
+// GetApplicationsBySpace returns all applications in a space. Every page of
+// results is requested and the warnings from each page are aggregated. Related
+// resources (routes, stacks) are not inlined; see the ccv2 package
+// documentation on inline-relations-depth.
 func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warnings, error) {
 	ccv2Apps, warnings, err := actor.CloudControllerClient.GetApplications([]ccv2.Query{
 		ccv2.Query{
@@ -326,6 +555,22 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 	return messages, logErrs, appState, allWarnings, errs
 }
 
+// StartApplicationAndWait sets the application's desired state to started and
+// then polls its instances until all of them are running. An
+// ApplicationInstanceCrashedError or ApplicationInstanceFlappingError is
+// returned if an instance fails, and a StartupTimeoutError if the instances
+// are not running within the startup timeout.
+func (actor Actor) StartApplicationAndWait(appGUID string, config Config) (Warnings, error) {
+	return actor.setApplicationStateAndWait(appGUID, ccv2.ApplicationStarted, config)
+}
+
+// StopApplicationAndWait sets the application's desired state to stopped and
+// then polls its instances until none of them are running. A StopTimeoutError
+// is returned if instances are still running after the startup timeout.
+func (actor Actor) StopApplicationAndWait(appGUID string, config Config) (Warnings, error) {
+	return actor.setApplicationStateAndWait(appGUID, ccv2.ApplicationStopped, config)
+}
+
 // RestartApplication restarts a given application. If already stopped, no stop
 // call will be sent.
 func (actor Actor) RestartApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan ApplicationState, <-chan string, <-chan error) {
@@ -378,6 +623,31 @@ func (actor Actor) RestartApplication(app Application, client NOAAClient, config
 	return messages, logErrs, appState, allWarnings, errs
 }
 
+// RestageApplicationAndWait restages the application and waits for staging to
+// complete. StagingFailedError carries the Cloud Controller's staging failure
+// reason when staging fails.
+func (actor Actor) RestageApplicationAndWait(appGUID string, config Config) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.CloudControllerClient.RestageApplication(ccv2.Application{GUID: appGUID})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings := make(chan string)
+	pollErr := make(chan error, 1)
+	go func() {
+		defer close(pollWarnings)
+		pollErr <- actor.pollStaging(Application(app), config, pollWarnings)
+	}()
+
+	for warning := range pollWarnings {
+		allWarnings = append(allWarnings, warning)
+	}
+	return allWarnings, <-pollErr
+}
+
 // RestageApplication restarts a given application. If already stopped, no stop
 // call will be sent.
 func (actor Actor) RestageApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan ApplicationState, <-chan string, <-chan error) {
@@ -417,6 +687,108 @@ func (actor Actor) UpdateApplication(application Application) (Application, Warn
 	return Application(app), Warnings(warnings), err
 }
 
+// RenameApplication changes the name of the application with the given GUID.
+// Only the name is sent to the Cloud Controller. An
+// ApplicationAlreadyExistsError is returned if another application in the
+// same space already has the new name.
+func (actor Actor) RenameApplication(appGUID string, newName string) (Application, Warnings, error) {
+	if newName == "" {
+		return Application{}, nil, EmptyApplicationNameError{}
+	}
+
+	app, allWarnings, err := actor.GetApplication(appGUID)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	existingApp, warnings, err := actor.GetApplicationByNameAndSpace(newName, app.SpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if existingApp.GUID != appGUID {
+			return Application{}, allWarnings, ApplicationAlreadyExistsError{Name: newName}
+		}
+	case ApplicationNotFoundError:
+	default:
+		return Application{}, allWarnings, err
+	}
+
+	renamedApp, warnings, err := actor.UpdateApplication(Application{
+		GUID: appGUID,
+		Name: newName,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return renamedApp, allWarnings, err
+}
+
+// ScaleApplicationBy scales the instance count and memory of the application
+// with the given GUID by the given percentages, where 100 leaves a value
+// unchanged. Instances are rounded to the nearest whole instance and memory
+// to the nearest megabyte. A ScaleBelowMinimumError is returned if the result
+// would be fewer than one instance or less than MinimumScaledMemory. The
+// returned application holds the resulting absolute values. An application
+// with no instances is left unchanged and an empty Application is returned.
+func (actor Actor) ScaleApplicationBy(appGUID string, instancePercent float64, memoryPercent float64) (Application, Warnings, error) {
+	app, allWarnings, err := actor.GetApplication(appGUID)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	if app.Instances == 0 {
+		return Application{}, allWarnings, nil
+	}
+
+	instances := math.Floor(float64(app.Instances)*instancePercent/100 + 0.5)
+	if instances < 1 {
+		return Application{}, allWarnings, ScaleBelowMinimumError{Resource: "instances", Percent: instancePercent}
+	}
+
+	memory := math.Floor(float64(app.Memory)*memoryPercent/100 + 0.5)
+	if memory < MinimumScaledMemory {
+		return Application{}, allWarnings, ScaleBelowMinimumError{Resource: "memory", Percent: memoryPercent}
+	}
+
+	scaledApp, warnings, err := actor.UpdateApplication(Application{
+		GUID:      appGUID,
+		Instances: int(instances),
+		Memory:    uint64(memory),
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return scaledApp, allWarnings, err
+}
+
+func (actor Actor) deleteApplicationAndRoutes(guid string) (Warnings, error) {
+	var allWarnings Warnings
+
+	routes, warnings, err := actor.CloudControllerClient.GetApplicationRoutes(guid, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return allWarnings, ApplicationNotFoundError{GUID: guid}
+		}
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.DeleteApplication(guid)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return allWarnings, ApplicationNotFoundError{GUID: guid}
+		}
+		return allWarnings, err
+	}
+
+	for _, route := range routes {
+		warnings, err = actor.CloudControllerClient.DeleteRoute(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
 func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<- string) error {
 	timeout := time.Now().Add(config.StagingTimeout())
 	for time.Now().Before(timeout) {
@@ -468,6 +840,80 @@ func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<
 	return StartupTimeoutError{Name: app.Name}
 }
 
+func (actor Actor) setApplicationStateAndWait(appGUID string, state ccv2.ApplicationState, config Config) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application{
+		GUID:  appGUID,
+		State: state,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var reachedState func(map[int]ApplicationInstance) (bool, error)
+	if state == ccv2.ApplicationStarted {
+		if app.Instances == 0 {
+			return allWarnings, nil
+		}
+		reachedState = func(instances map[int]ApplicationInstance) (bool, error) {
+			if len(instances) < app.Instances {
+				return false, nil
+			}
+			for _, instance := range instances {
+				switch {
+				case instance.Crashed():
+					return false, ApplicationInstanceCrashedError{Name: app.Name}
+				case instance.Flapping():
+					return false, ApplicationInstanceFlappingError{Name: app.Name}
+				case !instance.Running():
+					return false, nil
+				}
+			}
+			return true, nil
+		}
+	} else {
+		reachedState = func(instances map[int]ApplicationInstance) (bool, error) {
+			for _, instance := range instances {
+				if instance.Running() || instance.State == ccv2.ApplicationInstanceStarting {
+					return false, nil
+				}
+			}
+			return true, nil
+		}
+	}
+
+	var lastInstances map[int]ApplicationInstance
+	poller := poll.New(config.PollingInterval(), config.StartupTimeout())
+	err = poller.Poll(func() (bool, error) {
+		instances, warnings, err := actor.GetApplicationInstancesByApplication(appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(ApplicationInstancesNotFoundError); ok && state == ccv2.ApplicationStopped {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		lastInstances = instances
+
+		return reachedState(instances)
+	})
+	if _, ok := err.(poll.TimeoutError); !ok {
+		return allWarnings, err
+	}
+
+	instanceStates := map[int]ApplicationInstanceState{}
+	for index, instance := range lastInstances {
+		instanceStates[index] = ApplicationInstanceState(instance.State)
+	}
+
+	if state == ccv2.ApplicationStarted {
+		return allWarnings, StartupTimeoutError{Name: app.Name, InstanceStates: instanceStates}
+	}
+	return allWarnings, StopTimeoutError{Name: app.Name, InstanceStates: instanceStates}
+}
+
 func (actor Actor) waitForApplicationStageAndStart(app Application, client NOAAClient, config Config, appState chan ApplicationState, allWarnings chan string, errs chan error) {
 	err := actor.pollStaging(app, config, allWarnings)
 	if err != nil {
@@ -0,0 +1,70 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+const redactedCredentials = "[PRIVATE DATA HIDDEN]"
+
+// AppEnvironment represents the environment variables of an application,
+// grouped into user provided, system (VCAP_SERVICES), application
+// (VCAP_APPLICATION), running and staging variables.
+type AppEnvironment ccv2.ApplicationEnvironment
+
+// RedactCredentials returns a copy of the environment in which the
+// credentials of every service binding in VCAP_SERVICES are hidden.
+func (env AppEnvironment) RedactCredentials() AppEnvironment {
+	services, ok := env.System["VCAP_SERVICES"].(map[string]interface{})
+	if !ok {
+		return env
+	}
+
+	redactedServices := map[string]interface{}{}
+	for offering, rawBindings := range services {
+		bindings, ok := rawBindings.([]interface{})
+		if !ok {
+			redactedServices[offering] = rawBindings
+			continue
+		}
+
+		redactedBindings := make([]interface{}, len(bindings))
+		for i, rawBinding := range bindings {
+			binding, ok := rawBinding.(map[string]interface{})
+			if !ok {
+				redactedBindings[i] = rawBinding
+				continue
+			}
+
+			redactedBinding := map[string]interface{}{}
+			for key, value := range binding {
+				redactedBinding[key] = value
+			}
+			if _, hasCredentials := redactedBinding["credentials"]; hasCredentials {
+				redactedBinding["credentials"] = redactedCredentials
+			}
+			redactedBindings[i] = redactedBinding
+		}
+		redactedServices[offering] = redactedBindings
+	}
+
+	system := map[string]interface{}{}
+	for key, value := range env.System {
+		system[key] = value
+	}
+	system["VCAP_SERVICES"] = redactedServices
+	env.System = system
+
+	return env
+}
+
+// GetApplicationEnvironment returns the environment variables of the
+// application with the provided GUID.
+func (actor Actor) GetApplicationEnvironment(appGUID string) (AppEnvironment, Warnings, error) {
+	env, warnings, err := actor.CloudControllerClient.GetApplicationEnvironment(appGUID)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return AppEnvironment{}, Warnings(warnings), ApplicationNotFoundError{GUID: appGUID}
+	}
+
+	return AppEnvironment(env), Warnings(warnings), err
+}
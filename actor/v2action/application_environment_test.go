@@ -0,0 +1,136 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Environment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		var (
+			env        AppEnvironment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			env, warnings, executeErr = actor.GetApplicationEnvironment("some-app-guid")
+		})
+
+		Context("when the environment is returned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv2.ApplicationEnvironment{
+						UserProvided: map[string]interface{}{"USER_VAR": "user-value"},
+						Running:      map[string]interface{}{"RUNNING_VAR": "running-value"},
+					},
+					ccv2.Warnings{"env-warning"},
+					nil,
+				)
+			})
+
+			It("returns the environment and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("env-warning"))
+				Expect(env).To(Equal(AppEnvironment{
+					UserProvided: map[string]interface{}{"USER_VAR": "user-value"},
+					Running:      map[string]interface{}{"RUNNING_VAR": "running-value"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"env-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("env-warning"))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("env error")
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"env-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("env-warning"))
+			})
+		})
+	})
+
+	Describe("AppEnvironment", func() {
+		Describe("RedactCredentials", func() {
+			var env AppEnvironment
+
+			BeforeEach(func() {
+				env = AppEnvironment{
+					System: map[string]interface{}{
+						"VCAP_SERVICES": map[string]interface{}{
+							"some-service": []interface{}{
+								map[string]interface{}{
+									"name":        "some-instance",
+									"credentials": map[string]interface{}{"password": "secret"},
+								},
+							},
+						},
+					},
+					UserProvided: map[string]interface{}{"USER_VAR": "user-value"},
+				}
+			})
+
+			It("hides the credentials of every service binding", func() {
+				redacted := env.RedactCredentials()
+				Expect(redacted.System).To(Equal(map[string]interface{}{
+					"VCAP_SERVICES": map[string]interface{}{
+						"some-service": []interface{}{
+							map[string]interface{}{
+								"name":        "some-instance",
+								"credentials": "[PRIVATE DATA HIDDEN]",
+							},
+						},
+					},
+				}))
+				Expect(redacted.UserProvided).To(Equal(env.UserProvided))
+			})
+
+			It("does not modify the original environment", func() {
+				env.RedactCredentials()
+				binding := env.System["VCAP_SERVICES"].(map[string]interface{})["some-service"].([]interface{})[0].(map[string]interface{})
+				Expect(binding["credentials"]).To(Equal(map[string]interface{}{"password": "secret"}))
+			})
+
+			Context("when there is no VCAP_SERVICES", func() {
+				It("returns the environment unchanged", func() {
+					env = AppEnvironment{UserProvided: map[string]interface{}{"USER_VAR": "user-value"}}
+					Expect(env.RedactCredentials()).To(Equal(env))
+				})
+			})
+		})
+	})
+})
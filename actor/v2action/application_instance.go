@@ -1,12 +1,25 @@
 package v2action
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
 type ApplicationInstanceState ccv2.ApplicationInstanceState
 
+// InstanceNotFoundError is returned when the requested instance index is
+// outside of the application's instance count.
+type InstanceNotFoundError struct {
+	ApplicationGUID string
+	Index           int
+}
+
+func (e InstanceNotFoundError) Error() string {
+	return fmt.Sprintf("Instance %d of application '%s' not found.", e.Index, e.ApplicationGUID)
+}
+
 type ApplicationInstance ccv2.ApplicationInstance
 
 func (instance ApplicationInstance) Crashed() bool {
@@ -37,3 +50,26 @@ func (actor Actor) GetApplicationInstancesByApplication(guid string) (map[int]Ap
 
 	return appInstances, Warnings(warnings), err
 }
+
+// RestartApplicationInstance stops the instance of the application at the
+// given index; the platform replaces it with a new instance.
+func (actor Actor) RestartApplicationInstance(appGUID string, index int) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.CloudControllerClient.GetApplication(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return allWarnings, ApplicationNotFoundError{GUID: appGUID}
+		}
+		return allWarnings, err
+	}
+
+	if index < 0 || index >= app.Instances {
+		return allWarnings, InstanceNotFoundError{ApplicationGUID: appGUID, Index: index}
+	}
+
+	warnings, err = actor.CloudControllerClient.DeleteApplicationInstance(appGUID, index)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,110 @@
+package v2action
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// InstanceNotRunningError is returned when the files of an application
+// instance are requested while the instance is not running.
+type InstanceNotRunningError struct {
+	ApplicationGUID string
+	Index           int
+	State           ccv2.ApplicationInstanceState
+}
+
+func (e InstanceNotRunningError) Error() string {
+	if e.State == "" {
+		return fmt.Sprintf("Instance %d of application '%s' is not running.", e.Index, e.ApplicationGUID)
+	}
+	return fmt.Sprintf("Instance %d of application '%s' is not running (state: %s).", e.Index, e.ApplicationGUID, e.State)
+}
+
+// FileInfo represents an entry of a directory on an application instance.
+type FileInfo struct {
+	Name string
+
+	// Size is the human readable size reported by the instance. It is empty
+	// for directories.
+	Size string
+
+	IsDir bool
+}
+
+// ListAppFiles returns the contents of the directory at path on the provided
+// application instance.
+func (actor Actor) ListAppFiles(appGUID string, instance int, path string) ([]FileInfo, Warnings, error) {
+	listing, warnings, err := actor.getRunningInstanceFiles(appGUID, instance, path)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var files []FileInfo
+	scanner := bufio.NewScanner(bytes.NewReader(listing))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		sizeIndex := strings.LastIndexAny(line, " \t")
+		if sizeIndex == -1 {
+			continue
+		}
+
+		file := FileInfo{
+			Name: strings.TrimSpace(line[:sizeIndex]),
+			Size: line[sizeIndex+1:],
+		}
+		if strings.HasSuffix(file.Name, "/") {
+			file.Name = strings.TrimSuffix(file.Name, "/")
+			file.IsDir = true
+			file.Size = ""
+		}
+		files = append(files, file)
+	}
+
+	return files, warnings, scanner.Err()
+}
+
+// DownloadAppFile writes the contents of the file at path on the provided
+// application instance to dest.
+func (actor Actor) DownloadAppFile(appGUID string, instance int, path string, dest io.Writer) (Warnings, error) {
+	contents, warnings, err := actor.getRunningInstanceFiles(appGUID, instance, path)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, err = io.Copy(dest, bytes.NewReader(contents))
+	return warnings, err
+}
+
+// getRunningInstanceFiles checks that the instance is running before
+// requesting path from it, so that callers get a clear error instead of the
+// Cloud Controller's generic file error.
+func (actor Actor) getRunningInstanceFiles(appGUID string, instance int, path string) ([]byte, Warnings, error) {
+	instances, allWarnings, err := actor.GetApplicationInstancesByApplication(appGUID)
+	if err != nil {
+		if _, ok := err.(ApplicationInstancesNotFoundError); ok {
+			return nil, allWarnings, InstanceNotRunningError{ApplicationGUID: appGUID, Index: instance}
+		}
+		return nil, allWarnings, err
+	}
+
+	appInstance, found := instances[instance]
+	if !found {
+		return nil, allWarnings, InstanceNotFoundError{ApplicationGUID: appGUID, Index: instance}
+	}
+	if !appInstance.Running() {
+		return nil, allWarnings, InstanceNotRunningError{
+			ApplicationGUID: appGUID,
+			Index:           instance,
+			State:           appInstance.State,
+		}
+	}
+
+	contents, warnings, err := actor.CloudControllerClient.GetApplicationInstanceFiles(appGUID, instance, path)
+	allWarnings = append(allWarnings, warnings...)
+	return contents, allWarnings, err
+}
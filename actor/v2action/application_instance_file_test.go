@@ -0,0 +1,178 @@
+package v2action_test
+
+import (
+	"bytes"
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Instance File Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ListAppFiles", func() {
+		Context("when the instance is running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					map[int]ccv2.ApplicationInstance{
+						0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil,
+				)
+			})
+
+			Context("when the files are listed successfully", func() {
+				BeforeEach(func() {
+					listing := ".bash_logout                              220B\n" +
+						"app/                                         -\n" +
+						"my file.txt                                1.2K\n"
+					fakeCloudControllerClient.GetApplicationInstanceFilesReturns(
+						[]byte(listing),
+						ccv2.Warnings{"files-warning"},
+						nil,
+					)
+				})
+
+				It("returns the files and all warnings", func() {
+					files, warnings, err := actor.ListAppFiles("some-app-guid", 0, "/")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("instances-warning", "files-warning"))
+					Expect(files).To(Equal([]FileInfo{
+						{Name: ".bash_logout", Size: "220B"},
+						{Name: "app", IsDir: true},
+						{Name: "my file.txt", Size: "1.2K"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeCloudControllerClient.GetApplicationInstanceFilesCallCount()).To(Equal(1))
+					appGUID, index, path := fakeCloudControllerClient.GetApplicationInstanceFilesArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(index).To(Equal(0))
+					Expect(path).To(Equal("/"))
+				})
+			})
+
+			Context("when listing the files returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("files-error")
+					fakeCloudControllerClient.GetApplicationInstanceFilesReturns(nil, ccv2.Warnings{"files-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					_, warnings, err := actor.ListAppFiles("some-app-guid", 0, "/")
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("instances-warning", "files-warning"))
+				})
+			})
+		})
+
+		Context("when the instance is not running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					map[int]ccv2.ApplicationInstance{
+						0: {ID: 0, State: ccv2.ApplicationInstanceCrashed},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns an InstanceNotRunningError and all warnings", func() {
+				_, warnings, err := actor.ListAppFiles("some-app-guid", 0, "/")
+				Expect(err).To(MatchError(InstanceNotRunningError{
+					ApplicationGUID: "some-app-guid",
+					Index:           0,
+					State:           ccv2.ApplicationInstanceCrashed,
+				}))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationInstanceFilesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app has no running instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					nil,
+					ccv2.Warnings{"instances-warning"},
+					ccerror.NotStagedError{},
+				)
+			})
+
+			It("returns an InstanceNotRunningError and all warnings", func() {
+				_, warnings, err := actor.ListAppFiles("some-app-guid", 1, "/")
+				Expect(err).To(MatchError(InstanceNotRunningError{
+					ApplicationGUID: "some-app-guid",
+					Index:           1,
+				}))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+
+		Context("when the instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					map[int]ccv2.ApplicationInstance{
+						0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns an InstanceNotFoundError and all warnings", func() {
+				_, warnings, err := actor.ListAppFiles("some-app-guid", 3, "/")
+				Expect(err).To(MatchError(InstanceNotFoundError{
+					ApplicationGUID: "some-app-guid",
+					Index:           3,
+				}))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+	})
+
+	Describe("DownloadAppFile", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+				map[int]ccv2.ApplicationInstance{
+					2: {ID: 2, State: ccv2.ApplicationInstanceRunning},
+				},
+				ccv2.Warnings{"instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationInstanceFilesReturns(
+				[]byte("some file contents"),
+				ccv2.Warnings{"files-warning"},
+				nil,
+			)
+		})
+
+		It("writes the file contents to the destination and returns all warnings", func() {
+			dest := new(bytes.Buffer)
+			warnings, err := actor.DownloadAppFile("some-app-guid", 2, "app/some-file", dest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instances-warning", "files-warning"))
+			Expect(dest.String()).To(Equal("some file contents"))
+
+			appGUID, index, path := fakeCloudControllerClient.GetApplicationInstanceFilesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(index).To(Equal(2))
+			Expect(path).To(Equal("app/some-file"))
+		})
+	})
+})
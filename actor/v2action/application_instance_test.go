@@ -175,4 +175,96 @@ var _ = Describe("Application Instance Actions", func() {
 			})
 		})
 	})
+	Describe("RestartApplicationInstance", func() {
+		var (
+			index      int
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			index = 1
+			fakeCloudControllerClient.GetApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid", Instances: 3},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.RestartApplicationInstance("some-app-guid", index)
+		})
+
+		Context("when the index is within the instance count", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationInstanceReturns(ccv2.Warnings{"delete-instance-warning"}, nil)
+			})
+
+			It("deletes the instance at the given index", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "delete-instance-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(1))
+				appGUID, instanceIndex := fakeCloudControllerClient.DeleteApplicationInstanceArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(instanceIndex).To(Equal(1))
+			})
+
+			Context("when deleting the instance fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete failed")
+					fakeCloudControllerClient.DeleteApplicationInstanceReturns(ccv2.Warnings{"delete-instance-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "delete-instance-warning"))
+				})
+			})
+		})
+
+		Context("when the index is equal to the instance count", func() {
+			BeforeEach(func() {
+				index = 3
+			})
+
+			It("returns an InstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(InstanceNotFoundError{ApplicationGUID: "some-app-guid", Index: 3}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the index is negative", func() {
+			BeforeEach(func() {
+				index = -1
+			})
+
+			It("returns an InstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(InstanceNotFoundError{ApplicationGUID: "some-app-guid", Index: -1}))
+				Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{},
+					ccv2.Warnings{"get-app-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -2,6 +2,8 @@ package v2action
 
 import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
+// ApplicationSummary represents an application along with its stack, routes
+// and the state and resource usage of each of its running instances.
 type ApplicationSummary struct {
 	Application
 	Stack            Stack
@@ -21,6 +23,10 @@ func (app ApplicationSummary) StartingOrRunningInstanceCount() int {
 	return count
 }
 
+// GetApplicationSummaryByNameAndSpace returns an application with the state,
+// CPU, memory and disk usage of each of its instances. Instances are only
+// fetched when the application is STARTED; if the stats endpoint reports that
+// the application is stopped, RunningInstances is left empty.
 func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (ApplicationSummary, Warnings, error) {
 	var allWarnings Warnings
 
@@ -216,6 +216,358 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("CreateApplicationIfNotExists", func() {
+		var (
+			newApp     Application
+			app        Application
+			created    bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			newApp = Application{
+				Name:      "some-app-name",
+				SpaceGUID: "some-space-guid",
+			}
+		})
+
+		JustBeforeEach(func() {
+			app, created, warnings, executeErr = actor.CreateApplicationIfNotExists(newApp)
+		})
+
+		Context("when the application already exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "existing-app-guid", Name: "some-app-name"}},
+					ccv2.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the existing application without creating it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(app).To(Equal(Application{GUID: "existing-app-guid", Name: "some-app-name"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-app-name",
+					},
+					ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-space-guid",
+					},
+				))
+				Expect(fakeCloudControllerClient.CreateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			})
+
+			Context("when the create is successful", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateApplicationReturns(
+						ccv2.Application{GUID: "new-app-guid", Name: "some-app-name"},
+						ccv2.Warnings{"create-app-warning"},
+						nil,
+					)
+				})
+
+				It("creates and returns the application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(created).To(BeTrue())
+					Expect(warnings).To(ConsistOf("get-apps-warning", "create-app-warning"))
+					Expect(app).To(Equal(Application{GUID: "new-app-guid", Name: "some-app-name"}))
+
+					Expect(fakeCloudControllerClient.CreateApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.CreateApplicationArgsForCall(0)).To(Equal(ccv2.Application(newApp)))
+				})
+			})
+
+			Context("when the application is created by someone else before the create", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateApplicationReturns(
+						ccv2.Application{},
+						ccv2.Warnings{"create-app-warning"},
+						ccerror.NameNotUniqueInSpaceError{},
+					)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+						[]ccv2.Application{{GUID: "racing-app-guid", Name: "some-app-name"}},
+						ccv2.Warnings{"get-apps-again-warning"},
+						nil,
+					)
+				})
+
+				It("returns the application created by the other client", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(created).To(BeFalse())
+					Expect(warnings).To(ConsistOf("get-apps-warning", "create-app-warning", "get-apps-again-warning"))
+					Expect(app).To(Equal(Application{GUID: "racing-app-guid", Name: "some-app-name"}))
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("when the create fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some create app error")
+					fakeCloudControllerClient.CreateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"create-app-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(created).To(BeFalse())
+					Expect(warnings).To(ConsistOf("get-apps-warning", "create-app-warning"))
+				})
+			})
+		})
+
+		Context("when looking up the application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some get apps error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without creating", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CopyApplicationBits", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationStub = func(guid string) (ccv2.Application, ccv2.Warnings, error) {
+				return ccv2.Application{
+					GUID:         guid,
+					Name:         guid + "-name",
+					PackageState: ccv2.ApplicationPackageStaged,
+				}, ccv2.Warnings{"get-app-warning-" + guid}, nil
+			}
+			fakeCloudControllerClient.CopyApplicationBitsReturns(
+				ccv2.Job{GUID: "some-job-guid"},
+				ccv2.Warnings{"copy-bits-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-job-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CopyApplicationBits("source-app-guid", "target-app-guid")
+		})
+
+		Context("when both apps exist and the source app has been staged", func() {
+			It("copies the bits, polls the job and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-app-warning-source-app-guid",
+					"get-app-warning-target-app-guid",
+					"copy-bits-warning",
+					"poll-job-warning",
+				))
+
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("source-app-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationArgsForCall(1)).To(Equal("target-app-guid"))
+
+				Expect(fakeCloudControllerClient.CopyApplicationBitsCallCount()).To(Equal(1))
+				sourceAppGUID, targetAppGUID := fakeCloudControllerClient.CopyApplicationBitsArgsForCall(0)
+				Expect(sourceAppGUID).To(Equal("source-app-guid"))
+				Expect(targetAppGUID).To(Equal("target-app-guid"))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when the source app has never been staged", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationStub = nil
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{
+						GUID:         "source-app-guid",
+						Name:         "source-app",
+						PackageState: ccv2.ApplicationPackagePending,
+					},
+					ccv2.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotStagedError and does not copy the bits", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotStagedError{Name: "source-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.CopyApplicationBitsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the target app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationStub = func(guid string) (ccv2.Application, ccv2.Warnings, error) {
+					if guid == "target-app-guid" {
+						return ccv2.Application{}, ccv2.Warnings{"get-target-app-warning"}, ccerror.ResourceNotFoundError{}
+					}
+					return ccv2.Application{GUID: guid, PackageState: ccv2.ApplicationPackageStaged}, ccv2.Warnings{"get-source-app-warning"}, nil
+				}
+			})
+
+			It("returns an ApplicationNotFoundError and does not copy the bits", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{GUID: "target-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-source-app-warning", "get-target-app-warning"))
+				Expect(fakeCloudControllerClient.CopyApplicationBitsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when copying the bits fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("copy bits error")
+				fakeCloudControllerClient.CopyApplicationBitsReturns(ccv2.Job{}, ccv2.Warnings{"copy-bits-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf(
+					"get-app-warning-source-app-guid",
+					"get-app-warning-target-app-guid",
+					"copy-bits-warning",
+				))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when polling the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("poll job error")
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-job-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("poll-job-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteApplications", func() {
+		var (
+			deleted  []string
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			deleted, warnings, err = actor.DeleteApplications([]string{"app-guid-1", "app-guid-2"})
+		})
+
+		Context("when all the applications are deleted", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesStub = func(appGUID string, _ []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+					return []ccv2.Route{{GUID: appGUID + "-route"}}, ccv2.Warnings{"get-routes-warning"}, nil
+				}
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-app-warning"}, nil)
+				fakeCloudControllerClient.DeleteRouteReturns(ccv2.Warnings{"delete-route-warning"}, nil)
+			})
+
+			It("deletes each application and its routes", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(Equal([]string{"app-guid-1", "app-guid-2"}))
+				Expect(warnings).To(Equal(Warnings{
+					"get-routes-warning", "delete-app-warning", "delete-route-warning",
+					"get-routes-warning", "delete-app-warning", "delete-route-warning",
+				}))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("app-guid-1"))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(1)).To(Equal("app-guid-2"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("app-guid-1-route"))
+				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(1)).To(Equal("app-guid-2-route"))
+			})
+		})
+
+		Context("when some of the applications fail to delete", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete-app-error")
+				fakeCloudControllerClient.DeleteApplicationStub = func(appGUID string) (ccv2.Warnings, error) {
+					if appGUID == "app-guid-1" {
+						return ccv2.Warnings{"delete-app-warning-1"}, expectedErr
+					}
+					return ccv2.Warnings{"delete-app-warning-2"}, nil
+				}
+			})
+
+			It("continues deleting the remaining applications and returns the failures", func() {
+				Expect(err).To(MatchError(DeleteApplicationsError{
+					Errors: map[string]error{"app-guid-1": expectedErr},
+				}))
+				Expect(err.Error()).To(Equal("Failed to delete 1 application(s): app-guid-1: delete-app-error"))
+				Expect(deleted).To(Equal([]string{"app-guid-2"}))
+				Expect(warnings).To(ConsistOf("delete-app-warning-1", "delete-app-warning-2"))
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when an application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, nil, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError for that application", func() {
+				Expect(err).To(MatchError(DeleteApplicationsError{
+					Errors: map[string]error{
+						"app-guid-1": ApplicationNotFoundError{GUID: "app-guid-1"},
+						"app-guid-2": ApplicationNotFoundError{GUID: "app-guid-2"},
+					},
+				}))
+				Expect(deleted).To(BeEmpty())
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting a route fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete-route-error")
+				fakeCloudControllerClient.GetApplicationRoutesReturns([]ccv2.Route{{GUID: "route-guid"}}, nil, nil)
+				fakeCloudControllerClient.DeleteRouteReturnsOnCall(0, ccv2.Warnings{"delete-route-warning"}, expectedErr)
+			})
+
+			It("records the failure against the application", func() {
+				Expect(err).To(MatchError(DeleteApplicationsError{
+					Errors: map[string]error{"app-guid-1": expectedErr},
+				}))
+				Expect(deleted).To(Equal([]string{"app-guid-2"}))
+				Expect(warnings).To(ContainElement("delete-route-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
@@ -303,6 +655,120 @@ var _ = Describe("Application Actions", func() {
 			It("returns an ApplicationNotFoundError", func() {
 				_, _, err := actor.GetApplicationByNameAndSpace("some-app", "some-space-guid")
 				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when case-insensitive matching is enabled", func() {
+			var (
+				app        Application
+				warnings   Warnings
+				executeErr error
+			)
+
+			BeforeEach(func() {
+				actor.EnableCaseInsensitiveAppNames()
+			})
+
+			JustBeforeEach(func() {
+				app, warnings, executeErr = actor.GetApplicationByNameAndSpace("some-app", "some-space-guid")
+			})
+
+			Context("when the name matches exactly", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(
+						[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+						ccv2.Warnings{"exact-warning"},
+						nil,
+					)
+				})
+
+				It("does not list the space's applications", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(app.GUID).To(Equal("some-app-guid"))
+					Expect(warnings).To(ConsistOf("exact-warning"))
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a single application matches ignoring case", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv2.Application{}, ccv2.Warnings{"exact-warning"}, nil)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+						[]ccv2.Application{
+							{GUID: "other-app-guid", Name: "other-app"},
+							{GUID: "some-app-guid", Name: "Some-App"},
+						},
+						ccv2.Warnings{"space-warning"},
+						nil,
+					)
+				})
+
+				It("returns the matching application and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(app).To(Equal(Application{GUID: "some-app-guid", Name: "Some-App"}))
+					Expect(warnings).To(ConsistOf("exact-warning", "space-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(1)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-space-guid",
+					}))
+				})
+			})
+
+			Context("when multiple applications match ignoring case", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv2.Application{}, nil, nil)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+						[]ccv2.Application{
+							{GUID: "some-app-guid-1", Name: "SOME-APP"},
+							{GUID: "some-app-guid-2", Name: "Some-App"},
+						},
+						ccv2.Warnings{"space-warning"},
+						nil,
+					)
+				})
+
+				It("returns a MultipleApplicationsFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(MultipleApplicationsFoundError{
+						Name:  "some-app",
+						Names: []string{"SOME-APP", "Some-App"},
+					}))
+					Expect(warnings).To(ConsistOf("space-warning"))
+				})
+			})
+
+			Context("when no application matches ignoring case", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv2.Application{}, nil, nil)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+						[]ccv2.Application{{GUID: "other-app-guid", Name: "other-app"}},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns an ApplicationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				})
+			})
+
+			Context("when listing the space's applications errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("list-error")
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv2.Application{}, ccv2.Warnings{"exact-warning"}, nil)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, nil, ccv2.Warnings{"space-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("exact-warning", "space-warning"))
+				})
 			})
 		})
 
@@ -1206,24 +1672,257 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
-	Describe("UpdateApplication", func() {
-		Context("when the update is successful", func() {
-			var expectedApp ccv2.Application
+	Describe("RestageApplicationAndWait", func() {
+		var (
+			fakeConfig *v2actionfakes.FakeConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.StagingTimeoutReturns(time.Minute)
+
+			fakeCloudControllerClient.RestageApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid", Name: "some-app", PackageState: ccv2.ApplicationPackagePending},
+				ccv2.Warnings{"restage-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.RestageApplicationAndWait("some-app-guid", fakeConfig)
+		})
+
+		Context("when staging succeeds", func() {
 			BeforeEach(func() {
-				expectedApp = ccv2.Application{
-					GUID:      "some-app-guid",
-					Name:      "some-app-name",
-					SpaceGUID: "some-space-guid",
-				}
-				fakeCloudControllerClient.UpdateApplicationReturns(expectedApp, ccv2.Warnings{"some-app-warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationReturnsOnCall(0, ccv2.Application{PackageState: ccv2.ApplicationPackagePending}, ccv2.Warnings{"get-app-warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationReturnsOnCall(1, ccv2.Application{PackageState: ccv2.ApplicationPackageStaged}, ccv2.Warnings{"get-app-warning-2"}, nil)
 			})
 
-			It("updates and returns the application", func() {
-				newApp := Application{
-					Name:      "some-app-name",
-					SpaceGUID: "some-space-guid",
-				}
-				app, warnings, err := actor.UpdateApplication(newApp)
+			It("calls the restage endpoint and polls until staged", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("restage-warning", "get-app-warning-1", "get-app-warning-2"))
+
+				Expect(fakeCloudControllerClient.RestageApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.RestageApplicationArgsForCall(0)).To(Equal(ccv2.Application{GUID: "some-app-guid"}))
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when staging fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{
+						PackageState:             ccv2.ApplicationPackageFailed,
+						StagingFailedReason:      "BuildpackCompileFailed",
+						StagingFailedDescription: "App staging failed in the buildpack compile phase",
+					},
+					ccv2.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns a StagingFailedError with the failure reason", func() {
+				Expect(executeErr).To(MatchError(StagingFailedError{Reason: "App staging failed in the buildpack compile phase"}))
+				Expect(warnings).To(ConsistOf("restage-warning", "get-app-warning"))
+			})
+		})
+
+		Context("when the restage request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("restage error")
+				fakeCloudControllerClient.RestageApplicationReturns(ccv2.Application{}, ccv2.Warnings{"restage-warning"}, expectedErr)
+			})
+
+			It("returns the error without polling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("restage-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("StartApplicationAndWait/StopApplicationAndWait", func() {
+		var (
+			fakeConfig *v2actionfakes.FakeConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		instances := func(states ...ccv2.ApplicationInstanceState) map[int]ccv2.ApplicationInstance {
+			result := map[int]ccv2.ApplicationInstance{}
+			for index, state := range states {
+				result[index] = ccv2.ApplicationInstance{ID: index, State: state}
+			}
+			return result
+		}
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.StartupTimeoutReturns(time.Minute)
+
+			fakeCloudControllerClient.UpdateApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid", Name: "some-app", Instances: 2},
+				ccv2.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		Describe("StartApplicationAndWait", func() {
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.StartApplicationAndWait("some-app-guid", fakeConfig)
+			})
+
+			Context("when all instances start", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturnsOnCall(0, instances(ccv2.ApplicationInstanceStarting), ccv2.Warnings{"instances-warning-1"}, nil)
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturnsOnCall(1, instances(ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceStarting), ccv2.Warnings{"instances-warning-2"}, nil)
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturnsOnCall(2, instances(ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceRunning), ccv2.Warnings{"instances-warning-3"}, nil)
+				})
+
+				It("starts the app and polls until every instance is running", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("update-warning", "instances-warning-1", "instances-warning-2", "instances-warning-3"))
+
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+						GUID:  "some-app-guid",
+						State: ccv2.ApplicationStarted,
+					}))
+					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(3))
+					Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the app has no instances", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{GUID: "some-app-guid", Name: "some-app"}, ccv2.Warnings{"update-warning"}, nil)
+				})
+
+				It("does not poll the instances", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when an instance crashes", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(instances(ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceCrashed), nil, nil)
+				})
+
+				It("returns an ApplicationInstanceCrashedError", func() {
+					Expect(executeErr).To(MatchError(ApplicationInstanceCrashedError{Name: "some-app"}))
+				})
+			})
+
+			Context("when the instances do not start before the timeout", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(20 * time.Millisecond)
+					fakeConfig.PollingIntervalReturns(5 * time.Millisecond)
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(instances(ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceStarting), nil, nil)
+				})
+
+				It("returns a StartupTimeoutError with the last instance states", func() {
+					Expect(executeErr).To(MatchError(StartupTimeoutError{
+						Name: "some-app",
+						InstanceStates: map[int]ApplicationInstanceState{
+							0: ApplicationInstanceState(ccv2.ApplicationInstanceRunning),
+							1: ApplicationInstanceState(ccv2.ApplicationInstanceStarting),
+						},
+					}))
+				})
+			})
+
+			Context("when updating the app fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("update failed")
+					fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("update-warning"))
+					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("StopApplicationAndWait", func() {
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.StopApplicationAndWait("some-app-guid", fakeConfig)
+			})
+
+			Context("when the instances go down", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturnsOnCall(0, instances(ccv2.ApplicationInstanceRunning, ccv2.ApplicationInstanceDown), ccv2.Warnings{"instances-warning-1"}, nil)
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturnsOnCall(1, instances(ccv2.ApplicationInstanceDown, ccv2.ApplicationInstanceDown), ccv2.Warnings{"instances-warning-2"}, nil)
+				})
+
+				It("stops the app and polls until no instance is running", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("update-warning", "instances-warning-1", "instances-warning-2"))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+						GUID:  "some-app-guid",
+						State: ccv2.ApplicationStopped,
+					}))
+					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("when the instances are no longer reported", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(nil, ccv2.Warnings{"instances-warning"}, ccerror.InstancesError{})
+				})
+
+				It("considers the app stopped", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("update-warning", "instances-warning"))
+				})
+			})
+
+			Context("when the instances do not stop before the timeout", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(20 * time.Millisecond)
+					fakeConfig.PollingIntervalReturns(5 * time.Millisecond)
+					fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(instances(ccv2.ApplicationInstanceRunning), nil, nil)
+				})
+
+				It("returns a StopTimeoutError with the last instance states", func() {
+					Expect(executeErr).To(MatchError(StopTimeoutError{
+						Name: "some-app",
+						InstanceStates: map[int]ApplicationInstanceState{
+							0: ApplicationInstanceState(ccv2.ApplicationInstanceRunning),
+						},
+					}))
+				})
+			})
+		})
+	})
+
+	Describe("UpdateApplication", func() {
+		Context("when the update is successful", func() {
+			var expectedApp ccv2.Application
+			BeforeEach(func() {
+				expectedApp = ccv2.Application{
+					GUID:      "some-app-guid",
+					Name:      "some-app-name",
+					SpaceGUID: "some-space-guid",
+				}
+				fakeCloudControllerClient.UpdateApplicationReturns(expectedApp, ccv2.Warnings{"some-app-warning-1"}, nil)
+			})
+
+			It("updates and returns the application", func() {
+				newApp := Application{
+					Name:      "some-app-name",
+					SpaceGUID: "some-space-guid",
+				}
+				app, warnings, err := actor.UpdateApplication(newApp)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(ConsistOf("some-app-warning-1"))
 				Expect(app).To(Equal(Application(expectedApp)))
@@ -1251,4 +1950,296 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 	})
+
+	Describe("RenameApplication", func() {
+		var (
+			newName string
+
+			app        Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			newName = "new-app-name"
+
+			fakeCloudControllerClient.GetApplicationReturns(
+				ccv2.Application{
+					GUID:      "some-app-guid",
+					Name:      "old-app-name",
+					SpaceGUID: "some-space-guid",
+				},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.UpdateApplicationReturns(
+				ccv2.Application{
+					GUID:      "some-app-guid",
+					Name:      "new-app-name",
+					SpaceGUID: "some-space-guid",
+				},
+				ccv2.Warnings{"update-app-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, executeErr = actor.RenameApplication("some-app-guid", newName)
+		})
+
+		Context("when no other app in the space has the new name", func() {
+			It("updates only the name and returns the renamed app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-apps-warning", "update-app-warning"))
+				Expect(app).To(Equal(Application{
+					GUID:      "some-app-guid",
+					Name:      "new-app-name",
+					SpaceGUID: "some-space-guid",
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "new-app-name",
+					},
+					ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-space-guid",
+					},
+				))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID: "some-app-guid",
+					Name: "new-app-name",
+				}))
+			})
+		})
+
+		Context("when the new name is empty", func() {
+			BeforeEach(func() {
+				newName = ""
+			})
+
+			It("returns an EmptyApplicationNameError", func() {
+				Expect(executeErr).To(MatchError(EmptyApplicationNameError{}))
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when another app in the space has the new name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "other-app-guid", Name: "new-app-name"}},
+					ccv2.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationAlreadyExistsError and warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationAlreadyExistsError{Name: "new-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-apps-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app already has the new name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "some-app-guid", Name: "new-app-name"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("updates the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when getting the app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get app error")
+				fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{}, ccv2.Warnings{"get-app-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when checking for an existing app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get apps error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-apps-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the update fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update app error")
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-apps-warning", "update-app-warning"))
+			})
+		})
+	})
+
+	Describe("ScaleApplicationBy", func() {
+		var (
+			instancePercent float64
+			memoryPercent   float64
+
+			app        Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			instancePercent = 150
+			memoryPercent = 50
+
+			fakeCloudControllerClient.GetApplicationReturns(
+				ccv2.Application{
+					GUID:      "some-app-guid",
+					Instances: 3,
+					Memory:    1024,
+				},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateApplicationStub = func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+				return app, ccv2.Warnings{"update-app-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, executeErr = actor.ScaleApplicationBy("some-app-guid", instancePercent, memoryPercent)
+		})
+
+		It("updates the instances and memory in a single update and returns the new values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "update-app-warning"))
+			Expect(app.Instances).To(Equal(5))
+			Expect(app.Memory).To(Equal(uint64(512)))
+
+			Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+				GUID:      "some-app-guid",
+				Instances: 5,
+				Memory:    512,
+			}))
+		})
+
+		Context("when the app has no instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{
+						GUID:      "some-app-guid",
+						Instances: 0,
+						Memory:    1024,
+					},
+					ccv2.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns an empty application and does not update the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(app).To(Equal(Application{}))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the scaled instances round down to below one", func() {
+			BeforeEach(func() {
+				instancePercent = 10
+			})
+
+			It("returns a ScaleBelowMinimumError and does not update the app", func() {
+				Expect(executeErr).To(MatchError(ScaleBelowMinimumError{Resource: "instances", Percent: 10}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the scaled instances round up to one", func() {
+			BeforeEach(func() {
+				instancePercent = 20
+			})
+
+			It("scales to one instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(app.Instances).To(Equal(1))
+			})
+		})
+
+		Context("when the scaled memory is below the minimum", func() {
+			BeforeEach(func() {
+				memoryPercent = 5
+			})
+
+			It("returns a ScaleBelowMinimumError and does not update the app", func() {
+				Expect(executeErr).To(MatchError(ScaleBelowMinimumError{Resource: "memory", Percent: 5}))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get app error")
+				fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{}, ccv2.Warnings{"get-app-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when updating the app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update app error")
+				fakeCloudControllerClient.UpdateApplicationStub = nil
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-app-warning"))
+			})
+		})
+	})
 })
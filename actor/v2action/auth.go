@@ -2,6 +2,10 @@ package v2action
 
 import "fmt"
 
+// ClientCredentialsGrantType is the grant type recorded in the config after
+// authenticating with AuthenticateWithClientCredentials.
+const ClientCredentialsGrantType = "client_credentials"
+
 // Authenticate authenticates the user in UAA and sets the returned tokens in
 // the config.
 //
@@ -14,10 +18,57 @@ func (actor Actor) Authenticate(config Config, username string, password string)
 	accessToken, refreshToken, err := actor.UAAClient.Authenticate(username, password)
 	if err != nil {
 		config.SetTokenInformation("", "", "")
+		config.SetUAAGrantType("")
+		return err
+	}
+
+	accessToken = fmt.Sprintf("bearer %s", accessToken)
+	config.SetTokenInformation(accessToken, refreshToken, "")
+	config.SetUAAGrantType("")
+	return nil
+}
+
+// AuthenticateWithClientCredentials authenticates as the provided UAA client
+// and sets the returned access token in the config. Client credentials grants
+// have no refresh token, which the stored grant type records.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithClientCredentials(config Config, clientID string, clientSecret string) error {
+	config.UnsetOrganizationInformation()
+	config.UnsetSpaceInformation()
+
+	accessToken, err := actor.UAAClient.AuthenticateWithClientCredentials(clientID, clientSecret)
+	if err != nil {
+		config.SetTokenInformation("", "", "")
+		config.SetUAAGrantType("")
+		return err
+	}
+
+	accessToken = fmt.Sprintf("bearer %s", accessToken)
+	config.SetTokenInformation(accessToken, "", "")
+	config.SetUAAGrantType(ClientCredentialsGrantType)
+	return nil
+}
+
+// AuthenticateWithPasscode authenticates the user in UAA with a one time SSO
+// passcode and sets the returned tokens in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithPasscode(config Config, passcode string) error {
+	config.UnsetOrganizationInformation()
+	config.UnsetSpaceInformation()
+
+	accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithPasscode(passcode)
+	if err != nil {
+		config.SetTokenInformation("", "", "")
+		config.SetUAAGrantType("")
 		return err
 	}
 
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	config.SetTokenInformation(accessToken, refreshToken, "")
+	config.SetUAAGrantType("")
 	return nil
 }
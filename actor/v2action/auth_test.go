@@ -52,6 +52,9 @@ var _ = Describe("Auth Actions", func() {
 				Expect(refreshToken).To(Equal("some-refresh-token"))
 				Expect(sshOAuthClient).To(BeEmpty())
 
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 			})
@@ -83,9 +86,118 @@ var _ = Describe("Auth Actions", func() {
 				Expect(refreshToken).To(BeEmpty())
 				Expect(sshOAuthClient).To(BeEmpty())
 
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 			})
 		})
 	})
+
+	Describe("AuthenticateWithClientCredentials", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithClientCredentials(fakeConfig, "some-client", "some-secret")
+		})
+
+		Context("when no API errors occur", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithClientCredentialsReturns("some-access-token", nil)
+			})
+
+			It("stores the access token without a refresh token and records the grant type", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateWithClientCredentialsCallCount()).To(Equal(1))
+				clientID, clientSecret := fakeUAAClient.AuthenticateWithClientCredentialsArgsForCall(0)
+				Expect(clientID).To(Equal("some-client"))
+				Expect(clientSecret).To(Equal("some-secret"))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(BeEmpty())
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(ClientCredentialsGrantType))
+
+				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when an API error occurs", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeUAAClient.AuthenticateWithClientCredentialsReturns("", expectedErr)
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(actualErr).To(MatchError(expectedErr))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("AuthenticateWithPasscode", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithPasscode(fakeConfig, "some-passcode")
+		})
+
+		Context("when no API errors occur", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithPasscodeReturns("some-access-token", "some-refresh-token", nil)
+			})
+
+			It("stores the access and refresh tokens", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateWithPasscodeCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.AuthenticateWithPasscodeArgsForCall(0)).To(Equal("some-passcode"))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+
+				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when an API error occurs", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeUAAClient.AuthenticateWithPasscodeReturns("", "", expectedErr)
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(actualErr).To(MatchError(expectedErr))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,69 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Buildpack represents a CLI Buildpack.
+type Buildpack ccv2.Buildpack
+
+// BuildpackNotFoundError is returned when a requested buildpack is not found.
+type BuildpackNotFoundError struct {
+	GUID string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	return fmt.Sprintf("Buildpack with GUID '%s' not found.", e.GUID)
+}
+
+// InvalidBuildpackPositionError is returned when a buildpack is given a
+// position lower than 1.
+type InvalidBuildpackPositionError struct {
+	Position int
+}
+
+func (e InvalidBuildpackPositionError) Error() string {
+	return fmt.Sprintf("Buildpack position must be greater than or equal to 1, got %d.", e.Position)
+}
+
+// GetBuildpacks returns all the buildpacks, sorted by position. Buildpacks
+// sharing a position are kept in the order returned by the Cloud Controller.
+func (actor Actor) GetBuildpacks() ([]Buildpack, Warnings, error) {
+	ccv2Buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(nil)
+	if err != nil {
+		return []Buildpack{}, Warnings(warnings), err
+	}
+
+	buildpacks := make([]Buildpack, len(ccv2Buildpacks))
+	for i, ccv2Buildpack := range ccv2Buildpacks {
+		buildpacks[i] = Buildpack(ccv2Buildpack)
+	}
+
+	sort.SliceStable(buildpacks, func(i int, j int) bool {
+		return buildpacks[i].Position < buildpacks[j].Position
+	})
+
+	return buildpacks, Warnings(warnings), nil
+}
+
+// UpdateBuildpackPosition moves the buildpack with the provided GUID to the
+// given position. Positions start at 1.
+func (actor Actor) UpdateBuildpackPosition(guid string, position int) (Buildpack, Warnings, error) {
+	if position < 1 {
+		return Buildpack{}, nil, InvalidBuildpackPositionError{Position: position}
+	}
+
+	buildpack, warnings, err := actor.CloudControllerClient.UpdateBuildpack(ccv2.Buildpack{
+		GUID:     guid,
+		Position: position,
+	})
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Buildpack{}, Warnings(warnings), BuildpackNotFoundError{GUID: guid}
+	}
+
+	return Buildpack(buildpack), Warnings(warnings), err
+}
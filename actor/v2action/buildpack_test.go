@@ -0,0 +1,154 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetBuildpacks", func() {
+		var (
+			buildpacks []Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			buildpacks, warnings, executeErr = actor.GetBuildpacks()
+		})
+
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(
+					[]ccv2.Buildpack{
+						{GUID: "buildpack-guid-3", Name: "buildpack-3", Position: 3, Enabled: true, Filename: "buildpack-3.zip"},
+						{GUID: "buildpack-guid-1", Name: "buildpack-1", Position: 1, Locked: true},
+						{GUID: "buildpack-guid-2a", Name: "buildpack-2a", Position: 2},
+						{GUID: "buildpack-guid-2b", Name: "buildpack-2b", Position: 2},
+					},
+					ccv2.Warnings{"get-buildpacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the buildpacks sorted by position and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{GUID: "buildpack-guid-1", Name: "buildpack-1", Position: 1, Locked: true},
+					{GUID: "buildpack-guid-2a", Name: "buildpack-2a", Position: 2},
+					{GUID: "buildpack-guid-2b", Name: "buildpack-2b", Position: 2},
+					{GUID: "buildpack-guid-3", Name: "buildpack-3", Position: 3, Enabled: true, Filename: "buildpack-3.zip"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get buildpacks error")
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-buildpacks-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateBuildpackPosition", func() {
+		var (
+			position   int
+			buildpack  Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			position = 2
+		})
+
+		JustBeforeEach(func() {
+			buildpack, warnings, executeErr = actor.UpdateBuildpackPosition("some-buildpack-guid", position)
+		})
+
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateBuildpackReturns(
+					ccv2.Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack", Position: 2},
+					ccv2.Warnings{"update-buildpack-warning"},
+					nil,
+				)
+			})
+
+			It("updates only the position and returns the buildpack and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-buildpack-warning"))
+				Expect(buildpack).To(Equal(Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack", Position: 2}))
+
+				Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateBuildpackArgsForCall(0)).To(Equal(ccv2.Buildpack{
+					GUID:     "some-buildpack-guid",
+					Position: 2,
+				}))
+			})
+		})
+
+		Context("when the position is less than 1", func() {
+			BeforeEach(func() {
+				position = 0
+			})
+
+			It("returns an InvalidBuildpackPositionError", func() {
+				Expect(executeErr).To(MatchError(InvalidBuildpackPositionError{Position: 0}))
+				Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the buildpack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"update-buildpack-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a BuildpackNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(BuildpackNotFoundError{GUID: "some-buildpack-guid"}))
+				Expect(warnings).To(ConsistOf("update-buildpack-warning"))
+			})
+		})
+
+		Context("when the CC API client returns another error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update buildpack error")
+				fakeCloudControllerClient.UpdateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"update-buildpack-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("update-buildpack-warning"))
+			})
+		})
+	})
+})
@@ -10,38 +10,64 @@ type CloudControllerClient interface {
 	AssociateSpaceWithStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	BindRouteToApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	CheckRoute(route ccv2.Route) (bool, ccv2.Warnings, error)
+	CopyApplicationBits(sourceAppGUID string, targetAppGUID string) (ccv2.Job, ccv2.Warnings, error)
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	CreateOrganization(orgName string, quotaGUID *string) (ccv2.Organization, ccv2.Warnings, error)
+	CreateOrganizationQuota(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	CreateServiceBinding(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string, quotaGUID *string) (ccv2.Space, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	DeleteApplication(guid string) (ccv2.Warnings, error)
+	DeleteApplicationInstance(appGUID string, index int) (ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteApplicationRoute(appGUID string, routeGUID string) (ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
 	DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error)
+	GetApplicationEnvironment(appGUID string) (ccv2.ApplicationEnvironment, ccv2.Warnings, error)
+	GetApplicationInstanceFiles(appGUID string, index int, path string) ([]byte, ccv2.Warnings, error)
 	GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetApplicationServiceBindings(appGUID string, queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	GetEnvironmentVariableGroup(group ccv2.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	GetEvents(limit int, queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	GetFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetOrganizationQuotas(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
 	GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
 	GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetRouterGroups() ([]ccv2.RouterGroup, ccv2.Warnings, error)
 	GetRunningSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetServiceKey(guid string) (ccv2.ServiceKey, ccv2.Warnings, error)
+	GetServiceKeys(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	GetServicePlans(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	GetServices(queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
 	GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error)
+	GetSpaceAuditors(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	GetSpaceDevelopers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	GetSpaceManagers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	GetSpaceRoutes(spaceGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetSpaces(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetSpaceServices(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
 	GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	GetStacks(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
@@ -52,11 +78,23 @@ type CloudControllerClient interface {
 	ResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	UpdateApplicationRoute(appGUID string, routeGUID string) (ccv2.Warnings, error)
+	UpdateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
+	UpdateEnvironmentVariableGroup(group ccv2.EnvironmentVariableGroupName, envVars ccv2.EnvironmentVariableGroup) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	UpdateFeatureFlag(name string, enabled bool) (ccv2.FeatureFlag, ccv2.Warnings, error)
+	UpdateOrganizationManager(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateOrganizationQuota(orgGUID string, orgQuotaGUID string) (ccv2.Warnings, error)
+	UpdateOrganizationUser(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateSpaceDeveloper(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateSpaceManager(spaceGUID string, userGUID string) (ccv2.Warnings, error)
 	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 
 	API() string
 	APIVersion() string
+	AppSSHEndpoint() string
+	AppSSHHostKeyFingerprint() string
+	AppSSHOAuthClient() string
 	AuthorizationEndpoint() string
 	DopplerEndpoint() string
 	MinCLIVersion() string
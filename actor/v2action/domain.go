@@ -90,31 +90,45 @@ func (actor Actor) GetPrivateDomain(domainGUID string) (Domain, Warnings, error)
 	return Domain(domain), Warnings(warnings), err
 }
 
+// GetSharedDomains returns the global shared domains.
+func (actor Actor) GetSharedDomains() ([]Domain, Warnings, error) {
+	ccv2Domains, warnings, err := actor.CloudControllerClient.GetSharedDomains()
+	if err != nil {
+		return []Domain{}, Warnings(warnings), err
+	}
+
+	return actor.saveDomains(ccv2Domains), Warnings(warnings), nil
+}
+
+// GetPrivateDomains returns the private domains of an organization.
+func (actor Actor) GetPrivateDomains(orgGUID string) ([]Domain, Warnings, error) {
+	ccv2Domains, warnings, err := actor.CloudControllerClient.GetOrganizationPrivateDomains(orgGUID, nil)
+	if err != nil {
+		return []Domain{}, Warnings(warnings), err
+	}
+
+	return actor.saveDomains(ccv2Domains), Warnings(warnings), nil
+}
+
 // GetOrganizationDomains returns the shared and private domains associated
-// with an organization.
+// with an organization, shared domains first. Domain.Shared tells them apart.
 func (actor Actor) GetOrganizationDomains(orgGUID string) ([]Domain, Warnings, error) {
 	var allWarnings Warnings
 	var allDomains []Domain
 
-	domains, warnings, err := actor.CloudControllerClient.GetSharedDomains()
+	domains, warnings, err := actor.GetSharedDomains()
 	allWarnings = append(allWarnings, warnings...)
-
 	if err != nil {
 		return []Domain{}, allWarnings, err
 	}
-	for _, domain := range domains {
-		allDomains = append(allDomains, Domain(domain))
-	}
+	allDomains = append(allDomains, domains...)
 
-	domains, warnings, err = actor.CloudControllerClient.GetOrganizationPrivateDomains(orgGUID, nil)
+	domains, warnings, err = actor.GetPrivateDomains(orgGUID)
 	allWarnings = append(allWarnings, warnings...)
-
 	if err != nil {
 		return []Domain{}, allWarnings, err
 	}
-	for _, domain := range domains {
-		allDomains = append(allDomains, Domain(domain))
-	}
+	allDomains = append(allDomains, domains...)
 
 	return allDomains, allWarnings, nil
 }
@@ -125,6 +139,15 @@ func (actor Actor) saveDomain(domain ccv2.Domain) {
 	}
 }
 
+func (actor Actor) saveDomains(ccv2Domains []ccv2.Domain) []Domain {
+	domains := make([]Domain, 0, len(ccv2Domains))
+	for _, domain := range ccv2Domains {
+		actor.saveDomain(domain)
+		domains = append(domains, Domain(domain))
+	}
+	return domains
+}
+
 func (actor Actor) loadDomain(domainGUID string) (Domain, bool) {
 	domain, found := actor.domainCache[domainGUID]
 	return domain, found
@@ -274,11 +274,91 @@ var _ = Describe("Domain Actions", func() {
 		})
 	})
 
+	Describe("GetSharedDomains", func() {
+		Context("when the cloud controller returns the shared domains", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainsReturns(
+					[]ccv2.Domain{{GUID: "shared-domain-guid", Name: "shared.com", Shared: true}},
+					ccv2.Warnings{"shared domains warning"},
+					nil,
+				)
+			})
+
+			It("returns the shared domains and caches them", func() {
+				domains, warnings, err := actor.GetSharedDomains()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domains).To(Equal([]Domain{{GUID: "shared-domain-guid", Name: "shared.com", Shared: true}}))
+				Expect(warnings).To(ConsistOf("shared domains warning"))
+
+				domain, _, err := actor.GetDomain("shared-domain-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domain.Shared).To(BeTrue())
+				Expect(fakeCloudControllerClient.GetSharedDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("shared domains error")
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"shared domains warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				domains, warnings, err := actor.GetSharedDomains()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(domains).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("shared domains warning"))
+			})
+		})
+	})
+
+	Describe("GetPrivateDomains", func() {
+		Context("when the cloud controller returns the private domains", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(
+					[]ccv2.Domain{{GUID: "private-domain-guid", Name: "private.com"}},
+					ccv2.Warnings{"private domains warning"},
+					nil,
+				)
+			})
+
+			It("returns the organization's private domains", func() {
+				domains, warnings, err := actor.GetPrivateDomains("some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domains).To(Equal([]Domain{{GUID: "private-domain-guid", Name: "private.com"}}))
+				Expect(warnings).To(ConsistOf("private domains warning"))
+
+				orgGUID, queries := fakeCloudControllerClient.GetOrganizationPrivateDomainsArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(queries).To(BeEmpty())
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("private domains error")
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"private domains warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				domains, warnings, err := actor.GetPrivateDomains("some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(domains).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("private domains warning"))
+			})
+		})
+	})
+
 	Describe("GetOrganizationDomains", func() {
 		Context("when the organization has both shared and private domains", func() {
 			BeforeEach(func() {
 				sharedDomain := ccv2.Domain{
-					Name: "some-shared-domain",
+					Name:   "some-shared-domain",
+					Shared: true,
 				}
 				privateDomain := ccv2.Domain{
 					Name: "some-private-domain",
@@ -295,7 +375,7 @@ var _ = Describe("Domain Actions", func() {
 				domains, warnings, err := actor.GetOrganizationDomains("some-org-guid")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(domains).To(Equal([]Domain{
-					{Name: "some-shared-domain"},
+					{Name: "some-shared-domain", Shared: true},
 					{Name: "some-private-domain"},
 					{Name: "some-other-private-domain"},
 				}))
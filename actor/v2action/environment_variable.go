@@ -0,0 +1,199 @@
+package v2action
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var environmentVariableNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvironmentVariablesFileParseError is returned when an environment variable
+// file cannot be parsed. Line is 0 when the offending line is not known.
+type EnvironmentVariablesFileParseError struct {
+	Path   string
+	Line   int
+	Reason string
+}
+
+func (e EnvironmentVariablesFileParseError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("Error parsing %s on line %d: %s", e.Path, e.Line, e.Reason)
+	}
+	return fmt.Sprintf("Error parsing %s: %s", e.Path, e.Reason)
+}
+
+// SetEnvFromFile reads the environment variables in the file at path and
+// merges them into the application's existing environment variables with a
+// single update; existing variables keep their type. Files ending in .yml or
+// .yaml are parsed as a YAML map, all other files as KEY=VALUE lines where
+// blank lines and lines starting with # are ignored.
+func (actor Actor) SetEnvFromFile(appGUID string, path string) (Application, Warnings, error) {
+	envVars, err := parseEnvironmentVariablesFile(path)
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	env, allWarnings, err := actor.GetApplicationEnvironment(appGUID)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	mergedEnvVars := map[string]interface{}{}
+	for name, value := range env.UserProvided {
+		mergedEnvVars[name] = value
+	}
+	for name, value := range envVars {
+		mergedEnvVars[name] = value
+	}
+
+	updatedApp, warnings, err := actor.UpdateApplication(Application{
+		GUID:                 appGUID,
+		EnvironmentVariables: mergedEnvVars,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return updatedApp, allWarnings, err
+}
+
+// UnsetEnvVars removes the provided keys from the application's environment
+// variables with a single update. Keys that are not set on the application
+// are reported as warnings; when none of the keys are set the application is
+// not updated.
+func (actor Actor) UnsetEnvVars(appGUID string, keys []string) (Warnings, error) {
+	env, allWarnings, err := actor.GetApplicationEnvironment(appGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	remainingEnvVars := map[string]interface{}{}
+	for name, value := range env.UserProvided {
+		remainingEnvVars[name] = value
+	}
+
+	removed := false
+	for _, key := range keys {
+		if _, ok := remainingEnvVars[key]; !ok {
+			allWarnings = append(allWarnings, fmt.Sprintf("Environment variable %s was not set", key))
+			continue
+		}
+		delete(remainingEnvVars, key)
+		removed = true
+	}
+
+	if !removed {
+		return allWarnings, nil
+	}
+
+	_, warnings, err := actor.UpdateApplication(Application{
+		GUID:                 appGUID,
+		EnvironmentVariables: remainingEnvVars,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func parseEnvironmentVariablesFile(path string) (map[string]string, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return parseYAMLEnvironmentVariables(path, raw)
+	default:
+		return parseDotEnvEnvironmentVariables(path, raw)
+	}
+}
+
+func parseDotEnvEnvironmentVariables(path string, raw []byte) (map[string]string, error) {
+	envVars := map[string]string{}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(raw)))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, EnvironmentVariablesFileParseError{
+				Path:   path,
+				Line:   lineNumber,
+				Reason: "expected KEY=VALUE",
+			}
+		}
+
+		name := strings.TrimSpace(parts[0])
+		if !environmentVariableNameRegexp.MatchString(name) {
+			return nil, EnvironmentVariablesFileParseError{
+				Path:   path,
+				Line:   lineNumber,
+				Reason: fmt.Sprintf("invalid variable name '%s'", name),
+			}
+		}
+
+		envVars[name] = unquote(strings.TrimSpace(parts[1]))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return envVars, nil
+}
+
+func parseYAMLEnvironmentVariables(path string, raw []byte) (map[string]string, error) {
+	var yamlEnvVars yaml.MapSlice
+	err := yaml.Unmarshal(raw, &yamlEnvVars)
+	if err != nil {
+		return nil, EnvironmentVariablesFileParseError{
+			Path:   path,
+			Reason: err.Error(),
+		}
+	}
+
+	envVars := map[string]string{}
+	for _, item := range yamlEnvVars {
+		name := fmt.Sprint(item.Key)
+		if !environmentVariableNameRegexp.MatchString(name) {
+			return nil, EnvironmentVariablesFileParseError{
+				Path:   path,
+				Reason: fmt.Sprintf("invalid variable name '%s'", name),
+			}
+		}
+
+		switch item.Value.(type) {
+		case yaml.MapSlice, map[interface{}]interface{}, []interface{}:
+			return nil, EnvironmentVariablesFileParseError{
+				Path:   path,
+				Reason: fmt.Sprintf("value of '%s' must be a string, number or boolean", name),
+			}
+		case nil:
+			envVars[name] = ""
+		default:
+			envVars[name] = fmt.Sprint(item.Value)
+		}
+	}
+
+	return envVars, nil
+}
+
+// unquote removes a single pair of matching quotes surrounding value.
+func unquote(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if first == last && (first == '"' || first == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
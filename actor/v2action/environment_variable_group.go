@@ -0,0 +1,54 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// EnvironmentVariableGroup represents the environment variables of a
+// platform-wide environment variable group.
+type EnvironmentVariableGroup ccv2.EnvironmentVariableGroup
+
+// InvalidEnvironmentVariableGroupError is returned when the requested group
+// is neither 'running' nor 'staging'.
+type InvalidEnvironmentVariableGroupError struct {
+	Group string
+}
+
+func (e InvalidEnvironmentVariableGroupError) Error() string {
+	return fmt.Sprintf("Environment variable group '%s' is invalid. Must be 'running' or 'staging'.", e.Group)
+}
+
+// GetEnvironmentVariableGroup returns the environment variables of the
+// running or staging group.
+func (actor Actor) GetEnvironmentVariableGroup(group string) (EnvironmentVariableGroup, Warnings, error) {
+	groupName, err := environmentVariableGroupName(group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	envVars, warnings, err := actor.CloudControllerClient.GetEnvironmentVariableGroup(groupName)
+	return EnvironmentVariableGroup(envVars), Warnings(warnings), err
+}
+
+// SetEnvironmentVariableGroup replaces all the environment variables of the
+// running or staging group with envVars.
+func (actor Actor) SetEnvironmentVariableGroup(group string, envVars EnvironmentVariableGroup) (Warnings, error) {
+	groupName, err := environmentVariableGroupName(group)
+	if err != nil {
+		return nil, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateEnvironmentVariableGroup(groupName, ccv2.EnvironmentVariableGroup(envVars))
+	return Warnings(warnings), err
+}
+
+func environmentVariableGroupName(group string) (ccv2.EnvironmentVariableGroupName, error) {
+	switch groupName := ccv2.EnvironmentVariableGroupName(group); groupName {
+	case ccv2.RunningEnvironmentVariableGroup, ccv2.StagingEnvironmentVariableGroup:
+		return groupName, nil
+	default:
+		return "", InvalidEnvironmentVariableGroupError{Group: group}
+	}
+}
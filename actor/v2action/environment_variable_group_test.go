@@ -0,0 +1,117 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		DescribeTable("returns the group's environment variables and all warnings",
+			func(group string, expectedGroupName ccv2.EnvironmentVariableGroupName) {
+				fakeCloudControllerClient.GetEnvironmentVariableGroupReturns(
+					ccv2.EnvironmentVariableGroup{"FOO": "foo"},
+					ccv2.Warnings{"get-group-warning"},
+					nil,
+				)
+
+				envVars, warnings, err := actor.GetEnvironmentVariableGroup(group)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-group-warning"))
+				Expect(envVars).To(Equal(EnvironmentVariableGroup{"FOO": "foo"}))
+
+				Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupArgsForCall(0)).To(Equal(expectedGroupName))
+			},
+
+			Entry("running", "running", ccv2.RunningEnvironmentVariableGroup),
+			Entry("staging", "staging", ccv2.StagingEnvironmentVariableGroup),
+		)
+
+		Context("when the group is invalid", func() {
+			It("returns an InvalidEnvironmentVariableGroupError", func() {
+				_, _, err := actor.GetEnvironmentVariableGroup("bogus")
+				Expect(err).To(MatchError(InvalidEnvironmentVariableGroupError{Group: "bogus"}))
+				Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-group-error")
+				fakeCloudControllerClient.GetEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"get-group-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetEnvironmentVariableGroup("running")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-group-warning"))
+			})
+		})
+	})
+
+	Describe("SetEnvironmentVariableGroup", func() {
+		DescribeTable("replaces the group's environment variables and returns all warnings",
+			func(group string, expectedGroupName ccv2.EnvironmentVariableGroupName) {
+				fakeCloudControllerClient.UpdateEnvironmentVariableGroupReturns(
+					ccv2.EnvironmentVariableGroup{"FOO": "foo", "BAR": "bar"},
+					ccv2.Warnings{"update-group-warning"},
+					nil,
+				)
+
+				warnings, err := actor.SetEnvironmentVariableGroup(group, EnvironmentVariableGroup{"FOO": "foo", "BAR": "bar"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-group-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateEnvironmentVariableGroupCallCount()).To(Equal(1))
+				groupName, envVars := fakeCloudControllerClient.UpdateEnvironmentVariableGroupArgsForCall(0)
+				Expect(groupName).To(Equal(expectedGroupName))
+				Expect(envVars).To(Equal(ccv2.EnvironmentVariableGroup{"FOO": "foo", "BAR": "bar"}))
+			},
+
+			Entry("running", "running", ccv2.RunningEnvironmentVariableGroup),
+			Entry("staging", "staging", ccv2.StagingEnvironmentVariableGroup),
+		)
+
+		Context("when the group is invalid", func() {
+			It("returns an InvalidEnvironmentVariableGroupError", func() {
+				_, err := actor.SetEnvironmentVariableGroup("Running", EnvironmentVariableGroup{})
+				Expect(err).To(MatchError(InvalidEnvironmentVariableGroupError{Group: "Running"}))
+				Expect(fakeCloudControllerClient.UpdateEnvironmentVariableGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update-group-error")
+				fakeCloudControllerClient.UpdateEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"update-group-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.SetEnvironmentVariableGroup("staging", EnvironmentVariableGroup{})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("update-group-warning"))
+			})
+		})
+	})
+})
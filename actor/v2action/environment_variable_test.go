@@ -0,0 +1,304 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("SetEnvFromFile", func() {
+		var (
+			tempDir     string
+			envFilePath string
+			envFile     string
+
+			app        Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "set-env-from-file")
+			Expect(err).NotTo(HaveOccurred())
+			envFilePath = filepath.Join(tempDir, "vars.env")
+
+			fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+				ccv2.ApplicationEnvironment{
+					UserProvided: map[string]interface{}{
+						"EXISTING": "old-value",
+						"FOO":      "old-foo",
+						"NUMBER":   float64(1),
+					},
+				},
+				ccv2.Warnings{"get-env-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid"},
+				ccv2.Warnings{"update-app-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			err := ioutil.WriteFile(envFilePath, []byte(envFile), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			app, warnings, executeErr = actor.SetEnvFromFile("some-app-guid", envFilePath)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		Context("when the file contains KEY=VALUE lines", func() {
+			BeforeEach(func() {
+				envFile = `# a comment
+FOO=bar
+
+  BAZ = "quoted value"
+EMPTY=
+WITH_EQUALS=a=b
+`
+			})
+
+			It("merges the variables into the existing ones with a single update", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(app).To(Equal(Application{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-env-warning", "update-app-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID: "some-app-guid",
+					EnvironmentVariables: map[string]interface{}{
+						"EXISTING":    "old-value",
+						"NUMBER":      float64(1),
+						"FOO":         "bar",
+						"BAZ":         "quoted value",
+						"EMPTY":       "",
+						"WITH_EQUALS": "a=b",
+					},
+				}))
+			})
+		})
+
+		Context("when a line is missing an =", func() {
+			BeforeEach(func() {
+				envFile = "FOO=bar\n\nNOT_A_VARIABLE\n"
+			})
+
+			It("returns a parse error with the line number and does not update the app", func() {
+				Expect(executeErr).To(MatchError(EnvironmentVariablesFileParseError{
+					Path:   envFilePath,
+					Line:   3,
+					Reason: "expected KEY=VALUE",
+				}))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a variable name is invalid", func() {
+			BeforeEach(func() {
+				envFile = "FOO=bar\n1-BAD=baz\n"
+			})
+
+			It("returns a parse error with the line number", func() {
+				Expect(executeErr).To(MatchError(EnvironmentVariablesFileParseError{
+					Path:   envFilePath,
+					Line:   2,
+					Reason: "invalid variable name '1-BAD'",
+				}))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the file is YAML", func() {
+			BeforeEach(func() {
+				envFilePath = filepath.Join(tempDir, "vars.yml")
+			})
+
+			Context("when the file is a map of scalars", func() {
+				BeforeEach(func() {
+					envFile = "FOO: bar\nPORT: 8080\nENABLED: true\nEMPTY:\n"
+				})
+
+				It("merges the variables into the existing ones", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0).EnvironmentVariables).To(Equal(map[string]interface{}{
+						"EXISTING": "old-value",
+						"NUMBER":   float64(1),
+						"FOO":      "bar",
+						"PORT":     "8080",
+						"ENABLED":  "true",
+						"EMPTY":    "",
+					}))
+				})
+			})
+
+			Context("when a value is not a scalar", func() {
+				BeforeEach(func() {
+					envFile = "FOO:\n  nested: value\n"
+				})
+
+				It("returns a parse error", func() {
+					Expect(executeErr).To(MatchError(EnvironmentVariablesFileParseError{
+						Path:   envFilePath,
+						Reason: "value of 'FOO' must be a string, number or boolean",
+					}))
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the file is not valid YAML", func() {
+				BeforeEach(func() {
+					envFile = "FOO: bar\nBAZ: [unclosed\n"
+				})
+
+				It("returns a parse error", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(EnvironmentVariablesFileParseError{}))
+					Expect(executeErr.Error()).To(ContainSubstring("line 2"))
+				})
+			})
+		})
+
+		Context("when getting the application environment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				envFile = "FOO=bar\n"
+				expectedErr = errors.New("get env failed")
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"get-env-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-env-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when updating the application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				envFile = "FOO=bar\n"
+				expectedErr = errors.New("update app failed")
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-env-warning", "update-app-warning"))
+			})
+		})
+	})
+
+	Describe("UnsetEnvVars", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv2.ApplicationEnvironment{
+						UserProvided: map[string]interface{}{
+							"FOO":   "foo",
+							"BAR":   "bar",
+							"OTHER": map[string]interface{}{"nested": true},
+						},
+					},
+					ccv2.Warnings{"get-env-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, nil)
+			})
+
+			It("removes all the keys with a single update", func() {
+				warnings, err := actor.UnsetEnvVars("some-app-guid", []string{"FOO", "BAR"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-env-warning", "update-app-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID:                 "some-app-guid",
+					EnvironmentVariables: map[string]interface{}{"OTHER": map[string]interface{}{"nested": true}},
+				}))
+			})
+
+			It("reports the keys that are not set as warnings", func() {
+				warnings, err := actor.UnsetEnvVars("some-app-guid", []string{"FOO", "MISSING"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-env-warning",
+					"Environment variable MISSING was not set",
+					"update-app-warning",
+				))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID:                 "some-app-guid",
+					EnvironmentVariables: map[string]interface{}{"BAR": "bar", "OTHER": map[string]interface{}{"nested": true}},
+				}))
+			})
+
+			It("does not update the application when none of the keys are set", func() {
+				warnings, err := actor.UnsetEnvVars("some-app-guid", []string{"MISSING"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-env-warning", "Environment variable MISSING was not set"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+
+			Context("when updating the application fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("update-app-error")
+					fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					warnings, err := actor.UnsetEnvVars("some-app-guid", []string{"FOO"})
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-env-warning", "update-app-warning"))
+				})
+			})
+		})
+
+		Context("when getting the application environment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-env-error")
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"get-env-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UnsetEnvVars("some-app-guid", []string{"FOO"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-env-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
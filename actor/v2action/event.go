@@ -0,0 +1,107 @@
+package v2action
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// DefaultEventsLimit is the number of events returned by GetRecentEvents when
+// no limit is given.
+const DefaultEventsLimit = 50
+
+// AppEventType is the kind of an application event.
+type AppEventType string
+
+const (
+	AppEventCrash  AppEventType = "crash"
+	AppEventUpdate AppEventType = "update"
+	AppEventDelete AppEventType = "delete"
+)
+
+// AppEvent is a crash, update or delete event of an application.
+// InstanceIndex, ExitStatus and ExitDescription are only set for crash
+// events; Request holds the requested changes of update and delete events.
+type AppEvent struct {
+	GUID            string
+	Type            AppEventType
+	ActorName       string
+	Timestamp       time.Time
+	InstanceIndex   int
+	ExitStatus      int
+	ExitDescription string
+	Request         map[string]interface{}
+}
+
+var appEventTypes = map[ccv2.EventType]AppEventType{
+	ccv2.EventTypeApplicationCrash:         AppEventCrash,
+	ccv2.EventTypeApplicationUpdate:        AppEventUpdate,
+	ccv2.EventTypeApplicationDeleteRequest: AppEventDelete,
+}
+
+// GetRecentEvents returns the most recent crash, update and delete events of
+// the application, newest first. At most limit events are returned;
+// DefaultEventsLimit is used when limit is not positive.
+func (actor Actor) GetRecentEvents(appGUID string, limit int) ([]AppEvent, Warnings, error) {
+	if limit <= 0 {
+		limit = DefaultEventsLimit
+	}
+
+	var types []string
+	for ccType := range appEventTypes {
+		types = append(types, string(ccType))
+	}
+	sort.Strings(types)
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(limit, []ccv2.Query{
+		{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    appGUID,
+		},
+		{
+			Filter:   ccv2.TypeFilter,
+			Operator: ccv2.InOperator,
+			Value:    strings.Join(types, ","),
+		},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	events := []AppEvent{}
+	for _, ccEvent := range ccEvents {
+		eventType, ok := appEventTypes[ccEvent.Type]
+		if !ok {
+			continue
+		}
+
+		event := AppEvent{
+			GUID:      ccEvent.GUID,
+			Type:      eventType,
+			ActorName: ccEvent.ActorName,
+			Timestamp: ccEvent.Timestamp,
+		}
+
+		if eventType == AppEventCrash {
+			event.InstanceIndex = metadataInt(ccEvent.Metadata, "index")
+			event.ExitStatus = metadataInt(ccEvent.Metadata, "exit_status")
+			event.ExitDescription, _ = ccEvent.Metadata["exit_description"].(string)
+		} else {
+			event.Request, _ = ccEvent.Metadata["request"].(map[string]interface{})
+		}
+
+		events = append(events, event)
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+// metadataInt returns the number stored under key in event metadata decoded
+// from JSON, or 0 when it is missing.
+func metadataInt(metadata map[string]interface{}, key string) int {
+	value, _ := metadata[key].(float64)
+	return int(value)
+}
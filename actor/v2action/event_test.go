@@ -0,0 +1,142 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetRecentEvents", func() {
+		var (
+			limit      int
+			events     []AppEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			limit = 10
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetRecentEvents("some-app-guid", limit)
+		})
+
+		Context("when the app has events", func() {
+			var crashTime, updateTime time.Time
+
+			BeforeEach(func() {
+				crashTime = time.Date(2017, 8, 2, 10, 0, 0, 0, time.UTC)
+				updateTime = time.Date(2017, 8, 2, 9, 0, 0, 0, time.UTC)
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv2.Event{
+						{
+							GUID:      "crash-guid",
+							Type:      ccv2.EventTypeApplicationCrash,
+							ActorName: "some-app",
+							Timestamp: crashTime,
+							Metadata: map[string]interface{}{
+								"index":            float64(2),
+								"exit_status":      float64(137),
+								"exit_description": "out of memory",
+							},
+						},
+						{
+							GUID:      "update-guid",
+							Type:      ccv2.EventTypeApplicationUpdate,
+							ActorName: "admin",
+							Timestamp: updateTime,
+							Metadata: map[string]interface{}{
+								"request": map[string]interface{}{"instances": float64(3)},
+							},
+						},
+					},
+					ccv2.Warnings{"events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the events mapped to AppEvents", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(events).To(Equal([]AppEvent{
+					{
+						GUID:            "crash-guid",
+						Type:            AppEventCrash,
+						ActorName:       "some-app",
+						Timestamp:       crashTime,
+						InstanceIndex:   2,
+						ExitStatus:      137,
+						ExitDescription: "out of memory",
+					},
+					{
+						GUID:      "update-guid",
+						Type:      AppEventUpdate,
+						ActorName: "admin",
+						Timestamp: updateTime,
+						Request:   map[string]interface{}{"instances": float64(3)},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("events-warning"))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				passedLimit, queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(passedLimit).To(Equal(10))
+				Expect(queries).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.ActeeFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-app-guid",
+					},
+					ccv2.Query{
+						Filter:   ccv2.TypeFilter,
+						Operator: ccv2.InOperator,
+						Value:    "app.crash,audit.app.delete-request,audit.app.update",
+					},
+				))
+			})
+		})
+
+		Context("when the limit is not positive", func() {
+			BeforeEach(func() {
+				limit = 0
+			})
+
+			It("uses the default limit", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(events).To(BeEmpty())
+				passedLimit, _ := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(passedLimit).To(Equal(DefaultEventsLimit))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get events failed")
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"events-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// FeatureFlag represents a Cloud Controller feature flag.
+type FeatureFlag ccv2.FeatureFlag
+
+// FeatureFlagNotFoundError is returned when a requested feature flag is not
+// one of the flags known to the Cloud Controller.
+type FeatureFlagNotFoundError struct {
+	Name string
+}
+
+func (e FeatureFlagNotFoundError) Error() string {
+	return fmt.Sprintf("Feature flag '%s' not found.", e.Name)
+}
+
+// GetFeatureFlags returns all the feature flags.
+func (actor Actor) GetFeatureFlags() ([]FeatureFlag, Warnings, error) {
+	ccv2FeatureFlags, warnings, err := actor.CloudControllerClient.GetFeatureFlags()
+	if err != nil {
+		return []FeatureFlag{}, Warnings(warnings), err
+	}
+
+	featureFlags := make([]FeatureFlag, len(ccv2FeatureFlags))
+	for i, ccv2FeatureFlag := range ccv2FeatureFlags {
+		featureFlags[i] = FeatureFlag(ccv2FeatureFlag)
+	}
+
+	return featureFlags, Warnings(warnings), nil
+}
+
+// SetFeatureFlag enables or disables the provided feature flag. A
+// FeatureFlagNotFoundError is returned if the flag is not one of the flags
+// returned by GetFeatureFlags.
+func (actor Actor) SetFeatureFlag(name string, enabled bool) (Warnings, error) {
+	featureFlags, allWarnings, err := actor.GetFeatureFlags()
+	if err != nil {
+		return allWarnings, err
+	}
+
+	found := false
+	for _, featureFlag := range featureFlags {
+		if featureFlag.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return allWarnings, FeatureFlagNotFoundError{Name: name}
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateFeatureFlag(name, enabled)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,134 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature Flag Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetFeatureFlags", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetFeatureFlagsReturns(
+					[]ccv2.FeatureFlag{
+						{Name: "user_org_creation", Enabled: false, ErrorMessage: "no orgs for you"},
+						{Name: "app_bits_upload", Enabled: true},
+					},
+					ccv2.Warnings{"get-feature-flags-warning"},
+					nil,
+				)
+			})
+
+			It("returns the feature flags and all warnings", func() {
+				featureFlags, warnings, err := actor.GetFeatureFlags()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-feature-flags-warning"))
+				Expect(featureFlags).To(Equal([]FeatureFlag{
+					{Name: "user_org_creation", Enabled: false, ErrorMessage: "no orgs for you"},
+					{Name: "app_bits_upload", Enabled: true},
+				}))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-feature-flags-error")
+				fakeCloudControllerClient.GetFeatureFlagsReturns(nil, ccv2.Warnings{"get-feature-flags-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetFeatureFlags()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-feature-flags-warning"))
+			})
+		})
+	})
+
+	Describe("SetFeatureFlag", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetFeatureFlagsReturns(
+				[]ccv2.FeatureFlag{{Name: "user_org_creation"}},
+				ccv2.Warnings{"get-feature-flags-warning"},
+				nil,
+			)
+		})
+
+		Context("when the feature flag exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateFeatureFlagReturns(
+					ccv2.FeatureFlag{Name: "user_org_creation", Enabled: true},
+					ccv2.Warnings{"update-feature-flag-warning"},
+					nil,
+				)
+			})
+
+			It("updates the feature flag and returns all warnings", func() {
+				warnings, err := actor.SetFeatureFlag("user_org_creation", true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-feature-flags-warning", "update-feature-flag-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateFeatureFlagCallCount()).To(Equal(1))
+				name, enabled := fakeCloudControllerClient.UpdateFeatureFlagArgsForCall(0)
+				Expect(name).To(Equal("user_org_creation"))
+				Expect(enabled).To(BeTrue())
+			})
+
+			Context("when updating the feature flag fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("update-feature-flag-error")
+					fakeCloudControllerClient.UpdateFeatureFlagReturns(ccv2.FeatureFlag{}, ccv2.Warnings{"update-feature-flag-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					warnings, err := actor.SetFeatureFlag("user_org_creation", false)
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-feature-flags-warning", "update-feature-flag-warning"))
+				})
+			})
+		})
+
+		Context("when the feature flag does not exist", func() {
+			It("returns a FeatureFlagNotFoundError without updating", func() {
+				warnings, err := actor.SetFeatureFlag("user_org_creatoin", true)
+				Expect(err).To(MatchError(FeatureFlagNotFoundError{Name: "user_org_creatoin"}))
+				Expect(warnings).To(ConsistOf("get-feature-flags-warning"))
+				Expect(fakeCloudControllerClient.UpdateFeatureFlagCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listing the feature flags fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-feature-flags-error")
+				fakeCloudControllerClient.GetFeatureFlagsReturns(nil, ccv2.Warnings{"get-feature-flags-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.SetFeatureFlag("user_org_creation", true)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-feature-flags-warning"))
+				Expect(fakeCloudControllerClient.UpdateFeatureFlagCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
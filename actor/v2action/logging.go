@@ -1,9 +1,10 @@
 package v2action
 
 import (
+	"sort"
+	"strconv"
 	"time"
 
-	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 )
@@ -61,6 +62,16 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+func newLogMessage(event *events.LogMessage) LogMessage {
+	return LogMessage{
+		message:        string(event.GetMessage()),
+		messageType:    event.GetMessageType(),
+		timestamp:      time.Unix(0, event.GetTimestamp()),
+		sourceType:     event.GetSourceType(),
+		sourceInstance: event.GetSourceInstance(),
+	}
+}
+
 func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
@@ -80,13 +91,8 @@ func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config)
 					break dance
 				}
 
-				messages <- &LogMessage{
-					message:        string(event.GetMessage()),
-					messageType:    event.GetMessageType(),
-					timestamp:      time.Unix(0, event.GetTimestamp()),
-					sourceInstance: event.GetSourceInstance(),
-					sourceType:     event.GetSourceType(),
-				}
+				message := newLogMessage(event)
+				messages <- &message
 			case err, ok := <-errStream:
 				if !ok {
 					break dance
@@ -106,32 +112,53 @@ func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config)
 	return messages, errs
 }
 
-func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) ([]LogMessage, Warnings, error) {
-	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+// GetRecentLogs returns the recent logs of the application, sorted by
+// timestamp. Messages with the same timestamp are ordered by source instance,
+// numerically when both instances are numbers, and otherwise keep the order
+// they were dumped in. An empty slice is returned if the application has no
+// log history.
+func (Actor) GetRecentLogs(appGUID string, client NOAAClient) ([]LogMessage, Warnings, error) {
+	noaaMessages, err := client.RecentLogs(appGUID, "")
 	if err != nil {
-		return nil, allWarnings, err
+		return nil, nil, err
 	}
 
-	noaaMessages, err := client.RecentLogs(app.GUID, "")
-	if err != nil {
-		return nil, allWarnings, err
+	logMessages := make([]LogMessage, 0, len(noaaMessages))
+	for _, message := range noaaMessages {
+		logMessages = append(logMessages, newLogMessage(message))
 	}
 
-	noaaMessages = noaa.SortRecent(noaaMessages)
+	sort.SliceStable(logMessages, func(i int, j int) bool {
+		if !logMessages[i].timestamp.Equal(logMessages[j].timestamp) {
+			return logMessages[i].timestamp.Before(logMessages[j].timestamp)
+		}
+		return sourceInstanceLess(logMessages[i].sourceInstance, logMessages[j].sourceInstance)
+	})
 
-	var logMessages []LogMessage
+	return logMessages, nil, nil
+}
 
-	for _, message := range noaaMessages {
-		logMessages = append(logMessages, LogMessage{
-			message:        string(message.GetMessage()),
-			messageType:    message.GetMessageType(),
-			timestamp:      time.Unix(0, message.GetTimestamp()),
-			sourceType:     message.GetSourceType(),
-			sourceInstance: message.GetSourceInstance(),
-		})
+// sourceInstanceLess compares source instances as integers when both are
+// integers, so that instance 10 sorts after instance 9, and as strings
+// otherwise.
+func sourceInstanceLess(a string, b string) bool {
+	aIndex, aErr := strconv.Atoi(a)
+	bIndex, bErr := strconv.Atoi(b)
+	if aErr == nil && bErr == nil {
+		return aIndex < bIndex
 	}
+	return a < b
+}
 
-	return logMessages, allWarnings, nil
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	logMessages, warnings, err := actor.GetRecentLogs(app.GUID, client)
+	allWarnings = append(allWarnings, warnings...)
+	return logMessages, allWarnings, err
 }
 
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
@@ -144,3 +171,51 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 
 	return messages, logErrs, allWarnings, err
 }
+
+// GetStagingLogs returns the recent staging logs of the application, sorted
+// by timestamp. An empty slice is returned if the application's bits have not
+// been uploaded, and therefore staging has not started yet.
+func (actor Actor) GetStagingLogs(appGUID string, client NOAAClient) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplication(appGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	logMessages := []LogMessage{}
+	if app.PackageUpdatedAt.IsZero() {
+		return logMessages, allWarnings, nil
+	}
+
+	recentMessages, warnings, err := actor.GetRecentLogs(appGUID, client)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	for _, message := range recentMessages {
+		if message.Staging() {
+			logMessages = append(logMessages, message)
+		}
+	}
+
+	return logMessages, allWarnings, nil
+}
+
+// GetStreamingStagingLogs streams the staging logs of the application. Logs
+// from any other source are dropped.
+func (actor Actor) GetStreamingStagingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
+	allMessages, errs := actor.GetStreamingLogs(appGUID, client, config)
+
+	messages := make(chan *LogMessage)
+	go func() {
+		defer close(messages)
+
+		for message := range allMessages {
+			if message.Staging() {
+				messages <- message
+			}
+		}
+	}()
+
+	return messages, errs
+}
@@ -213,6 +213,88 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogs", func() {
+		var (
+			messages   []LogMessage
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			messages, warnings, executeErr = actor.GetRecentLogs("some-app-guid", fakeNOAAClient)
+		})
+
+		Context("when NOAA returns logs out of order", func() {
+			BeforeEach(func() {
+				logMessage := func(message string, timestamp int64, sourceInstance string) *events.LogMessage {
+					sourceType := "APP/PROC/WEB"
+					return &events.LogMessage{
+						Message:        []byte(message),
+						MessageType:    events.LogMessage_OUT.Enum(),
+						Timestamp:      &timestamp,
+						SourceType:     &sourceType,
+						SourceInstance: &sourceInstance,
+					}
+				}
+
+				fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+					logMessage("message-4", 30, "0"),
+					logMessage("message-3", 20, "1"),
+					logMessage("message-1", 10, "0"),
+					logMessage("message-2a", 20, "0"),
+					logMessage("message-2b", 20, "0"),
+					logMessage("message-5b", 40, "10"),
+					logMessage("message-5a", 40, "9"),
+				}, nil)
+			})
+
+			It("sorts them by timestamp and then numerically by source instance, keeping the dumped order otherwise", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(1))
+				appGUID, _ := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				var contents []string
+				for _, message := range messages {
+					contents = append(contents, message.Message())
+				}
+				Expect(contents).To(Equal([]string{"message-1", "message-2a", "message-2b", "message-3", "message-4", "message-5a", "message-5b"}))
+
+				Expect(messages[0].Type()).To(Equal("OUT"))
+				Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+				Expect(messages[0].SourceType()).To(Equal("APP/PROC/WEB"))
+				Expect(messages[0].SourceInstance()).To(Equal("0"))
+			})
+		})
+
+		Context("when the application has no log history", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns(nil, nil)
+			})
+
+			It("returns an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messages).ToNot(BeNil())
+				Expect(messages).To(BeEmpty())
+			})
+		})
+
+		Context("when NOAA errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
 	Describe("GetRecentLogsForApplicationByNameAndSpace", func() {
 		Context("when the application can be found", func() {
 			BeforeEach(func() {
@@ -429,4 +511,195 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStagingLogs", func() {
+		var (
+			messages []LogMessage
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			messages, warnings, err = actor.GetStagingLogs("some-app-guid", fakeNOAAClient)
+		})
+
+		Context("when the application has started staging", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{
+						GUID:             "some-app-guid",
+						PackageUpdatedAt: time.Unix(0, 5),
+					},
+					ccv2.Warnings{"some-app-warnings"},
+					nil,
+				)
+			})
+
+			Context("when NOAA returns logs", func() {
+				BeforeEach(func() {
+					outMessage := events.LogMessage_OUT
+					errMessage := events.LogMessage_ERR
+					ts1 := int64(10)
+					ts2 := int64(20)
+					ts3 := int64(30)
+					stagingSourceType := StagingLog
+					appSourceType := "APP"
+					sourceInstance := "0"
+
+					fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+						{
+							Message:        []byte("staging-message-2"),
+							MessageType:    &errMessage,
+							Timestamp:      &ts3,
+							SourceType:     &stagingSourceType,
+							SourceInstance: &sourceInstance,
+						},
+						{
+							Message:        []byte("app-message"),
+							MessageType:    &outMessage,
+							Timestamp:      &ts2,
+							SourceType:     &appSourceType,
+							SourceInstance: &sourceInstance,
+						},
+						{
+							Message:        []byte("staging-message-1"),
+							MessageType:    &outMessage,
+							Timestamp:      &ts1,
+							SourceType:     &stagingSourceType,
+							SourceInstance: &sourceInstance,
+						},
+					}, nil)
+				})
+
+				It("returns only the staging logs, sorted, and warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+					Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(1))
+					appGUID, authToken := fakeNOAAClient.RecentLogsArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(authToken).To(BeEmpty())
+
+					Expect(messages).To(HaveLen(2))
+					Expect(messages[0].Message()).To(Equal("staging-message-1"))
+					Expect(messages[0].Type()).To(Equal("OUT"))
+					Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+					Expect(messages[0].SourceType()).To(Equal(StagingLog))
+					Expect(messages[0].SourceInstance()).To(Equal("0"))
+
+					Expect(messages[1].Message()).To(Equal("staging-message-2"))
+					Expect(messages[1].Type()).To(Equal("ERR"))
+					Expect(messages[1].Timestamp()).To(Equal(time.Unix(0, 30)))
+				})
+			})
+
+			Context("when NOAA errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("ZOMG")
+					fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+				})
+
+				It("returns error and warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+				})
+			})
+		})
+
+		Context("when the application has not started staging", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{GUID: "some-app-guid"},
+					ccv2.Warnings{"some-app-warnings"},
+					nil,
+				)
+			})
+
+			It("returns an empty slice without fetching logs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(messages).ToNot(BeNil())
+				Expect(messages).To(BeEmpty())
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the application errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{}, ccv2.Warnings{"some-app-warnings"}, expectedErr)
+			})
+
+			It("returns error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetStreamingStagingLogs", func() {
+		var (
+			messages    <-chan *LogMessage
+			errs        <-chan error
+			eventStream chan *events.LogMessage
+			errStream   chan error
+		)
+
+		BeforeEach(func() {
+			eventStream = make(chan *events.LogMessage)
+			errStream = make(chan error)
+
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				go func() {
+					outMessage := events.LogMessage_OUT
+					ts := int64(10)
+					stagingSourceType := StagingLog
+					appSourceType := "APP"
+					sourceInstance := "0"
+
+					eventStream <- &events.LogMessage{
+						Message:        []byte("app-message"),
+						MessageType:    &outMessage,
+						Timestamp:      &ts,
+						SourceType:     &appSourceType,
+						SourceInstance: &sourceInstance,
+					}
+					eventStream <- &events.LogMessage{
+						Message:        []byte("staging-message"),
+						MessageType:    &outMessage,
+						Timestamp:      &ts,
+						SourceType:     &stagingSourceType,
+						SourceInstance: &sourceInstance,
+					}
+				}()
+
+				return eventStream, errStream
+			}
+		})
+
+		AfterEach(func() {
+			close(eventStream)
+			close(errStream)
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("passes through only the staging logs", func() {
+			messages, errs = actor.GetStreamingStagingLogs("some-app-guid", fakeNOAAClient, fakeConfig)
+
+			message := <-messages
+			Expect(message.Message()).To(Equal("staging-message"))
+			Expect(message.Staging()).To(BeTrue())
+			Expect(message.SourceInstance()).To(Equal("0"))
+		})
+	})
 })
@@ -0,0 +1,118 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Cloud Controller defaults for new applications. GenerateManifest omits
+// settings that match them.
+const (
+	DefaultManifestDiskQuota = 1024
+	DefaultManifestInstances = 1
+	DefaultManifestMemory    = 1024
+)
+
+// Manifest is an application manifest, as written by create-app-manifest.
+// Manifests are read with the pushaction/manifest package.
+type Manifest struct {
+	Applications []ManifestApplication `yaml:"applications"`
+}
+
+// ManifestApplication is a single application's entry in a Manifest. Memory
+// and DiskQuota are formatted as megabytes, e.g. "512M". Instances is nil
+// when it is omitted, so that an application scaled to zero keeps
+// "instances: 0".
+type ManifestApplication struct {
+	Name      string                 `yaml:"name"`
+	Instances *int                   `yaml:"instances,omitempty"`
+	Memory    string                 `yaml:"memory,omitempty"`
+	DiskQuota string                 `yaml:"disk_quota,omitempty"`
+	Buildpack string                 `yaml:"buildpack,omitempty"`
+	Command   string                 `yaml:"command,omitempty"`
+	Env       map[string]interface{} `yaml:"env,omitempty"`
+	Routes    []ManifestRoute        `yaml:"routes,omitempty"`
+	Services  []string               `yaml:"services,omitempty"`
+}
+
+// ManifestRoute is a route in a ManifestApplication, formatted as
+// host.domain/path for HTTP routes and domain:port for TCP routes.
+type ManifestRoute struct {
+	Route string `yaml:"route"`
+}
+
+// Marshal returns the manifest as YAML. Environment variable values keep
+// their type, so string values such as "true" or "8080" are quoted.
+func (manifest Manifest) Marshal() ([]byte, error) {
+	return yaml.Marshal(manifest)
+}
+
+// GenerateManifest returns a manifest for the application named appName in
+// the given space, containing its settings, environment variables, routes
+// and bound services. Settings that are unset or at the Cloud Controller
+// defaults are omitted. Routes and services are sorted.
+func (actor Actor) GenerateManifest(appName string, spaceGUID string) (Manifest, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Manifest{}, allWarnings, err
+	}
+
+	manifestApp := ManifestApplication{
+		Name:      app.Name,
+		Buildpack: app.Buildpack,
+		Command:   app.Command,
+	}
+
+	if app.Instances != DefaultManifestInstances {
+		instances := app.Instances
+		manifestApp.Instances = &instances
+	}
+	if app.Memory != 0 && app.Memory != DefaultManifestMemory {
+		manifestApp.Memory = fmt.Sprintf("%dM", app.Memory)
+	}
+	if app.DiskQuota != 0 && app.DiskQuota != DefaultManifestDiskQuota {
+		manifestApp.DiskQuota = fmt.Sprintf("%dM", app.DiskQuota)
+	}
+
+	env, warnings, err := actor.GetApplicationEnvironment(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Manifest{}, allWarnings, err
+	}
+	if len(env.UserProvided) > 0 {
+		manifestApp.Env = env.UserProvided
+	}
+
+	routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Manifest{}, allWarnings, err
+	}
+
+	var routeNames []string
+	for _, route := range routes {
+		routeNames = append(routeNames, route.String())
+	}
+	sort.Strings(routeNames)
+	for _, routeName := range routeNames {
+		manifestApp.Routes = append(manifestApp.Routes, ManifestRoute{Route: routeName})
+	}
+
+	serviceBindings, warnings, err := actor.GetServiceBindingsByApp(app.GUID, false)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Manifest{}, allWarnings, err
+	}
+
+	for _, serviceBinding := range serviceBindings {
+		manifestApp.Services = append(manifestApp.Services, serviceBinding.ServiceInstanceName)
+	}
+	sort.Strings(manifestApp.Services)
+
+	return Manifest{Applications: []ManifestApplication{manifestApp}}, allWarnings, nil
+}
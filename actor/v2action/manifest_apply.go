@@ -0,0 +1,257 @@
+package v2action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// InvalidManifestRouteError is returned when a manifest route does not match
+// any domain available to the space's organization.
+type InvalidManifestRouteError struct {
+	Route string
+}
+
+func (e InvalidManifestRouteError) Error() string {
+	return fmt.Sprintf("Route '%s' does not match any domain in the organization.", e.Route)
+}
+
+// ApplyResult is the outcome of applying one application of a manifest.
+// Created is false when an existing application was updated.
+type ApplyResult struct {
+	Name    string
+	GUID    string
+	Created bool
+}
+
+// ApplyManifest creates or updates each application, as read by
+// manifest.ReadAndMergeManifests, in the given space, maps its routes,
+// creating any that do not exist, and binds its services. The manifest's
+// environment variables are added to those of existing applications, and
+// services that are already bound are left alone. Applications are applied
+// in order, and the results of the applications applied before a failure are
+// returned alongside the error.
+func (actor Actor) ApplyManifest(spaceGUID string, manifestApps []manifest.Application) ([]ApplyResult, Warnings, error) {
+	var (
+		allWarnings Warnings
+		results     []ApplyResult
+		domains     []Domain
+	)
+
+	for _, manifestApp := range manifestApps {
+		if len(manifestApp.Routes) > 0 && domains == nil {
+			var (
+				warnings Warnings
+				err      error
+			)
+			domains, warnings, err = actor.getSpaceDomains(spaceGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return results, allWarnings, err
+			}
+		}
+
+		result, warnings, err := actor.applyManifestApplication(spaceGUID, manifestApp, domains)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return results, allWarnings, err
+		}
+		results = append(results, result)
+	}
+
+	return results, allWarnings, nil
+}
+
+func (actor Actor) applyManifestApplication(spaceGUID string, manifestApp manifest.Application, domains []Domain) (ApplyResult, Warnings, error) {
+	var allWarnings Warnings
+
+	desired := Application{
+		Name:                 manifestApp.Name,
+		SpaceGUID:            spaceGUID,
+		Instances:            manifestApp.Instances,
+		Memory:               manifestApp.Memory,
+		DiskQuota:            manifestApp.DiskQuota,
+		Buildpack:            manifestApp.BuildpackName,
+		Command:              manifestApp.Command,
+		EnvironmentVariables: manifestApp.Env,
+	}
+
+	app, created, warnings, err := actor.CreateApplicationIfNotExists(desired)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplyResult{}, allWarnings, err
+	}
+
+	if !created {
+		desired.GUID = app.GUID
+		desired.SpaceGUID = ""
+		if len(manifestApp.Env) > 0 {
+			desired.EnvironmentVariables, warnings, err = actor.mergeManifestEnvironment(app.GUID, manifestApp.Env)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return ApplyResult{}, allWarnings, err
+			}
+		}
+
+		_, warnings, err = actor.UpdateApplication(desired)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplyResult{}, allWarnings, err
+		}
+	}
+
+	for _, routeName := range manifestApp.Routes {
+		warnings, err = actor.mapManifestRoute(app.GUID, spaceGUID, routeName, domains)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplyResult{}, allWarnings, err
+		}
+	}
+
+	for _, serviceName := range manifestApp.Services {
+		warnings, err = actor.bindManifestService(app.GUID, spaceGUID, serviceName, created)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplyResult{}, allWarnings, err
+		}
+	}
+
+	return ApplyResult{Name: app.Name, GUID: app.GUID, Created: created}, allWarnings, nil
+}
+
+// mergeManifestEnvironment returns the application's user provided
+// environment variables with the manifest's variables added, since updating
+// an application's environment replaces all of its variables.
+func (actor Actor) mergeManifestEnvironment(appGUID string, manifestEnv map[string]interface{}) (map[string]interface{}, Warnings, error) {
+	env, warnings, err := actor.GetApplicationEnvironment(appGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	merged := map[string]interface{}{}
+	for name, value := range env.UserProvided {
+		merged[name] = value
+	}
+	for name, value := range manifestEnv {
+		merged[name] = value
+	}
+
+	return merged, warnings, nil
+}
+
+func (actor Actor) getSpaceDomains(spaceGUID string) ([]Domain, Warnings, error) {
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces([]ccv2.Query{
+		{Filter: ccv2.GUIDFilter, Operator: ccv2.EqualOperator, Value: spaceGUID},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+	if len(spaces) == 0 {
+		return nil, Warnings(warnings), SpaceNotFoundError{GUID: spaceGUID}
+	}
+
+	domains, domainWarnings, err := actor.GetOrganizationDomains(spaces[0].OrganizationGUID)
+	return domains, append(Warnings(warnings), domainWarnings...), err
+}
+
+// mapManifestRoute maps the route, given as host.domain/path or domain:port,
+// to the application, creating it in the space when it does not exist. The
+// longest domain that the route ends with is used, so routes on a subdomain
+// of another domain resolve to the subdomain.
+func (actor Actor) mapManifestRoute(appGUID string, spaceGUID string, routeName string, domains []Domain) (Warnings, error) {
+	route, err := parseManifestRoute(routeName, domains)
+	if err != nil {
+		return nil, err
+	}
+	route.SpaceGUID = spaceGUID
+
+	var port *int
+	if route.Port != 0 {
+		port = &route.Port
+	}
+
+	var allWarnings Warnings
+	existingRoute, found, warnings, err := actor.FindRoute(route.Domain.GUID, route.Host, route.Path, port)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if !found {
+		existingRoute, warnings, err = actor.CreateRoute(route, false)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	warnings, err = actor.MapRoute(appGUID, existingRoute.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func parseManifestRoute(routeName string, domains []Domain) (Route, error) {
+	hostname := routeName
+	route := Route{}
+
+	if i := strings.Index(hostname, "/"); i != -1 {
+		hostname, route.Path = hostname[:i], hostname[i:]
+	}
+
+	if i := strings.LastIndex(hostname, ":"); i != -1 {
+		port, err := strconv.Atoi(hostname[i+1:])
+		if err != nil {
+			return Route{}, InvalidManifestRouteError{Route: routeName}
+		}
+		hostname, route.Port = hostname[:i], port
+	}
+
+	var domain Domain
+	for _, candidate := range domains {
+		if hostname != candidate.Name && !strings.HasSuffix(hostname, "."+candidate.Name) {
+			continue
+		}
+		if len(candidate.Name) > len(domain.Name) {
+			domain = candidate
+		}
+	}
+	if domain.GUID == "" {
+		return Route{}, InvalidManifestRouteError{Route: routeName}
+	}
+
+	route.Domain = domain
+	route.Host = strings.TrimSuffix(strings.TrimSuffix(hostname, domain.Name), ".")
+	return route, nil
+}
+
+// bindManifestService binds the service instance to the application unless it
+// is already bound. Newly created applications have no bindings, so the
+// lookup is skipped for them.
+func (actor Actor) bindManifestService(appGUID string, spaceGUID string, serviceName string, created bool) (Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if !created {
+		_, warnings, err = actor.GetServiceBindingByApplicationAndServiceInstance(appGUID, serviceInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		switch err.(type) {
+		case nil:
+			return allWarnings, nil
+		case ServiceBindingNotFoundError:
+		default:
+			return allWarnings, err
+		}
+	}
+
+	_, ccWarnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstance.GUID, nil)
+	allWarnings = append(allWarnings, ccWarnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,209 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Apply Actions", func() {
+	Describe("ApplyManifest", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+			manifestApps []manifest.Application
+			results      []ApplyResult
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+			manifestApps = []manifest.Application{
+				{
+					Name:      "new-app",
+					Memory:    512,
+					Instances: 2,
+					Routes:    []string{"new-app.apps.com/some-path"},
+					Services:  []string{"some-service"},
+				},
+				{
+					Name:     "existing-app",
+					Command:  "some-command",
+					Env:      map[string]interface{}{"SOME_VAR": "true"},
+					Routes:   []string{"tcp.com:1024"},
+					Services: []string{"some-service"},
+				},
+			}
+
+			fakeCloudControllerClient.GetApplicationsStub = func(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				for _, query := range queries {
+					if query.Filter == ccv2.NameFilter && query.Value == "existing-app" {
+						return []ccv2.Application{{GUID: "existing-app-guid", Name: "existing-app"}}, ccv2.Warnings{"get-apps-warning"}, nil
+					}
+				}
+				return nil, ccv2.Warnings{"get-apps-warning"}, nil
+			}
+			fakeCloudControllerClient.CreateApplicationStub = func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+				app.GUID = app.Name + "-guid"
+				return app, ccv2.Warnings{"create-app-warning"}, nil
+			}
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{
+				UserProvided: map[string]interface{}{"EXISTING_VAR": "existing", "SOME_VAR": "false"},
+			}, ccv2.Warnings{"get-env-warning"}, nil)
+
+			fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid", OrganizationGUID: "some-org-guid"}}, nil, nil)
+			fakeCloudControllerClient.GetSharedDomainsReturns([]ccv2.Domain{
+				{GUID: "apps-domain-guid", Name: "apps.com"},
+				{GUID: "tcp-domain-guid", Name: "tcp.com"},
+			}, nil, nil)
+
+			fakeCloudControllerClient.GetRoutesStub = func(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+				if queries[0].Value == "tcp-domain-guid" {
+					return []ccv2.Route{{GUID: "tcp-route-guid", Port: 1024, DomainGUID: "tcp-domain-guid"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "tcp-domain-guid", Name: "tcp.com"}, nil, nil)
+			fakeCloudControllerClient.CreateRouteReturns(ccv2.Route{GUID: "http-route-guid"}, ccv2.Warnings{"create-route-warning"}, nil)
+
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns([]ccv2.ServiceInstance{{GUID: "some-service-guid", Name: "some-service"}}, nil, nil)
+			fakeCloudControllerClient.GetServiceBindingsReturns([]ccv2.ServiceBinding{{GUID: "some-binding-guid"}}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			results, warnings, executeErr = actor.ApplyManifest("some-space-guid", manifestApps)
+		})
+
+		It("creates the new application and updates the existing one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]ApplyResult{
+				{Name: "new-app", GUID: "new-app-guid", Created: true},
+				{Name: "existing-app", GUID: "existing-app-guid", Created: false},
+			}))
+			Expect(warnings).To(ContainElement("create-app-warning"))
+			Expect(warnings).To(ContainElement("update-app-warning"))
+			Expect(warnings).To(ContainElement("get-env-warning"))
+
+			Expect(fakeCloudControllerClient.CreateApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+				Name:      "new-app",
+				SpaceGUID: "some-space-guid",
+				Memory:    512,
+				Instances: 2,
+			}))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+				GUID:    "existing-app-guid",
+				Name:    "existing-app",
+				Command: "some-command",
+				EnvironmentVariables: map[string]interface{}{
+					"EXISTING_VAR": "existing",
+					"SOME_VAR":     "true",
+				},
+			}))
+		})
+
+		It("only fetches the environment of existing applications", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("existing-app-guid"))
+		})
+
+		Context("when the existing application's manifest has no environment variables", func() {
+			BeforeEach(func() {
+				manifestApps[1].Env = nil
+			})
+
+			It("leaves its environment variables alone", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0).EnvironmentVariables).To(BeNil())
+			})
+		})
+
+		Context("when getting the existing application's environment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-env-error")
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"get-env-warning"}, expectedErr)
+			})
+
+			It("does not update the application", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-env-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		It("creates missing routes and maps each route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+			route, _ := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+			Expect(route).To(Equal(ccv2.Route{
+				DomainGUID: "apps-domain-guid",
+				Host:       "new-app",
+				Path:       "/some-path",
+				SpaceGUID:  "some-space-guid",
+			}))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationRouteCallCount()).To(Equal(2))
+			appGUID, routeGUID := fakeCloudControllerClient.UpdateApplicationRouteArgsForCall(0)
+			Expect(appGUID).To(Equal("new-app-guid"))
+			Expect(routeGUID).To(Equal("http-route-guid"))
+			appGUID, routeGUID = fakeCloudControllerClient.UpdateApplicationRouteArgsForCall(1)
+			Expect(appGUID).To(Equal("existing-app-guid"))
+			Expect(routeGUID).To(Equal("tcp-route-guid"))
+		})
+
+		It("binds services that are not already bound", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID, _ := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("new-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("some-service-guid"))
+		})
+
+		Context("when a route does not match any domain", func() {
+			BeforeEach(func() {
+				manifestApps[0].Routes = []string{"some-host.unknown.com"}
+			})
+
+			It("returns an InvalidManifestRouteError", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestRouteError{Route: "some-host.unknown.com"}))
+				Expect(results).To(BeEmpty())
+			})
+		})
+
+		Context("when updating an application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update-app-error")
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, expectedErr)
+			})
+
+			It("returns the results so far, the warnings and the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(results).To(Equal([]ApplyResult{
+					{Name: "new-app", GUID: "new-app-guid", Created: true},
+				}))
+				Expect(warnings).To(ContainElement("update-app-warning"))
+			})
+		})
+	})
+})
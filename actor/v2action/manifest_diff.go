@@ -0,0 +1,111 @@
+package v2action
+
+import (
+	"reflect"
+	"sort"
+)
+
+// ManifestFieldDiff is a single application setting that differs between the
+// live application and the desired settings. Old is nil when the setting is
+// not currently set.
+type ManifestFieldDiff struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// ManifestDiff is the list of settings that would change when applying the
+// desired settings to an application.
+type ManifestDiff []ManifestFieldDiff
+
+// DiffManifest compares the desired settings with the application named
+// appName in the given space. Memory, instances, buildpack, command, stack
+// and environment variables are compared; settings that are not specified in
+// desired (zero values) and settings that would not change are omitted.
+// Environment variables are compared per variable as "env.NAME", and
+// variables that are not in desired are left alone, so they are not reported.
+// The stack is reported by name.
+func (actor Actor) DiffManifest(appName string, spaceGUID string, desired Application) (ManifestDiff, Warnings, error) {
+	var allWarnings Warnings
+
+	current, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	diff := ManifestDiff{}
+
+	if desired.Memory != 0 && desired.Memory != current.Memory {
+		diff = append(diff, ManifestFieldDiff{Field: "memory", Old: current.Memory, New: desired.Memory})
+	}
+	if desired.Instances != 0 && desired.Instances != current.Instances {
+		diff = append(diff, ManifestFieldDiff{Field: "instances", Old: current.Instances, New: desired.Instances})
+	}
+	if desired.Buildpack != "" && desired.Buildpack != current.Buildpack {
+		diff = append(diff, ManifestFieldDiff{Field: "buildpack", Old: optionalString(current.Buildpack), New: desired.Buildpack})
+	}
+	if desired.Command != "" && desired.Command != current.Command {
+		diff = append(diff, ManifestFieldDiff{Field: "command", Old: optionalString(current.Command), New: desired.Command})
+	}
+
+	if desired.StackGUID != "" && desired.StackGUID != current.StackGUID {
+		stackDiff := ManifestFieldDiff{Field: "stack"}
+
+		if current.StackGUID != "" {
+			currentStack, warnings, err := actor.GetStack(current.StackGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			stackDiff.Old = currentStack.Name
+		}
+
+		desiredStack, warnings, err := actor.GetStack(desired.StackGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		stackDiff.New = desiredStack.Name
+
+		diff = append(diff, stackDiff)
+	}
+
+	if len(desired.EnvironmentVariables) == 0 {
+		return diff, allWarnings, nil
+	}
+
+	env, warnings, err := actor.GetApplicationEnvironment(current.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var envNames []string
+	for name := range desired.EnvironmentVariables {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+
+	for _, name := range envNames {
+		newValue := desired.EnvironmentVariables[name]
+		oldValue, exists := env.UserProvided[name]
+		switch {
+		case !exists:
+			diff = append(diff, ManifestFieldDiff{Field: "env." + name, New: newValue})
+		case !reflect.DeepEqual(oldValue, newValue):
+			diff = append(diff, ManifestFieldDiff{Field: "env." + name, Old: oldValue, New: newValue})
+		}
+	}
+
+	return diff, allWarnings, nil
+}
+
+// optionalString returns nil for an empty string so that unset settings are
+// reported with a nil Old value.
+func optionalString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
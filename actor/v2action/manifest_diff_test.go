@@ -0,0 +1,159 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Diff Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("DiffManifest", func() {
+		var (
+			desired    Application
+			diff       ManifestDiff
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			desired = Application{}
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{
+						GUID:      "some-app-guid",
+						Name:      "some-app",
+						Memory:    256,
+						Instances: 2,
+						Buildpack: "ruby_buildpack",
+						StackGUID: "cflinuxfs2-guid",
+					},
+				},
+				ccv2.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+				ccv2.ApplicationEnvironment{
+					UserProvided: map[string]interface{}{
+						"UNCHANGED": "same",
+						"CHANGED":   "old",
+						"NESTED":    map[string]interface{}{"key": "value"},
+					},
+				},
+				ccv2.Warnings{"get-env-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetStackStub = func(guid string) (ccv2.Stack, ccv2.Warnings, error) {
+				return ccv2.Stack{GUID: guid, Name: map[string]string{
+					"cflinuxfs2-guid": "cflinuxfs2",
+					"cflinuxfs3-guid": "cflinuxfs3",
+				}[guid]}, ccv2.Warnings{"get-stack-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			diff, warnings, executeErr = actor.DiffManifest("some-app", "some-space-guid", desired)
+		})
+
+		Context("when the desired settings match the app", func() {
+			BeforeEach(func() {
+				desired = Application{
+					Memory:    256,
+					Instances: 2,
+					Buildpack: "ruby_buildpack",
+					StackGUID: "cflinuxfs2-guid",
+					EnvironmentVariables: map[string]interface{}{
+						"UNCHANGED": "same",
+						"NESTED":    map[string]interface{}{"key": "value"},
+					},
+				}
+			})
+
+			It("returns an empty diff", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diff).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-env-warning"))
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when settings differ", func() {
+			BeforeEach(func() {
+				desired = Application{
+					Memory:    512,
+					Instances: 2,
+					Command:   "bundle exec rackup",
+					StackGUID: "cflinuxfs3-guid",
+					EnvironmentVariables: map[string]interface{}{
+						"UNCHANGED": "same",
+						"CHANGED":   "new",
+						"ADDED":     "value",
+					},
+				}
+			})
+
+			It("returns only the changed settings with their old and new values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diff).To(Equal(ManifestDiff{
+					{Field: "memory", Old: uint64(256), New: uint64(512)},
+					{Field: "command", Old: nil, New: "bundle exec rackup"},
+					{Field: "stack", Old: "cflinuxfs2", New: "cflinuxfs3"},
+					{Field: "env.ADDED", Old: nil, New: "value"},
+					{Field: "env.CHANGED", Old: "old", New: "new"},
+				}))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-stack-warning", "get-stack-warning", "get-env-warning"))
+			})
+		})
+
+		Context("when looking up a stack fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				desired = Application{StackGUID: "cflinuxfs3-guid"}
+				expectedErr = errors.New("get stack failed")
+				fakeCloudControllerClient.GetStackStub = nil
+				fakeCloudControllerClient.GetStackReturns(ccv2.Stack{}, ccv2.Warnings{"get-stack-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-stack-warning"))
+			})
+		})
+
+		Context("when no environment variables are desired", func() {
+			BeforeEach(func() {
+				desired = Application{Memory: 512}
+			})
+
+			It("does not get the app's environment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+	})
+})
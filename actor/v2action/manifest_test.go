@@ -0,0 +1,238 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GenerateManifest", func() {
+		var (
+			manifest   Manifest
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{
+						GUID:      "some-app-guid",
+						Name:      "some-app",
+						SpaceGUID: "some-space-guid",
+						Memory:    256,
+						DiskQuota: 2048,
+						Instances: 3,
+						Buildpack: "ruby_buildpack",
+						Command:   "bundle exec rackup",
+					},
+				},
+				ccv2.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+				ccv2.ApplicationEnvironment{
+					UserProvided: map[string]interface{}{
+						"ENABLED":  "true",
+						"PORT":     "8080",
+						"GREETING": "hello: world",
+						"WORKERS":  float64(4),
+					},
+				},
+				ccv2.Warnings{"get-env-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationRoutesReturns(
+				[]ccv2.Route{
+					{GUID: "route-guid-1", Host: "some-host", Path: "/some-path", DomainGUID: "shared-domain-guid"},
+					{GUID: "route-guid-2", Port: 1024, DomainGUID: "tcp-domain-guid"},
+				},
+				ccv2.Warnings{"get-routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSharedDomainStub = func(guid string) (ccv2.Domain, ccv2.Warnings, error) {
+				return ccv2.Domain{GUID: guid, Name: map[string]string{
+					"shared-domain-guid": "shared.com",
+					"tcp-domain-guid":    "tcp.com",
+				}[guid]}, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationServiceBindingsReturns(
+				[]ccv2.ServiceBinding{
+					{GUID: "binding-guid-1", ServiceInstanceGUID: "instance-guid-1"},
+					{GUID: "binding-guid-2", ServiceInstanceGUID: "instance-guid-2"},
+				},
+				ccv2.Warnings{"get-bindings-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{GUID: "some-app-guid", SpaceGUID: "some-space-guid"}, nil, nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "instance-guid-1", Name: "some-service"},
+					{GUID: "instance-guid-2", Name: "another-service"},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			manifest, warnings, executeErr = actor.GenerateManifest("some-app", "some-space-guid")
+		})
+
+		It("returns the application's settings, routes and services", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-env-warning", "get-routes-warning", "get-bindings-warning"))
+
+			instances := 3
+			Expect(manifest).To(Equal(Manifest{
+				Applications: []ManifestApplication{
+					{
+						Name:      "some-app",
+						Instances: &instances,
+						Memory:    "256M",
+						DiskQuota: "2048M",
+						Buildpack: "ruby_buildpack",
+						Command:   "bundle exec rackup",
+						Env: map[string]interface{}{
+							"ENABLED":  "true",
+							"PORT":     "8080",
+							"GREETING": "hello: world",
+							"WORKERS":  float64(4),
+						},
+						Routes: []ManifestRoute{
+							{Route: "some-host.shared.com/some-path"},
+							{Route: "tcp.com:1024"},
+						},
+						Services: []string{"another-service", "some-service"},
+					},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(1))
+			appGUID, _ := fakeCloudControllerClient.GetApplicationRoutesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		It("serializes to YAML with quoted string environment variable values", func() {
+			raw, err := manifest.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(`applications:
+- name: some-app
+  instances: 3
+  memory: 256M
+  disk_quota: 2048M
+  buildpack: ruby_buildpack
+  command: bundle exec rackup
+  env:
+    ENABLED: "true"
+    GREETING: 'hello: world'
+    PORT: "8080"
+    WORKERS: 4
+  routes:
+  - route: some-host.shared.com/some-path
+  - route: tcp.com:1024
+  services:
+  - another-service
+  - some-service
+`))
+		})
+
+		Context("when the application uses the Cloud Controller defaults", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{
+							GUID:      "some-app-guid",
+							Name:      "some-app",
+							Memory:    1024,
+							DiskQuota: 1024,
+							Instances: 1,
+						},
+					},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, nil, nil)
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, nil, nil)
+				fakeCloudControllerClient.GetApplicationServiceBindingsReturns(nil, nil, nil)
+			})
+
+			It("omits the default settings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				raw, err := manifest.Marshal()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("applications:\n- name: some-app\n"))
+			})
+		})
+
+		Context("when the application is scaled to zero instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{
+							GUID:      "some-app-guid",
+							Name:      "some-app",
+							Memory:    1024,
+							DiskQuota: 1024,
+							Instances: 0,
+						},
+					},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, nil, nil)
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, nil, nil)
+				fakeCloudControllerClient.GetApplicationServiceBindingsReturns(nil, nil, nil)
+			})
+
+			It("keeps the instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				raw, err := manifest.Marshal()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("applications:\n- name: some-app\n  instances: 0\n"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+
+		Context("when getting the routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-routes-error")
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-env-warning", "get-routes-warning"))
+			})
+		})
+	})
+})
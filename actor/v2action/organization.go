@@ -35,6 +35,41 @@ func (e MultipleOrganizationsFoundError) Error() string {
 	return fmt.Sprintf("Organization name '%s' matches multiple GUIDs: %s", e.Name, guids)
 }
 
+// OrganizationNameTakenError is returned when creating an organization whose
+// name is already in use.
+type OrganizationNameTakenError struct {
+	Name string
+}
+
+func (e OrganizationNameTakenError) Error() string {
+	return fmt.Sprintf("Organization '%s' already exists.", e.Name)
+}
+
+// CreateOrganization creates an organization with the given name. If
+// quotaGUID is nil, the Cloud Controller assigns its default quota. If
+// managerGUID is not empty, that user is added to the new organization as an
+// org manager. When assigning the role fails, the created organization is
+// returned with the error.
+func (actor Actor) CreateOrganization(orgName string, quotaGUID *string, managerGUID string) (Organization, Warnings, error) {
+	org, allWarnings, err := actor.CloudControllerClient.CreateOrganization(orgName, quotaGUID)
+	if _, ok := err.(ccerror.OrganizationNameTakenError); ok {
+		return Organization{}, Warnings(allWarnings), OrganizationNameTakenError{Name: orgName}
+	}
+	if err != nil || managerGUID == "" {
+		return Organization(org), Warnings(allWarnings), err
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUser(org.GUID, managerGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization(org), Warnings(allWarnings), err
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateOrganizationManager(org.GUID, managerGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return Organization(org), Warnings(allWarnings), err
+}
+
 // GetOrganization returns an Organization based on the provided guid.
 func (actor Actor) GetOrganization(guid string) (Organization, Warnings, error) {
 	org, warnings, err := actor.CloudControllerClient.GetOrganization(guid)
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -26,3 +27,117 @@ func (actor Actor) GetOrganizationQuota(guid string) (OrganizationQuota, Warning
 
 	return OrganizationQuota(orgQuota), Warnings(warnings), err
 }
+
+// QuotaLimit is a single quota limit. Unlimited is true when the Cloud
+// Controller does not enforce the limit, in which case Value is 0.
+type QuotaLimit struct {
+	Value     int
+	Unlimited bool
+}
+
+// String returns the limit's value, or "unlimited".
+func (limit QuotaLimit) String() string {
+	if limit.Unlimited {
+		return "unlimited"
+	}
+	return strconv.Itoa(limit.Value)
+}
+
+func newQuotaLimit(value int) QuotaLimit {
+	if value == ccv2.UnlimitedQuota {
+		return QuotaLimit{Unlimited: true}
+	}
+	return QuotaLimit{Value: value}
+}
+
+func (limit QuotaLimit) ccValue() int {
+	if limit.Unlimited {
+		return ccv2.UnlimitedQuota
+	}
+	return limit.Value
+}
+
+// OrgQuota is an organization quota's limits. Memory limits are in
+// megabytes.
+type OrgQuota struct {
+	GUID                  string
+	Name                  string
+	MemoryLimit           QuotaLimit
+	InstanceMemoryLimit   QuotaLimit
+	RoutesLimit           QuotaLimit
+	ServiceInstancesLimit QuotaLimit
+	PaidServicesAllowed   bool
+}
+
+func newOrgQuota(orgQuota ccv2.OrganizationQuota) OrgQuota {
+	return OrgQuota{
+		GUID:                  orgQuota.GUID,
+		Name:                  orgQuota.Name,
+		MemoryLimit:           newQuotaLimit(orgQuota.MemoryLimit),
+		InstanceMemoryLimit:   newQuotaLimit(orgQuota.InstanceMemoryLimit),
+		RoutesLimit:           newQuotaLimit(orgQuota.TotalRoutes),
+		ServiceInstancesLimit: newQuotaLimit(orgQuota.TotalServices),
+		PaidServicesAllowed:   orgQuota.NonBasicServicesAllowed,
+	}
+}
+
+// InstanceMemoryLimitExceedsMemoryLimitError is returned when a quota's
+// instance memory limit is larger than its total memory limit.
+type InstanceMemoryLimitExceedsMemoryLimitError struct {
+	MemoryLimit         int
+	InstanceMemoryLimit int
+}
+
+func (e InstanceMemoryLimitExceedsMemoryLimitError) Error() string {
+	return fmt.Sprintf("Instance memory limit %dM exceeds the memory limit %dM.", e.InstanceMemoryLimit, e.MemoryLimit)
+}
+
+// GetOrganizationQuotas returns all organization quotas.
+func (actor Actor) GetOrganizationQuotas() ([]OrgQuota, Warnings, error) {
+	ccv2OrgQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var orgQuotas []OrgQuota
+	for _, orgQuota := range ccv2OrgQuotas {
+		orgQuotas = append(orgQuotas, newOrgQuota(orgQuota))
+	}
+
+	return orgQuotas, Warnings(warnings), nil
+}
+
+// SetOrganizationQuota assigns the organization quota with the given GUID to
+// the organization.
+func (actor Actor) SetOrganizationQuota(orgGUID string, quotaGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(orgGUID, quotaGUID)
+	return Warnings(warnings), err
+}
+
+// CreateOrganizationQuota creates an organization quota with the given name
+// and limits. An InstanceMemoryLimitExceedsMemoryLimitError is returned,
+// without creating the quota, when both memory limits are set and the
+// instance memory limit is larger than the total memory limit.
+func (actor Actor) CreateOrganizationQuota(orgQuota OrgQuota) (OrgQuota, Warnings, error) {
+	memory, instanceMemory := orgQuota.MemoryLimit, orgQuota.InstanceMemoryLimit
+	if !memory.Unlimited && !instanceMemory.Unlimited && instanceMemory.Value > memory.Value {
+		return OrgQuota{}, nil, InstanceMemoryLimitExceedsMemoryLimitError{
+			MemoryLimit:         memory.Value,
+			InstanceMemoryLimit: instanceMemory.Value,
+		}
+	}
+
+	createdOrgQuota, warnings, err := actor.CloudControllerClient.CreateOrganizationQuota(ccv2.OrganizationQuota{
+		Name:                    orgQuota.Name,
+		MemoryLimit:             memory.ccValue(),
+		InstanceMemoryLimit:     instanceMemory.ccValue(),
+		TotalRoutes:             orgQuota.RoutesLimit.ccValue(),
+		TotalServices:           orgQuota.ServiceInstancesLimit.ccValue(),
+		NonBasicServicesAllowed: orgQuota.PaidServicesAllowed,
+	})
+	if err != nil {
+		return OrgQuota{}, Warnings(warnings), err
+	}
+
+	return newOrgQuota(createdOrgQuota), Warnings(warnings), nil
+}
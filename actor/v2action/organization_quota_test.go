@@ -75,4 +75,152 @@ var _ = Describe("OrganizationQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("QuotaLimit", func() {
+		It("formats unlimited limits", func() {
+			Expect(QuotaLimit{Value: 10}.String()).To(Equal("10"))
+			Expect(QuotaLimit{Unlimited: true}.String()).To(Equal("unlimited"))
+		})
+	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when getting the quotas succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{
+						{
+							GUID:                    "some-org-quota-guid",
+							Name:                    "some-org-quota",
+							MemoryLimit:             10240,
+							InstanceMemoryLimit:     ccv2.UnlimitedQuota,
+							TotalRoutes:             1000,
+							TotalServices:           ccv2.UnlimitedQuota,
+							NonBasicServicesAllowed: true,
+						},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the quotas with unlimited values marked", func() {
+				orgQuotas, warnings, err := actor.GetOrganizationQuotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(orgQuotas).To(Equal([]OrgQuota{
+					{
+						GUID:                  "some-org-quota-guid",
+						Name:                  "some-org-quota",
+						MemoryLimit:           QuotaLimit{Value: 10240},
+						InstanceMemoryLimit:   QuotaLimit{Unlimited: true},
+						RoutesLimit:           QuotaLimit{Value: 1000},
+						ServiceInstancesLimit: QuotaLimit{Unlimited: true},
+						PaidServicesAllowed:   true,
+					},
+				}))
+			})
+		})
+
+		Context("when getting the quotas fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-quotas-error")
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationQuotas()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("SetOrganizationQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv2.Warnings{"warning-1"}, nil)
+		})
+
+		It("assigns the quota to the organization", func() {
+			warnings, err := actor.SetOrganizationQuota("some-org-guid", "some-org-quota-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(1))
+			orgGUID, quotaGUID := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quotaGUID).To(Equal("some-org-quota-guid"))
+		})
+	})
+
+	Describe("CreateOrganizationQuota", func() {
+		var (
+			orgQuota   OrgQuota
+			created    OrgQuota
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			orgQuota = OrgQuota{
+				Name:                  "some-org-quota",
+				MemoryLimit:           QuotaLimit{Value: 2048},
+				InstanceMemoryLimit:   QuotaLimit{Value: 512},
+				RoutesLimit:           QuotaLimit{Unlimited: true},
+				ServiceInstancesLimit: QuotaLimit{Value: 10},
+			}
+			fakeCloudControllerClient.CreateOrganizationQuotaStub = func(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+				orgQuota.GUID = "some-org-quota-guid"
+				return orgQuota, ccv2.Warnings{"warning-1"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			created, warnings, executeErr = actor.CreateOrganizationQuota(orgQuota)
+		})
+
+		It("creates the quota", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0)).To(Equal(ccv2.OrganizationQuota{
+				Name:                "some-org-quota",
+				MemoryLimit:         2048,
+				InstanceMemoryLimit: 512,
+				TotalRoutes:         ccv2.UnlimitedQuota,
+				TotalServices:       10,
+			}))
+
+			expected := orgQuota
+			expected.GUID = "some-org-quota-guid"
+			Expect(created).To(Equal(expected))
+		})
+
+		Context("when the instance memory limit exceeds the memory limit", func() {
+			BeforeEach(func() {
+				orgQuota.InstanceMemoryLimit = QuotaLimit{Value: 4096}
+			})
+
+			It("returns an InstanceMemoryLimitExceedsMemoryLimitError without creating the quota", func() {
+				Expect(executeErr).To(MatchError(InstanceMemoryLimitExceedsMemoryLimitError{
+					MemoryLimit:         2048,
+					InstanceMemoryLimit: 4096,
+				}))
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the instance memory limit is unlimited", func() {
+			BeforeEach(func() {
+				orgQuota.InstanceMemoryLimit = QuotaLimit{Unlimited: true}
+			})
+
+			It("creates the quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0).InstanceMemoryLimit).To(Equal(ccv2.UnlimitedQuota))
+			})
+		})
+	})
 })
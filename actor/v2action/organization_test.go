@@ -300,4 +300,166 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		var (
+			quotaGUID   *string
+			managerGUID string
+
+			org        Organization
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			quotaGUID = nil
+			managerGUID = ""
+		})
+
+		JustBeforeEach(func() {
+			org, warnings, executeErr = actor.CreateOrganization("some-org", quotaGUID, managerGUID)
+		})
+
+		Context("when the organization is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateOrganizationReturns(
+					ccv2.Organization{GUID: "some-org-guid", Name: "some-org"},
+					ccv2.Warnings{"create-warning"},
+					nil)
+			})
+
+			Context("when a quota is provided", func() {
+				BeforeEach(func() {
+					quota := "some-quota-guid"
+					quotaGUID = &quota
+				})
+
+				It("creates the org with the quota", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("create-warning"))
+					Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+
+					Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(1))
+					orgName, passedQuotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+					Expect(orgName).To(Equal("some-org"))
+					Expect(passedQuotaGUID).ToNot(BeNil())
+					Expect(*passedQuotaGUID).To(Equal("some-quota-guid"))
+				})
+			})
+
+			Context("when the quota is nil", func() {
+				It("omits the quota", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(1))
+					_, passedQuotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+					Expect(passedQuotaGUID).To(BeNil())
+				})
+			})
+
+			Context("when no manager is provided", func() {
+				It("does not assign any roles", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.UpdateOrganizationManagerCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a manager is provided", func() {
+				BeforeEach(func() {
+					managerGUID = "some-user-guid"
+				})
+
+				Context("when no errors are encountered", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.UpdateOrganizationUserReturns(ccv2.Warnings{"user-warning"}, nil)
+						fakeCloudControllerClient.UpdateOrganizationManagerReturns(ccv2.Warnings{"manager-warning"}, nil)
+					})
+
+					It("adds the user to the new org as a manager and returns all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("create-warning", "user-warning", "manager-warning"))
+						Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+
+						Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(1))
+						orgGUID, userGUID := fakeCloudControllerClient.UpdateOrganizationUserArgsForCall(0)
+						Expect(orgGUID).To(Equal("some-org-guid"))
+						Expect(userGUID).To(Equal("some-user-guid"))
+
+						Expect(fakeCloudControllerClient.UpdateOrganizationManagerCallCount()).To(Equal(1))
+						orgGUID, userGUID = fakeCloudControllerClient.UpdateOrganizationManagerArgsForCall(0)
+						Expect(orgGUID).To(Equal("some-org-guid"))
+						Expect(userGUID).To(Equal("some-user-guid"))
+					})
+				})
+
+				Context("when adding the user to the org errors", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("user-error")
+						fakeCloudControllerClient.UpdateOrganizationUserReturns(ccv2.Warnings{"user-warning"}, expectedErr)
+					})
+
+					It("returns the created org, the error and all warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(warnings).To(ConsistOf("create-warning", "user-warning"))
+						Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+						Expect(fakeCloudControllerClient.UpdateOrganizationManagerCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when assigning the manager role errors", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("manager-error")
+						fakeCloudControllerClient.UpdateOrganizationUserReturns(ccv2.Warnings{"user-warning"}, nil)
+						fakeCloudControllerClient.UpdateOrganizationManagerReturns(ccv2.Warnings{"manager-warning"}, expectedErr)
+					})
+
+					It("returns the created org, the error and all warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(warnings).To(ConsistOf("create-warning", "user-warning", "manager-warning"))
+						Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+					})
+				})
+			})
+		})
+
+		Context("when the organization name is taken", func() {
+			BeforeEach(func() {
+				managerGUID = "some-user-guid"
+				fakeCloudControllerClient.CreateOrganizationReturns(
+					ccv2.Organization{},
+					ccv2.Warnings{"create-warning"},
+					ccerror.OrganizationNameTakenError{Message: "name taken"})
+			})
+
+			It("returns an OrganizationNameTakenError and all warnings", func() {
+				Expect(executeErr).To(MatchError(OrganizationNameTakenError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the organization errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				managerGUID = "some-user-guid"
+				expectedErr = errors.New("create-error")
+				fakeCloudControllerClient.CreateOrganizationReturns(
+					ccv2.Organization{},
+					ccv2.Warnings{"create-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -28,6 +29,44 @@ func (RouteInDifferentSpaceError) Error() string {
 	return "route registered to another space"
 }
 
+// RouteInUseError is returned when the Cloud Controller rejects mapping a
+// route because it is already mapped.
+type RouteInUseError struct {
+	AppGUID   string
+	RouteGUID string
+}
+
+func (e RouteInUseError) Error() string {
+	return fmt.Sprintf("Route with guid %s is already in use and cannot be mapped to application with guid %s", e.RouteGUID, e.AppGUID)
+}
+
+// MultipleRoutesFoundError is returned when a route search matches more than
+// one route.
+type MultipleRoutesFoundError struct {
+	Host  string
+	Path  string
+	Port  int
+	GUIDs []string
+}
+
+func (e MultipleRoutesFoundError) Error() string {
+	guids := strings.Join(e.GUIDs, ", ")
+	if e.Port != 0 {
+		return fmt.Sprintf("Route with port %d matches multiple GUIDs: %s", e.Port, guids)
+	}
+	return fmt.Sprintf("Route with host '%s' and path '%s' matches multiple GUIDs: %s", e.Host, e.Path, guids)
+}
+
+// TCPDomainRequiredError is returned when a TCP route is requested on a
+// domain that is not a shared domain with a TCP router group.
+type TCPDomainRequiredError struct {
+	Domain string
+}
+
+func (e TCPDomainRequiredError) Error() string {
+	return fmt.Sprintf("Domain '%s' is not a TCP domain.", e.Domain)
+}
+
 // RouteNotFoundError is returned when a route cannot be found
 type RouteNotFoundError struct {
 	Host       string
@@ -91,6 +130,36 @@ func (actor Actor) CreateRoute(route Route, generatePort bool) (Route, Warnings,
 	return CCToActorRoute(returnedRoute, route.Domain), Warnings(warnings), err
 }
 
+// CreateTCPRoute reserves a port on the given TCP domain and creates a route
+// for it in the given space. If port is nil, the router group assigns a random
+// port. The returned route contains the assigned port.
+func (actor Actor) CreateTCPRoute(spaceGUID string, domainGUID string, port *int) (Route, Warnings, error) {
+	domain, allWarnings, err := actor.GetDomain(domainGUID)
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	if !domain.Shared || domain.RouterGroupType != ccv2.RouterGroupTypeTCP {
+		return Route{}, allWarnings, TCPDomainRequiredError{Domain: domain.Name}
+	}
+
+	route := Route{
+		Domain:    domain,
+		SpaceGUID: spaceGUID,
+	}
+	if port != nil {
+		route.Port = *port
+	}
+
+	createdRoute, warnings, err := actor.CreateRoute(route, port == nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	return createdRoute, allWarnings, nil
+}
+
 // GetOrphanedRoutesBySpace returns a list of orphaned routes associated with
 // the provided Space GUID.
 func (actor Actor) GetOrphanedRoutesBySpace(spaceGUID string) ([]Route, Warnings, error) {
@@ -125,7 +194,9 @@ func (actor Actor) GetOrphanedRoutesBySpace(spaceGUID string) ([]Route, Warnings
 }
 
 // GetApplicationRoutes returns a list of routes associated with the provided
-// Application GUID.
+// Application GUID. The routes are fetched from /v2/apps/:guid/routes and
+// each route's domain is resolved, so Route.String can format TCP routes as
+// domain:port and HTTP routes as host.domain/path.
 func (actor Actor) GetApplicationRoutes(applicationGUID string) (Routes, Warnings, error) {
 	var allWarnings Warnings
 	ccv2Routes, warnings, err := actor.CloudControllerClient.GetApplicationRoutes(applicationGUID, nil)
@@ -155,6 +226,75 @@ func (actor Actor) GetSpaceRoutes(spaceGUID string) ([]Route, Warnings, error) {
 }
 
 // DeleteRoute deletes the Route associated with the provided Route GUID.
+// MapRoute maps the route to the application. RouteInDifferentSpaceError is
+// returned when the route is not in the application's space and
+// RouteInUseError when the Cloud Controller reports the route as taken.
+func (actor Actor) MapRoute(appGUID string, routeGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateApplicationRoute(appGUID, routeGUID)
+	switch err.(type) {
+	case ccerror.InvalidRelationError:
+		return Warnings(warnings), RouteInDifferentSpaceError{}
+	case ccerror.RouteMappingTakenError:
+		return Warnings(warnings), RouteInUseError{AppGUID: appGUID, RouteGUID: routeGUID}
+	}
+	return Warnings(warnings), err
+}
+
+// MapRouteByHostAndDomain maps the route with the given host, domain and path
+// to the application, creating the route in the application's space when it
+// does not exist.
+func (actor Actor) MapRouteByHostAndDomain(appGUID string, host string, domainGUID string, path string) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplication(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccv2Routes, ccWarnings, err := actor.CloudControllerClient.GetRoutes([]ccv2.Query{
+		{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: host},
+		{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: domainGUID},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var routeGUID string
+	for _, ccv2Route := range ccv2Routes {
+		if ccv2Route.Path == path {
+			routeGUID = ccv2Route.GUID
+			break
+		}
+	}
+
+	if routeGUID == "" {
+		log.Debugf("creating route %s.%s%s in space %s", host, domainGUID, path, app.SpaceGUID)
+		route, createWarnings, createErr := actor.CreateRoute(Route{
+			Domain:    Domain{GUID: domainGUID},
+			Host:      host,
+			Path:      path,
+			SpaceGUID: app.SpaceGUID,
+		}, false)
+		allWarnings = append(allWarnings, createWarnings...)
+		if createErr != nil {
+			return allWarnings, createErr
+		}
+		routeGUID = route.GUID
+	}
+
+	warnings, err = actor.MapRoute(appGUID, routeGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UnmapRoute unmaps the route from the application.
+func (actor Actor) UnmapRoute(appGUID string, routeGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplicationRoute(appGUID, routeGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) DeleteRoute(routeGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
 	return Warnings(warnings), err
@@ -230,6 +370,65 @@ func (actor Actor) GetRouteByHostAndDomain(host string, domainGUID string) (Rout
 	return routes[0], append(Warnings(warnings), domainWarnings...), err
 }
 
+// FindRoute searches for a route on the given domain. HTTP routes are matched
+// on host and path; TCP routes are matched on port, which is used whenever it
+// is non-nil. The returned bool reports whether a matching route exists. When
+// more than one route matches, MultipleRoutesFoundError is returned.
+func (actor Actor) FindRoute(domainGUID string, host string, path string, port *int) (Route, bool, Warnings, error) {
+	queries := []ccv2.Query{
+		{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: domainGUID},
+	}
+	if port != nil {
+		queries = append(queries, ccv2.Query{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Value: strconv.Itoa(*port)})
+	} else {
+		queries = append(queries, ccv2.Query{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: host})
+		if path != "" {
+			queries = append(queries, ccv2.Query{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: path})
+		}
+	}
+
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetRoutes(queries)
+	if err != nil {
+		return Route{}, false, Warnings(warnings), err
+	}
+
+	// The Cloud Controller ignores an empty path filter, so routes are
+	// matched exactly here.
+	var matches []ccv2.Route
+	for _, ccv2Route := range ccv2Routes {
+		if port != nil {
+			if ccv2Route.Port == *port {
+				matches = append(matches, ccv2Route)
+			}
+		} else if ccv2Route.Host == host && ccv2Route.Path == path {
+			matches = append(matches, ccv2Route)
+		}
+	}
+
+	if len(matches) == 0 {
+		return Route{}, false, Warnings(warnings), nil
+	}
+
+	if len(matches) > 1 {
+		multipleRoutesErr := MultipleRoutesFoundError{Host: host, Path: path}
+		if port != nil {
+			multipleRoutesErr = MultipleRoutesFoundError{Port: *port}
+		}
+		for _, match := range matches {
+			multipleRoutesErr.GUIDs = append(multipleRoutesErr.GUIDs, match.GUID)
+		}
+		return Route{}, false, Warnings(warnings), multipleRoutesErr
+	}
+
+	routes, domainWarnings, err := actor.applyDomain(matches)
+	allWarnings := append(Warnings(warnings), domainWarnings...)
+	if err != nil {
+		return Route{}, false, allWarnings, err
+	}
+
+	return routes[0], true, allWarnings, nil
+}
+
 func ActorToCCRoute(route Route) ccv2.Route {
 	return ccv2.Route{
 		DomainGUID: route.Domain.GUID,
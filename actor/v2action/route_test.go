@@ -177,6 +177,130 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("CreateTCPRoute", func() {
+		var (
+			port       *int
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			port = nil
+			fakeCloudControllerClient.GetSharedDomainReturns(
+				ccv2.Domain{
+					GUID:            "some-domain-guid",
+					Name:            "tcp.some-domain.com",
+					RouterGroupGUID: "some-router-group-guid",
+					RouterGroupType: ccv2.RouterGroupTypeTCP,
+					Shared:          true,
+				},
+				ccv2.Warnings{"get-domain-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateRouteReturns(
+				ccv2.Route{
+					GUID:       "some-route-guid",
+					Port:       1234,
+					DomainGUID: "some-domain-guid",
+					SpaceGUID:  "some-space-guid",
+				},
+				ccv2.Warnings{"create-route-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.CreateTCPRoute("some-space-guid", "some-domain-guid", port)
+		})
+
+		Context("when no port is given", func() {
+			It("requests a random port and returns the route with the assigned port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domain-warning", "create-route-warning"))
+				Expect(route.GUID).To(Equal("some-route-guid"))
+				Expect(route.Port).To(Equal(1234))
+				Expect(route.Domain.Name).To(Equal("tcp.some-domain.com"))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				passedRoute, generatePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+				Expect(passedRoute).To(Equal(ccv2.Route{
+					DomainGUID: "some-domain-guid",
+					SpaceGUID:  "some-space-guid",
+				}))
+				Expect(generatePort).To(BeTrue())
+			})
+		})
+
+		Context("when a port is given", func() {
+			BeforeEach(func() {
+				requestedPort := 1234
+				port = &requestedPort
+			})
+
+			It("reserves that port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(route.Port).To(Equal(1234))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				passedRoute, generatePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+				Expect(passedRoute).To(Equal(ccv2.Route{
+					DomainGUID: "some-domain-guid",
+					Port:       1234,
+					SpaceGUID:  "some-space-guid",
+				}))
+				Expect(generatePort).To(BeFalse())
+			})
+		})
+
+		Context("when the domain is a shared HTTP domain", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainReturns(
+					ccv2.Domain{GUID: "some-domain-guid", Name: "some-domain.com", Shared: true},
+					ccv2.Warnings{"get-domain-warning"},
+					nil,
+				)
+			})
+
+			It("returns a TCPDomainRequiredError and does not create the route", func() {
+				Expect(executeErr).To(MatchError(TCPDomainRequiredError{Domain: "some-domain.com"}))
+				Expect(warnings).To(ConsistOf("get-domain-warning"))
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the domain is a private domain", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{}, ccv2.Warnings{"get-shared-domain-warning"}, ccerror.ResourceNotFoundError{})
+				fakeCloudControllerClient.GetPrivateDomainReturns(
+					ccv2.Domain{GUID: "some-domain-guid", Name: "private.com"},
+					ccv2.Warnings{"get-private-domain-warning"},
+					nil,
+				)
+			})
+
+			It("returns a TCPDomainRequiredError and does not create the route", func() {
+				Expect(executeErr).To(MatchError(TCPDomainRequiredError{Domain: "private.com"}))
+				Expect(warnings).To(ConsistOf("get-shared-domain-warning", "get-private-domain-warning"))
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the route fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create-route-error")
+				fakeCloudControllerClient.CreateRouteReturns(ccv2.Route{}, ccv2.Warnings{"create-route-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-domain-warning", "create-route-warning"))
+			})
+		})
+	})
+
 	Describe("GetOrphanedRoutesBySpace", func() {
 		BeforeEach(func() {
 			fakeCloudControllerClient.GetRouteApplicationsStub = func(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
@@ -354,6 +478,209 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("MapRoute", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.MapRoute("some-app-guid", "some-route-guid")
+		})
+
+		Context("when the mapping succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationRouteReturns(ccv2.Warnings{"map-warning"}, nil)
+			})
+
+			It("maps the route and returns the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("map-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationRouteCallCount()).To(Equal(1))
+				appGUID, routeGUID := fakeCloudControllerClient.UpdateApplicationRouteArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(routeGUID).To(Equal("some-route-guid"))
+			})
+		})
+
+		Context("when the route mapping is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationRouteReturns(ccv2.Warnings{"map-warning"}, ccerror.RouteMappingTakenError{})
+			})
+
+			It("returns a RouteInUseError and the warnings", func() {
+				Expect(executeErr).To(MatchError(RouteInUseError{AppGUID: "some-app-guid", RouteGUID: "some-route-guid"}))
+				Expect(warnings).To(ConsistOf("map-warning"))
+			})
+		})
+
+		Context("when the route is in a different space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationRouteReturns(ccv2.Warnings{"map-warning"}, ccerror.InvalidRelationError{})
+			})
+
+			It("returns a RouteInDifferentSpaceError and the warnings", func() {
+				Expect(executeErr).To(MatchError(RouteInDifferentSpaceError{}))
+				Expect(warnings).To(ConsistOf("map-warning"))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("map route error")
+				fakeCloudControllerClient.UpdateApplicationRouteReturns(ccv2.Warnings{"map-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("map-warning"))
+			})
+		})
+	})
+
+	Describe("MapRouteByHostAndDomain", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid", SpaceGUID: "some-space-guid"},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateApplicationRouteReturns(ccv2.Warnings{"map-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.MapRouteByHostAndDomain("some-app-guid", "some-host", "some-domain-guid", "/some-path")
+		})
+
+		Context("when the route exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv2.Route{
+						{GUID: "other-path-route-guid", Host: "some-host", Path: "/other-path"},
+						{GUID: "some-route-guid", Host: "some-host", Path: "/some-path"},
+					},
+					ccv2.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("maps the existing route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-routes-warning", "map-warning"))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: "some-host"},
+					ccv2.Query{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-domain-guid"},
+				))
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationRouteCallCount()).To(Equal(1))
+				appGUID, routeGUID := fakeCloudControllerClient.UpdateApplicationRouteArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(routeGUID).To(Equal("some-route-guid"))
+			})
+		})
+
+		Context("when the route does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, nil)
+				fakeCloudControllerClient.CreateRouteReturns(
+					ccv2.Route{GUID: "new-route-guid"},
+					ccv2.Warnings{"create-route-warning"},
+					nil,
+				)
+			})
+
+			It("creates the route in the app's space and maps it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-routes-warning", "create-route-warning", "map-warning"))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				route, generatePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+				Expect(route).To(Equal(ccv2.Route{
+					DomainGUID: "some-domain-guid",
+					Host:       "some-host",
+					Path:       "/some-path",
+					SpaceGUID:  "some-space-guid",
+				}))
+				Expect(generatePort).To(BeFalse())
+
+				_, routeGUID := fakeCloudControllerClient.UpdateApplicationRouteArgsForCall(0)
+				Expect(routeGUID).To(Equal("new-route-guid"))
+			})
+
+			Context("when creating the route fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("create route error")
+					fakeCloudControllerClient.CreateRouteReturns(ccv2.Route{}, ccv2.Warnings{"create-route-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings without mapping", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-routes-warning", "create-route-warning"))
+					Expect(fakeCloudControllerClient.UpdateApplicationRouteCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{}, ccv2.Warnings{"get-app-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UnmapRoute", func() {
+		Context("when the unmapping succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationRouteReturns(ccv2.Warnings{"unmap-warning"}, nil)
+			})
+
+			It("unmaps the route and returns the warnings", func() {
+				warnings, err := actor.UnmapRoute("some-app-guid", "some-route-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unmap-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationRouteCallCount()).To(Equal(1))
+				appGUID, routeGUID := fakeCloudControllerClient.DeleteApplicationRouteArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(routeGUID).To(Equal("some-route-guid"))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unmap route error")
+				fakeCloudControllerClient.DeleteApplicationRouteReturns(ccv2.Warnings{"unmap-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				warnings, err := actor.UnmapRoute("some-app-guid", "some-route-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unmap-warning"))
+			})
+		})
+	})
+
 	Describe("DeleteRoute", func() {
 		Context("when the route exists", func() {
 			BeforeEach(func() {
@@ -741,6 +1068,154 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("FindRoute", func() {
+		var (
+			host       string
+			path       string
+			port       *int
+			domainGUID string
+
+			route      Route
+			found      bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			host = "some-host"
+			path = ""
+			port = nil
+			domainGUID = "some-domain-guid"
+
+			fakeCloudControllerClient.GetSharedDomainReturns(
+				ccv2.Domain{Name: "domain.com"},
+				ccv2.Warnings{"get-domain-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			route, found, warnings, executeErr = actor.FindRoute(domainGUID, host, path, port)
+		})
+
+		Context("when searching for an HTTP route with a path", func() {
+			BeforeEach(func() {
+				path = "/some-path"
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-guid", SpaceGUID: "some-space-guid", Host: host, Path: "/some-path", DomainGUID: domainGUID},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("filters on domain, host and path and returns the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domain-warning"))
+				Expect(route).To(Equal(Route{
+					Domain:    Domain{Name: "domain.com"},
+					GUID:      "route-guid",
+					Host:      host,
+					Path:      "/some-path",
+					SpaceGUID: "some-space-guid",
+				}))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: domainGUID},
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: host},
+					{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: "/some-path"},
+				}))
+			})
+		})
+
+		Context("when searching for an HTTP route without a path", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-with-path-guid", Host: host, Path: "/some-path", DomainGUID: domainGUID},
+					{GUID: "route-guid", Host: host, DomainGUID: domainGUID},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("only matches the route without a path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(route.GUID).To(Equal("route-guid"))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: domainGUID},
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: host},
+				}))
+			})
+		})
+
+		Context("when searching for a TCP route", func() {
+			BeforeEach(func() {
+				host = ""
+				port = new(int)
+				*port = 1024
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-guid", Port: 1024, DomainGUID: domainGUID},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("filters on domain and port and returns the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(route.Port).To(Equal(1024))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: domainGUID},
+					{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Value: "1024"},
+				}))
+			})
+		})
+
+		Context("when no route matches", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns false and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(fakeCloudControllerClient.GetSharedDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when multiple routes match", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-guid-1", Host: host, DomainGUID: domainGUID, SpaceGUID: "space-1"},
+					{GUID: "route-guid-2", Host: host, DomainGUID: domainGUID, SpaceGUID: "space-2"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns a MultipleRoutesFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(MultipleRoutesFoundError{
+					Host:  host,
+					GUIDs: []string{"route-guid-1", "route-guid-2"},
+				}))
+				Expect(found).To(BeFalse())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+
+		Context("when getting routes returns an error and warnings", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-routes-err")
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(found).To(BeFalse())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
 	Describe("CheckRoute", func() {
 		Context("when the API calls succeed", func() {
 			BeforeEach(func() {
@@ -0,0 +1,104 @@
+package v2action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// RoutingAPINotAvailableError is returned when the targeted Cloud Controller
+// does not advertise a routing API endpoint.
+type RoutingAPINotAvailableError struct{}
+
+func (RoutingAPINotAvailableError) Error() string {
+	return "Routing API not available."
+}
+
+// InvalidReservablePortsError is returned when a router group's reservable
+// ports cannot be parsed.
+type InvalidReservablePortsError struct {
+	RouterGroup string
+	Ports       string
+}
+
+func (e InvalidReservablePortsError) Error() string {
+	return fmt.Sprintf("Router group '%s' has invalid reservable ports '%s'.", e.RouterGroup, e.Ports)
+}
+
+// PortRange is an inclusive range of ports. A single port has equal Start and
+// End.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// RouterGroup represents a routing API router group.
+type RouterGroup struct {
+	GUID            string
+	Name            string
+	Type            string
+	ReservablePorts []PortRange
+}
+
+// GetRouterGroups returns the router groups from the routing API of the
+// targeted Cloud Controller.
+func (actor Actor) GetRouterGroups() ([]RouterGroup, Warnings, error) {
+	if actor.CloudControllerClient.RoutingEndpoint() == "" {
+		return nil, nil, RoutingAPINotAvailableError{}
+	}
+
+	ccRouterGroups, warnings, err := actor.CloudControllerClient.GetRouterGroups()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var routerGroups []RouterGroup
+	for _, ccRouterGroup := range ccRouterGroups {
+		ports, err := parseReservablePorts(ccRouterGroup)
+		if err != nil {
+			return nil, Warnings(warnings), err
+		}
+
+		routerGroups = append(routerGroups, RouterGroup{
+			GUID:            ccRouterGroup.GUID,
+			Name:            ccRouterGroup.Name,
+			Type:            ccRouterGroup.Type,
+			ReservablePorts: ports,
+		})
+	}
+
+	return routerGroups, Warnings(warnings), nil
+}
+
+// parseReservablePorts parses a comma separated list of ports and port
+// ranges, such as "1024-1033,2000".
+func parseReservablePorts(routerGroup ccv2.RouterGroup) ([]PortRange, error) {
+	if routerGroup.ReservablePorts == "" {
+		return nil, nil
+	}
+
+	invalidErr := InvalidReservablePortsError{RouterGroup: routerGroup.Name, Ports: routerGroup.ReservablePorts}
+
+	var ranges []PortRange
+	for _, part := range strings.Split(routerGroup.ReservablePorts, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, invalidErr
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(bounds[1])
+			if err != nil || end < start {
+				return nil, invalidErr
+			}
+		}
+
+		ranges = append(ranges, PortRange{Start: start, End: end})
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,109 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetRouterGroups", func() {
+		var (
+			routerGroups []RouterGroup
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.RoutingEndpointReturns("https://api.some-domain.com/routing")
+		})
+
+		JustBeforeEach(func() {
+			routerGroups, warnings, executeErr = actor.GetRouterGroups()
+		})
+
+		Context("when the routing API returns router groups", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouterGroupsReturns(
+					[]ccv2.RouterGroup{
+						{GUID: "tcp-guid", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033, 2000"},
+						{GUID: "http-guid", Name: "default-http", Type: "http"},
+					},
+					ccv2.Warnings{"router-groups-warning"},
+					nil,
+				)
+			})
+
+			It("returns the router groups with their port ranges and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("router-groups-warning"))
+				Expect(routerGroups).To(Equal([]RouterGroup{
+					{
+						GUID: "tcp-guid",
+						Name: "default-tcp",
+						Type: "tcp",
+						ReservablePorts: []PortRange{
+							{Start: 1024, End: 1033},
+							{Start: 2000, End: 2000},
+						},
+					},
+					{GUID: "http-guid", Name: "default-http", Type: "http"},
+				}))
+			})
+		})
+
+		Context("when a router group has invalid reservable ports", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouterGroupsReturns(
+					[]ccv2.RouterGroup{{GUID: "tcp-guid", Name: "default-tcp", Type: "tcp", ReservablePorts: "2000-1024"}},
+					ccv2.Warnings{"router-groups-warning"},
+					nil,
+				)
+			})
+
+			It("returns an InvalidReservablePortsError and warnings", func() {
+				Expect(executeErr).To(MatchError(InvalidReservablePortsError{RouterGroup: "default-tcp", Ports: "2000-1024"}))
+				Expect(warnings).To(ConsistOf("router-groups-warning"))
+			})
+		})
+
+		Context("when the routing API is not available", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.RoutingEndpointReturns("")
+			})
+
+			It("returns a RoutingAPINotAvailableError", func() {
+				Expect(executeErr).To(MatchError(RoutingAPINotAvailableError{}))
+				Expect(fakeCloudControllerClient.GetRouterGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the router groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("router-groups-error")
+				fakeCloudControllerClient.GetRouterGroupsReturns(nil, ccv2.Warnings{"router-groups-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("router-groups-warning"))
+			})
+		})
+	})
+})
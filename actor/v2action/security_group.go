@@ -69,6 +69,21 @@ func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID
 	return Warnings(warnings), err
 }
 
+// GetSecurityGroups returns all security groups visible to the user, along
+// with their rules and whether they are running or staging defaults.
+func (actor Actor) GetSecurityGroups() ([]SecurityGroup, Warnings, error) {
+	ccv2SecurityGroups, warnings, err := actor.CloudControllerClient.GetSecurityGroups(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	securityGroups := make([]SecurityGroup, len(ccv2SecurityGroups))
+	for i, ccv2SecurityGroup := range ccv2SecurityGroups {
+		securityGroups[i] = SecurityGroup(ccv2SecurityGroup)
+	}
+	return securityGroups, Warnings(warnings), nil
+}
+
 func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGroup, Warnings, error) {
 	securityGroups, warnings, err := actor.CloudControllerClient.GetSecurityGroups([]ccv2.Query{
 		{
@@ -1095,6 +1095,79 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("GetSecurityGroups", func() {
+		var (
+			securityGroups []SecurityGroup
+			warnings       Warnings
+			executeErr     error
+		)
+
+		JustBeforeEach(func() {
+			securityGroups, warnings, executeErr = actor.GetSecurityGroups()
+		})
+
+		Context("when the security groups are returned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "security-group-guid-1",
+							Name: "security-group-1",
+							Rules: []ccv2.SecurityGroupRule{
+								{Destination: "10.0.0.0/8", Ports: "443", Protocol: "tcp"},
+							},
+							RunningDefault: true,
+						},
+						{
+							GUID:           "security-group-guid-2",
+							Name:           "security-group-2",
+							StagingDefault: true,
+						},
+					},
+					ccv2.Warnings{"security-groups-warning"},
+					nil,
+				)
+			})
+
+			It("returns the security groups with their rules and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("security-groups-warning"))
+				Expect(securityGroups).To(Equal([]SecurityGroup{
+					{
+						GUID: "security-group-guid-1",
+						Name: "security-group-1",
+						Rules: []ccv2.SecurityGroupRule{
+							{Destination: "10.0.0.0/8", Ports: "443", Protocol: "tcp"},
+						},
+						RunningDefault: true,
+					},
+					{
+						GUID:           "security-group-guid-2",
+						Name:           "security-group-2",
+						StagingDefault: true,
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSecurityGroupsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSecurityGroupsArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when getting the security groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("security groups error")
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"security-groups-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("security-groups-warning"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroupByName", func() {
 		var (
 			securityGroup SecurityGroup
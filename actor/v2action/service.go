@@ -0,0 +1,62 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Service represents a service offered in the marketplace.
+type Service ccv2.Service
+
+// ServicePlan represents a plan of a service offered in the marketplace.
+type ServicePlan ccv2.ServicePlan
+
+// GetServices returns all the services in the marketplace.
+func (actor Actor) GetServices() ([]Service, Warnings, error) {
+	ccv2Services, warnings, err := actor.CloudControllerClient.GetServices(nil)
+	if err != nil {
+		return []Service{}, Warnings(warnings), err
+	}
+
+	return convertServices(ccv2Services), Warnings(warnings), nil
+}
+
+// GetSpaceServices returns the services in the marketplace that are visible
+// in the provided space.
+func (actor Actor) GetSpaceServices(spaceGUID string) ([]Service, Warnings, error) {
+	ccv2Services, warnings, err := actor.CloudControllerClient.GetSpaceServices(spaceGUID, nil)
+	if err != nil {
+		return []Service{}, Warnings(warnings), err
+	}
+
+	return convertServices(ccv2Services), Warnings(warnings), nil
+}
+
+// GetServicePlans returns the plans of the provided service, sorted by name.
+func (actor Actor) GetServicePlans(serviceGUID string) ([]ServicePlan, Warnings, error) {
+	ccv2Plans, warnings, err := actor.CloudControllerClient.GetServicePlans([]ccv2.Query{{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceGUID,
+	}})
+	if err != nil {
+		return []ServicePlan{}, Warnings(warnings), err
+	}
+
+	plans := make([]ServicePlan, len(ccv2Plans))
+	for i, ccv2Plan := range ccv2Plans {
+		plans[i] = ServicePlan(ccv2Plan)
+	}
+	sort.Slice(plans, func(i int, j int) bool { return plans[i].Name < plans[j].Name })
+
+	return plans, Warnings(warnings), nil
+}
+
+func convertServices(ccv2Services []ccv2.Service) []Service {
+	services := make([]Service, len(ccv2Services))
+	for i, ccv2Service := range ccv2Services {
+		services[i] = Service(ccv2Service)
+	}
+	return services
+}
@@ -10,6 +10,17 @@ import (
 // application.
 type ServiceBinding ccv2.ServiceBinding
 
+// AppServiceBinding is a service binding of an application, along with the
+// name and plan of the bound service instance. Credentials are only set when
+// they are explicitly requested.
+type AppServiceBinding struct {
+	GUID                string
+	ServiceInstanceGUID string
+	ServiceInstanceName string
+	ServicePlanName     string
+	Credentials         map[string]interface{}
+}
+
 // ServiceBindingNotFoundError is returned when a service binding cannot be
 // found.
 type ServiceBindingNotFoundError struct {
@@ -100,3 +111,72 @@ func (actor Actor) UnbindServiceBySpace(appName string, serviceInstanceName stri
 
 	return allWarnings, err
 }
+
+// GetServiceBindingsByApp returns the service bindings of the application with
+// the provided GUID, including the name and plan of each bound service
+// instance. Binding credentials are only returned when includeCredentials is
+// true, so that they are not displayed by accident. User provided service
+// instances have no plan.
+func (actor Actor) GetServiceBindingsByApp(appGUID string, includeCredentials bool) ([]AppServiceBinding, Warnings, error) {
+	var allWarnings Warnings
+
+	serviceBindings, warnings, err := actor.CloudControllerClient.GetApplicationServiceBindings(appGUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appServiceBindings := []AppServiceBinding{}
+	if len(serviceBindings) == 0 {
+		return appServiceBindings, allWarnings, nil
+	}
+
+	app, warnings, err := actor.CloudControllerClient.GetApplication(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetSpaceServiceInstances(app.SpaceGUID, true, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	serviceInstancesByGUID := map[string]ccv2.ServiceInstance{}
+	for _, serviceInstance := range serviceInstances {
+		serviceInstancesByGUID[serviceInstance.GUID] = serviceInstance
+	}
+
+	planNames := map[string]string{}
+	for _, serviceBinding := range serviceBindings {
+		serviceInstance := serviceInstancesByGUID[serviceBinding.ServiceInstanceGUID]
+
+		appServiceBinding := AppServiceBinding{
+			GUID:                serviceBinding.GUID,
+			ServiceInstanceGUID: serviceBinding.ServiceInstanceGUID,
+			ServiceInstanceName: serviceInstance.Name,
+		}
+		if includeCredentials {
+			appServiceBinding.Credentials = serviceBinding.Credentials
+		}
+
+		if planGUID := serviceInstance.ServicePlanGUID; planGUID != "" {
+			planName, found := planNames[planGUID]
+			if !found {
+				plan, warnings, err := actor.CloudControllerClient.GetServicePlan(planGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				planName = plan.Name
+				planNames[planGUID] = planName
+			}
+			appServiceBinding.ServicePlanName = planName
+		}
+
+		appServiceBindings = append(appServiceBindings, appServiceBinding)
+	}
+
+	return appServiceBindings, allWarnings, nil
+}
@@ -253,4 +253,137 @@ var _ = Describe("Service Binding Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceBindingsByApp", func() {
+		var (
+			includeCredentials bool
+			appServiceBindings []AppServiceBinding
+			warnings           Warnings
+			executeErr         error
+		)
+
+		BeforeEach(func() {
+			includeCredentials = false
+
+			fakeCloudControllerClient.GetApplicationServiceBindingsReturns(
+				[]ccv2.ServiceBinding{
+					{
+						GUID:                "binding-guid-1",
+						ServiceInstanceGUID: "instance-guid-1",
+						Credentials:         map[string]interface{}{"password": "secret-1"},
+					},
+					{
+						GUID:                "binding-guid-2",
+						ServiceInstanceGUID: "instance-guid-2",
+						Credentials:         map[string]interface{}{"password": "secret-2"},
+					},
+					{
+						GUID:                "binding-guid-3",
+						ServiceInstanceGUID: "instance-guid-3",
+					},
+				},
+				ccv2.Warnings{"get-bindings-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid", SpaceGUID: "some-space-guid"},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "instance-guid-1", Name: "instance-1", Type: ccv2.ManagedService, ServicePlanGUID: "plan-guid"},
+					{GUID: "instance-guid-2", Name: "instance-2", Type: ccv2.ManagedService, ServicePlanGUID: "plan-guid"},
+					{GUID: "instance-guid-3", Name: "instance-3", Type: ccv2.UserProvidedService},
+				},
+				ccv2.Warnings{"get-instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlanReturns(
+				ccv2.ServicePlan{GUID: "plan-guid", Name: "some-plan"},
+				ccv2.Warnings{"get-plan-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			appServiceBindings, warnings, executeErr = actor.GetServiceBindingsByApp("some-app-guid", includeCredentials)
+		})
+
+		It("returns the bindings with their service instance names and plans", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(appServiceBindings).To(Equal([]AppServiceBinding{
+				{GUID: "binding-guid-1", ServiceInstanceGUID: "instance-guid-1", ServiceInstanceName: "instance-1", ServicePlanName: "some-plan"},
+				{GUID: "binding-guid-2", ServiceInstanceGUID: "instance-guid-2", ServiceInstanceName: "instance-2", ServicePlanName: "some-plan"},
+				{GUID: "binding-guid-3", ServiceInstanceGUID: "instance-guid-3", ServiceInstanceName: "instance-3"},
+			}))
+			Expect(warnings).To(ConsistOf("get-bindings-warning", "get-app-warning", "get-instances-warning", "get-plan-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationServiceBindingsCallCount()).To(Equal(1))
+			appGUID, _ := fakeCloudControllerClient.GetApplicationServiceBindingsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
+			spaceGUID, includeUserProvided, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(includeUserProvided).To(BeTrue())
+
+			Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("plan-guid"))
+		})
+
+		Context("when credentials are requested", func() {
+			BeforeEach(func() {
+				includeCredentials = true
+			})
+
+			It("includes the binding credentials", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(appServiceBindings[0].Credentials).To(Equal(map[string]interface{}{"password": "secret-1"}))
+				Expect(appServiceBindings[1].Credentials).To(Equal(map[string]interface{}{"password": "secret-2"}))
+			})
+		})
+
+		Context("when the app has no service bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationServiceBindingsReturns(nil, ccv2.Warnings{"get-bindings-warning"}, nil)
+			})
+
+			It("returns an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(appServiceBindings).To(BeEmpty())
+				Expect(appServiceBindings).ToNot(BeNil())
+				Expect(warnings).To(ConsistOf("get-bindings-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the service bindings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get bindings failed")
+				fakeCloudControllerClient.GetApplicationServiceBindingsReturns(nil, ccv2.Warnings{"get-bindings-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-bindings-warning"))
+			})
+		})
+
+		Context("when getting the service plan fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get plan failed")
+				fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"get-plan-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-bindings-warning", "get-app-warning", "get-instances-warning", "get-plan-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,116 @@
+package v2action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/poll"
+)
+
+// ServiceKey represents a set of credentials for a service instance that is
+// not bound to an application.
+type ServiceKey ccv2.ServiceKey
+
+// ServiceKeyNotFoundError is returned when a service key cannot be found.
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceGUID string
+}
+
+func (e ServiceKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Service key '%s' for service instance GUID '%s' not found.", e.Name, e.ServiceInstanceGUID)
+}
+
+// ServiceKeyCreationFailedError is returned when the service broker reports
+// that it could not create a service key.
+type ServiceKeyCreationFailedError struct {
+	Name        string
+	Description string
+}
+
+func (e ServiceKeyCreationFailedError) Error() string {
+	return fmt.Sprintf("Service key '%s' could not be created: %s", e.Name, e.Description)
+}
+
+// ServiceKeyCreationTimeoutError is returned when the service broker does not
+// finish creating a service key within the startup timeout.
+type ServiceKeyCreationTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (e ServiceKeyCreationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for service key '%s' to be created.", e.Timeout, e.Name)
+}
+
+// CreateServiceKey creates a service key with the given name for the service
+// instance, passing the parameters through to the service broker. When the
+// broker creates the key asynchronously, CreateServiceKey polls until the
+// key's last operation has succeeded and returns the key with its
+// credentials.
+func (actor Actor) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ServiceKey, Warnings, error) {
+	serviceKey, warnings, err := actor.CloudControllerClient.CreateServiceKey(serviceInstanceGUID, keyName, parameters)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return ServiceKey{}, allWarnings, err
+	}
+
+	poller := poll.New(actor.Config.PollingInterval(), actor.Config.StartupTimeout())
+	err = poller.Poll(func() (bool, error) {
+		switch serviceKey.LastOperation.State {
+		case ccv2.LastOperationFailed:
+			return false, ServiceKeyCreationFailedError{
+				Name:        keyName,
+				Description: serviceKey.LastOperation.Description,
+			}
+		case ccv2.LastOperationInProgress:
+			serviceKey, warnings, err = actor.CloudControllerClient.GetServiceKey(serviceKey.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return false, err
+			}
+			return serviceKey.LastOperation.State == ccv2.LastOperationSucceeded, nil
+		default:
+			return true, nil
+		}
+	})
+
+	if e, ok := err.(poll.TimeoutError); ok {
+		return ServiceKey{}, allWarnings, ServiceKeyCreationTimeoutError{Name: keyName, Timeout: e.Timeout}
+	}
+	if err != nil {
+		return ServiceKey{}, allWarnings, err
+	}
+
+	return ServiceKey(serviceKey), allWarnings, nil
+}
+
+// GetServiceKey returns the service key with the given name for the service
+// instance, including its credentials.
+func (actor Actor) GetServiceKey(serviceInstanceGUID string, keyName string) (ServiceKey, Warnings, error) {
+	serviceKeys, warnings, err := actor.CloudControllerClient.GetServiceKeys([]ccv2.Query{
+		{
+			Filter:   ccv2.ServiceInstanceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    serviceInstanceGUID,
+		},
+		{
+			Filter:   ccv2.NameFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    keyName,
+		},
+	})
+	if err != nil {
+		return ServiceKey{}, Warnings(warnings), err
+	}
+
+	if len(serviceKeys) == 0 {
+		return ServiceKey{}, Warnings(warnings), ServiceKeyNotFoundError{
+			Name:                keyName,
+			ServiceInstanceGUID: serviceInstanceGUID,
+		}
+	}
+
+	return ServiceKey(serviceKeys[0]), Warnings(warnings), nil
+}
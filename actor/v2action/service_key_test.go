@@ -0,0 +1,266 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.StartupTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("CreateServiceKey", func() {
+		var (
+			parameters map[string]interface{}
+			serviceKey ServiceKey
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			parameters = map[string]interface{}{"some-parameter": "some-value"}
+		})
+
+		JustBeforeEach(func() {
+			serviceKey, warnings, executeErr = actor.CreateServiceKey("some-service-instance-guid", "some-key-name", parameters)
+		})
+
+		Context("when the broker creates the key synchronously", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceKeyReturns(
+					ccv2.ServiceKey{
+						GUID:          "some-service-key-guid",
+						Name:          "some-key-name",
+						Credentials:   map[string]interface{}{"username": "some-user"},
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationSucceeded},
+					},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("returns the key with its credentials and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(serviceKey.GUID).To(Equal("some-service-key-guid"))
+				Expect(serviceKey.Credentials).To(Equal(map[string]interface{}{"username": "some-user"}))
+
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(1))
+				serviceInstanceGUID, keyName, passedParameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(keyName).To(Equal("some-key-name"))
+				Expect(passedParameters).To(Equal(parameters))
+
+				Expect(fakeCloudControllerClient.GetServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the broker creates the key asynchronously", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceKeyReturns(
+					ccv2.ServiceKey{
+						GUID:          "some-service-key-guid",
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			Context("when the operation succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceKeyReturnsOnCall(0,
+						ccv2.ServiceKey{
+							GUID:          "some-service-key-guid",
+							LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+						},
+						ccv2.Warnings{"get-warning-1"},
+						nil,
+					)
+					fakeCloudControllerClient.GetServiceKeyReturnsOnCall(1,
+						ccv2.ServiceKey{
+							GUID:          "some-service-key-guid",
+							Credentials:   map[string]interface{}{"username": "some-user"},
+							LastOperation: ccv2.LastOperation{State: ccv2.LastOperationSucceeded},
+						},
+						ccv2.Warnings{"get-warning-2"},
+						nil,
+					)
+				})
+
+				It("polls until the key is created and returns its credentials", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("create-warning", "get-warning-1", "get-warning-2"))
+					Expect(serviceKey.Credentials).To(Equal(map[string]interface{}{"username": "some-user"}))
+
+					Expect(fakeCloudControllerClient.GetServiceKeyCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetServiceKeyArgsForCall(0)).To(Equal("some-service-key-guid"))
+				})
+			})
+
+			Context("when the operation fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceKeyReturns(
+						ccv2.ServiceKey{
+							GUID: "some-service-key-guid",
+							LastOperation: ccv2.LastOperation{
+								State:       ccv2.LastOperationFailed,
+								Description: "broker exploded",
+							},
+						},
+						ccv2.Warnings{"get-warning"},
+						nil,
+					)
+				})
+
+				It("returns a ServiceKeyCreationFailedError and warnings", func() {
+					Expect(executeErr).To(MatchError(ServiceKeyCreationFailedError{
+						Name:        "some-key-name",
+						Description: "broker exploded",
+					}))
+					Expect(warnings).To(ConsistOf("create-warning", "get-warning"))
+				})
+			})
+
+			Context("when getting the key fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get service key error")
+					fakeCloudControllerClient.GetServiceKeyReturns(ccv2.ServiceKey{}, ccv2.Warnings{"get-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("create-warning", "get-warning"))
+				})
+			})
+
+			Context("when the operation does not finish before the timeout", func() {
+				BeforeEach(func() {
+					fakeConfig.PollingIntervalReturns(time.Millisecond)
+					fakeConfig.StartupTimeoutReturns(10 * time.Millisecond)
+					fakeCloudControllerClient.GetServiceKeyReturns(
+						ccv2.ServiceKey{
+							GUID:          "some-service-key-guid",
+							LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ServiceKeyCreationTimeoutError", func() {
+					Expect(executeErr).To(MatchError(ServiceKeyCreationTimeoutError{
+						Name:    "some-key-name",
+						Timeout: 10 * time.Millisecond,
+					}))
+				})
+			})
+		})
+
+		Context("when creating the key fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create service key error")
+				fakeCloudControllerClient.CreateServiceKeyReturns(ccv2.ServiceKey{}, ccv2.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceKey", func() {
+		Context("when the key exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(
+					[]ccv2.ServiceKey{
+						{
+							GUID:        "some-service-key-guid",
+							Name:        "some-key-name",
+							Credentials: map[string]interface{}{"username": "some-user"},
+						},
+					},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns the key and warnings", func() {
+				serviceKey, warnings, err := actor.GetServiceKey("some-service-instance-guid", "some-key-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(serviceKey).To(Equal(ServiceKey{
+					GUID:        "some-service-key-guid",
+					Name:        "some-key-name",
+					Credentials: map[string]interface{}{"username": "some-user"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.ServiceInstanceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-service-instance-guid",
+					},
+					ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-key-name",
+					},
+				))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetServiceKey("some-service-instance-guid", "some-key-name")
+				Expect(err).To(MatchError(ServiceKeyNotFoundError{
+					Name:                "some-key-name",
+					ServiceInstanceGUID: "some-service-instance-guid",
+				}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when getting the keys fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get service keys error")
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetServiceKey("some-service-instance-guid", "some-key-name")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+})
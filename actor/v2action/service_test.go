@@ -0,0 +1,152 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServices", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{
+						{GUID: "some-service-guid", Label: "some-service"},
+						{GUID: "other-service-guid", Label: "other-service"},
+					},
+					ccv2.Warnings{"get-services-warning"},
+					nil,
+				)
+			})
+
+			It("returns all the services and all warnings", func() {
+				services, warnings, err := actor.GetServices()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+				Expect(services).To(ConsistOf(
+					Service{GUID: "some-service-guid", Label: "some-service"},
+					Service{GUID: "other-service-guid", Label: "other-service"},
+				))
+
+				Expect(fakeCloudControllerClient.GetServicesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-services-error")
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"get-services-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServices()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceServices", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"get-space-services-warning"},
+					nil,
+				)
+			})
+
+			It("returns the services visible in the space and all warnings", func() {
+				services, warnings, err := actor.GetSpaceServices("some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-services-warning"))
+				Expect(services).To(ConsistOf(Service{GUID: "some-service-guid", Label: "some-service"}))
+
+				Expect(fakeCloudControllerClient.GetSpaceServicesCallCount()).To(Equal(1))
+				spaceGUID, queries := fakeCloudControllerClient.GetSpaceServicesArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(queries).To(BeNil())
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-space-services-error")
+				fakeCloudControllerClient.GetSpaceServicesReturns(nil, ccv2.Warnings{"get-space-services-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetSpaceServices("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-space-services-warning"))
+			})
+		})
+	})
+
+	Describe("GetServicePlans", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv2.ServicePlan{
+						{GUID: "plan-guid-2", Name: "small", Free: false, Public: true},
+						{GUID: "plan-guid-1", Name: "free", Free: true, Public: false},
+					},
+					ccv2.Warnings{"get-service-plans-warning"},
+					nil,
+				)
+			})
+
+			It("returns the service's plans sorted by name and all warnings", func() {
+				plans, warnings, err := actor.GetServicePlans("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-service-plans-warning"))
+				Expect(plans).To(Equal([]ServicePlan{
+					{GUID: "plan-guid-1", Name: "free", Free: true, Public: false},
+					{GUID: "plan-guid-2", Name: "small", Free: false, Public: true},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.ServiceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service-guid",
+				}}))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-service-plans-error")
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"get-service-plans-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServicePlans("some-service-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-service-plans-warning"))
+			})
+		})
+	})
+})
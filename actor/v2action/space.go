@@ -3,6 +3,7 @@ package v2action
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
@@ -39,17 +40,43 @@ func (e MultipleSpacesFoundError) Error() string {
 	return fmt.Sprintf("Multiple spaces found matching organization GUID '%s' and name '%s'", e.OrgGUID, e.Name)
 }
 
-func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (Warnings, error) {
-	var allWarnings Warnings
+// SpaceNameTakenError is returned when creating a space whose name is already
+// in use in the organization.
+type SpaceNameTakenError struct {
+	Name string
+}
 
-	org, warnings, err := actor.GetOrganizationByName(orgName)
+func (e SpaceNameTakenError) Error() string {
+	return fmt.Sprintf("Space '%s' already exists.", e.Name)
+}
+
+// CreateSpace creates a space with the given name in the organization. If
+// quotaGUID is nil, no space quota is assigned. If managerGUID is not empty,
+// that user is made a space manager and space developer of the new space.
+// When assigning the roles fails, the created space is returned with the
+// error.
+func (actor Actor) CreateSpace(spaceName string, orgGUID string, quotaGUID *string, managerGUID string) (Space, Warnings, error) {
+	space, allWarnings, err := actor.CloudControllerClient.CreateSpace(spaceName, orgGUID, quotaGUID)
+	if _, ok := err.(ccerror.SpaceNameTakenError); ok {
+		return Space{}, Warnings(allWarnings), SpaceNameTakenError{Name: spaceName}
+	}
+	if err != nil || managerGUID == "" {
+		return Space(space), Warnings(allWarnings), err
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateSpaceManager(space.GUID, managerGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return allWarnings, err
+		return Space(space), Warnings(allWarnings), err
 	}
 
-	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	warnings, err = actor.CloudControllerClient.UpdateSpaceDeveloper(space.GUID, managerGUID)
 	allWarnings = append(allWarnings, warnings...)
+	return Space(space), Warnings(allWarnings), err
+}
+
+func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (Warnings, error) {
+	_, space, allWarnings, err := actor.GetOrganizationAndSpaceByName(orgName, spaceName)
 	if err != nil {
 		return allWarnings, err
 	}
@@ -60,12 +87,33 @@ func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgNam
 		return allWarnings, err
 	}
 
-	warnings, err = actor.PollJob(Job(job))
+	warnings, err := actor.PollJob(Job(job))
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 
 	return allWarnings, err
 }
 
+// GetOrganizationAndSpaceByName resolves an organization name and the name
+// of a space within it. An OrganizationNotFoundError or SpaceNotFoundError is
+// returned when either cannot be found.
+func (actor Actor) GetOrganizationAndSpaceByName(orgName string, spaceName string) (Organization, Space, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, Space{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, Space{}, allWarnings, err
+	}
+
+	return org, space, allWarnings, nil
+}
+
 // GetOrganizationSpaces returns a list of spaces in the specified org
 func (actor Actor) GetOrganizationSpaces(orgGUID string) ([]Space, Warnings, error) {
 	query := []ccv2.Query{
@@ -26,3 +26,100 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// QuotaDimension is a resource limited by a space quota.
+type QuotaDimension string
+
+const (
+	QuotaDimensionNone      QuotaDimension = ""
+	QuotaDimensionMemory    QuotaDimension = "memory"
+	QuotaDimensionInstances QuotaDimension = "instances"
+)
+
+// SpaceQuotaUsage is a space's quota limits alongside the memory and app
+// instances currently used by its started applications. A space without a
+// quota has an empty Quota with unlimited limits.
+type SpaceQuotaUsage struct {
+	Quota         SpaceQuota
+	UsedMemoryMB  int
+	UsedInstances int
+}
+
+// GetSpaceQuotaUsage returns the quota limits of the space with the given GUID
+// and how much of them its started applications use.
+func (actor Actor) GetSpaceQuotaUsage(spaceGUID string) (SpaceQuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces([]ccv2.Query{{
+		Filter:   ccv2.GUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    spaceGUID,
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceQuotaUsage{}, allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return SpaceQuotaUsage{}, allWarnings, SpaceNotFoundError{GUID: spaceGUID}
+	}
+
+	usage := SpaceQuotaUsage{
+		Quota: SpaceQuota{
+			MemoryLimit:      ccv2.UnlimitedQuota,
+			AppInstanceLimit: ccv2.UnlimitedQuota,
+		},
+	}
+
+	if quotaGUID := spaces[0].SpaceQuotaDefinitionGUID; quotaGUID != "" {
+		quota, quotaWarnings, quotaErr := actor.GetSpaceQuota(quotaGUID)
+		allWarnings = append(allWarnings, quotaWarnings...)
+		if quotaErr != nil {
+			return SpaceQuotaUsage{}, allWarnings, quotaErr
+		}
+		usage.Quota = quota
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications([]ccv2.Query{{
+		Filter:   ccv2.SpaceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    spaceGUID,
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceQuotaUsage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		if app.State != ccv2.ApplicationStarted {
+			continue
+		}
+		usage.UsedMemoryMB += int(app.Memory) * app.Instances
+		usage.UsedInstances += app.Instances
+	}
+
+	return usage, allWarnings, nil
+}
+
+// WillExceedQuota reports whether starting additionalInstances more app
+// instances using additionalMemoryMB more memory in total would exceed the
+// space's quota, and if so which limit would be exceeded. Memory is checked
+// before instances. Unlimited limits are never exceeded.
+func (actor Actor) WillExceedQuota(spaceGUID string, additionalMemoryMB int, additionalInstances int) (bool, QuotaDimension, Warnings, error) {
+	usage, warnings, err := actor.GetSpaceQuotaUsage(spaceGUID)
+	if err != nil {
+		return false, QuotaDimensionNone, warnings, err
+	}
+
+	if exceedsLimit(usage.Quota.MemoryLimit, usage.UsedMemoryMB+additionalMemoryMB) {
+		return true, QuotaDimensionMemory, warnings, nil
+	}
+	if exceedsLimit(usage.Quota.AppInstanceLimit, usage.UsedInstances+additionalInstances) {
+		return true, QuotaDimensionInstances, warnings, nil
+	}
+
+	return false, QuotaDimensionNone, warnings, nil
+}
+
+func exceedsLimit(limit int, total int) bool {
+	return limit != ccv2.UnlimitedQuota && total > limit
+}
@@ -76,4 +76,177 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotaUsage", func() {
+		Context("when the space has a quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "some-space-guid", SpaceQuotaDefinitionGUID: "some-space-quota-guid"}},
+					ccv2.Warnings{"get-space-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceQuotaReturns(
+					ccv2.SpaceQuota{GUID: "some-space-quota-guid", MemoryLimit: 2048, AppInstanceLimit: 10},
+					ccv2.Warnings{"get-quota-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{State: ccv2.ApplicationStarted, Memory: 256, Instances: 2},
+						{State: ccv2.ApplicationStarted, Memory: 128, Instances: 1},
+						{State: ccv2.ApplicationStopped, Memory: 1024, Instances: 4},
+					},
+					ccv2.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the limits and the usage of started apps", func() {
+				usage, warnings, err := actor.GetSpaceQuotaUsage("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-warning", "get-quota-warning", "get-apps-warning"))
+				Expect(usage).To(Equal(SpaceQuotaUsage{
+					Quota:         SpaceQuota{GUID: "some-space-quota-guid", MemoryLimit: 2048, AppInstanceLimit: 10},
+					UsedMemoryMB:  640,
+					UsedInstances: 3,
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.GUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-space-guid",
+				}))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("some-space-quota-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.SpaceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-space-guid",
+				}))
+			})
+		})
+
+		Context("when the space has no quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid"}}, nil, nil)
+			})
+
+			It("returns unlimited limits without looking up a quota", func() {
+				usage, _, err := actor.GetSpaceQuotaUsage("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage.Quota.MemoryLimit).To(Equal(ccv2.UnlimitedQuota))
+				Expect(usage.Quota.AppInstanceLimit).To(Equal(ccv2.UnlimitedQuota))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotaUsage("some-space-guid")
+				Expect(err).To(MatchError(SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+			})
+		})
+
+		Context("when getting the applications fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get applications error")
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid"}}, nil, nil)
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotaUsage("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+	})
+
+	Describe("WillExceedQuota", func() {
+		var (
+			memoryLimit   int
+			instanceLimit int
+		)
+
+		BeforeEach(func() {
+			memoryLimit = 1024
+			instanceLimit = 4
+		})
+
+		JustBeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "some-space-guid", SpaceQuotaDefinitionGUID: "some-space-quota-guid"}},
+				nil,
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceQuotaReturns(
+				ccv2.SpaceQuota{MemoryLimit: memoryLimit, AppInstanceLimit: instanceLimit},
+				nil,
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{State: ccv2.ApplicationStarted, Memory: 256, Instances: 2}},
+				ccv2.Warnings{"get-apps-warning"},
+				nil,
+			)
+		})
+
+		It("returns false when the additional usage fits exactly", func() {
+			exceeds, dimension, warnings, err := actor.WillExceedQuota("some-space-guid", 512, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning"))
+			Expect(exceeds).To(BeFalse())
+			Expect(dimension).To(Equal(QuotaDimensionNone))
+		})
+
+		It("reports memory when the memory limit would be exceeded", func() {
+			exceeds, dimension, _, err := actor.WillExceedQuota("some-space-guid", 513, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exceeds).To(BeTrue())
+			Expect(dimension).To(Equal(QuotaDimensionMemory))
+		})
+
+		It("reports instances when the instance limit would be exceeded", func() {
+			exceeds, dimension, _, err := actor.WillExceedQuota("some-space-guid", 64, 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exceeds).To(BeTrue())
+			Expect(dimension).To(Equal(QuotaDimensionInstances))
+		})
+
+		Context("when the limits are unlimited", func() {
+			BeforeEach(func() {
+				memoryLimit = ccv2.UnlimitedQuota
+				instanceLimit = ccv2.UnlimitedQuota
+			})
+
+			It("never reports the quota as exceeded", func() {
+				exceeds, dimension, _, err := actor.WillExceedQuota("some-space-guid", 1000000, 1000)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exceeds).To(BeFalse())
+				Expect(dimension).To(Equal(QuotaDimensionNone))
+			})
+		})
+
+		Context("when looking up the usage fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get spaces error")
+			})
+
+			It("returns the error", func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-space-warning"}, expectedErr)
+				exceeds, _, warnings, err := actor.WillExceedQuota("some-space-guid", 64, 1)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+				Expect(exceeds).To(BeFalse())
+			})
+		})
+	})
 })
@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -192,6 +193,99 @@ var _ = Describe("Space", func() {
 			})
 		})
 
+		Describe("GetOrganizationAndSpaceByName", func() {
+			var (
+				org        Organization
+				space      Space
+				warnings   Warnings
+				executeErr error
+			)
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+					ccv2.Warnings{"get-orgs-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv2.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			JustBeforeEach(func() {
+				org, space, warnings, executeErr = actor.GetOrganizationAndSpaceByName("some-org", "some-space")
+			})
+
+			Context("when the org and space exist", func() {
+				It("returns the org, the space and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-orgs-warning", "get-spaces-warning"))
+					Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+					Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+
+					Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-org",
+					}))
+
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+						ccv2.Query{
+							Filter:   ccv2.NameFilter,
+							Operator: ccv2.EqualOperator,
+							Value:    "some-space",
+						},
+						ccv2.Query{
+							Filter:   ccv2.OrganizationGUIDFilter,
+							Operator: ccv2.EqualOperator,
+							Value:    "some-org-guid",
+						},
+					))
+				})
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-orgs-warning"}, nil)
+				})
+
+				It("returns an OrganizationNotFoundError and warnings", func() {
+					Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+					Expect(warnings).To(ConsistOf("get-orgs-warning"))
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the space does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-spaces-warning"}, nil)
+				})
+
+				It("returns a SpaceNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+					Expect(warnings).To(ConsistOf("get-orgs-warning", "get-spaces-warning"))
+				})
+			})
+
+			Context("when getting the spaces fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get spaces error")
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-spaces-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-orgs-warning", "get-spaces-warning"))
+				})
+			})
+		})
+
 		Describe("GetOrganizationSpaces", func() {
 			Context("when there are spaces in the org", func() {
 				BeforeEach(func() {
@@ -379,5 +473,149 @@ var _ = Describe("Space", func() {
 				})
 			})
 		})
+
+		Describe("CreateSpace", func() {
+			var (
+				quotaGUID   *string
+				managerGUID string
+
+				space      Space
+				warnings   Warnings
+				executeErr error
+			)
+
+			BeforeEach(func() {
+				quotaGUID = nil
+				managerGUID = ""
+			})
+
+			JustBeforeEach(func() {
+				space, warnings, executeErr = actor.CreateSpace("some-space", "some-org-guid", quotaGUID, managerGUID)
+			})
+
+			Context("when the space is created", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateSpaceReturns(
+						ccv2.Space{GUID: "some-space-guid", Name: "some-space"},
+						ccv2.Warnings{"create-warning"},
+						nil)
+				})
+
+				Context("when a quota is provided", func() {
+					BeforeEach(func() {
+						quota := "some-quota-guid"
+						quotaGUID = &quota
+					})
+
+					It("creates the space with the quota", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("create-warning"))
+						Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+
+						Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(1))
+						spaceName, orgGUID, passedQuotaGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+						Expect(spaceName).To(Equal("some-space"))
+						Expect(orgGUID).To(Equal("some-org-guid"))
+						Expect(passedQuotaGUID).ToNot(BeNil())
+						Expect(*passedQuotaGUID).To(Equal("some-quota-guid"))
+					})
+				})
+
+				Context("when the quota is nil", func() {
+					It("omits the quota", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(1))
+						_, _, passedQuotaGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+						Expect(passedQuotaGUID).To(BeNil())
+					})
+				})
+
+				Context("when no manager is provided", func() {
+					It("does not assign any roles", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeCloudControllerClient.UpdateSpaceManagerCallCount()).To(Equal(0))
+						Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when a manager is provided", func() {
+					BeforeEach(func() {
+						managerGUID = "some-user-guid"
+					})
+
+					Context("when no errors are encountered", func() {
+						BeforeEach(func() {
+							fakeCloudControllerClient.UpdateSpaceManagerReturns(ccv2.Warnings{"manager-warning"}, nil)
+							fakeCloudControllerClient.UpdateSpaceDeveloperReturns(ccv2.Warnings{"developer-warning"}, nil)
+						})
+
+						It("makes the user a manager and developer of the new space and returns all warnings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(warnings).To(ConsistOf("create-warning", "manager-warning", "developer-warning"))
+							Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+
+							Expect(fakeCloudControllerClient.UpdateSpaceManagerCallCount()).To(Equal(1))
+							spaceGUID, userGUID := fakeCloudControllerClient.UpdateSpaceManagerArgsForCall(0)
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(userGUID).To(Equal("some-user-guid"))
+
+							Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(1))
+							spaceGUID, userGUID = fakeCloudControllerClient.UpdateSpaceDeveloperArgsForCall(0)
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(userGUID).To(Equal("some-user-guid"))
+						})
+					})
+
+					Context("when assigning the manager role errors", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("manager-error")
+							fakeCloudControllerClient.UpdateSpaceManagerReturns(ccv2.Warnings{"manager-warning"}, expectedErr)
+						})
+
+						It("returns the created space, the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(warnings).To(ConsistOf("create-warning", "manager-warning"))
+							Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+							Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when assigning the developer role errors", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("developer-error")
+							fakeCloudControllerClient.UpdateSpaceManagerReturns(ccv2.Warnings{"manager-warning"}, nil)
+							fakeCloudControllerClient.UpdateSpaceDeveloperReturns(ccv2.Warnings{"developer-warning"}, expectedErr)
+						})
+
+						It("returns the created space, the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(warnings).To(ConsistOf("create-warning", "manager-warning", "developer-warning"))
+							Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+						})
+					})
+				})
+			})
+
+			Context("when the space name is taken", func() {
+				BeforeEach(func() {
+					managerGUID = "some-user-guid"
+					fakeCloudControllerClient.CreateSpaceReturns(
+						ccv2.Space{},
+						ccv2.Warnings{"create-warning"},
+						ccerror.SpaceNameTakenError{Message: "name taken"})
+				})
+
+				It("returns a SpaceNameTakenError and all warnings", func() {
+					Expect(executeErr).To(MatchError(SpaceNameTakenError{Name: "some-space"}))
+					Expect(warnings).To(ConsistOf("create-warning"))
+					Expect(fakeCloudControllerClient.UpdateSpaceManagerCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,107 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// SSHDisabledGloballyError is returned when the targeted Cloud Foundry does
+// not advertise an SSH endpoint.
+type SSHDisabledGloballyError struct {
+}
+
+func (SSHDisabledGloballyError) Error() string {
+	return "SSH support is disabled in this Cloud Foundry."
+}
+
+// SSHNotEnabledForSpaceError is returned when the application's space does not
+// allow SSH.
+type SSHNotEnabledForSpaceError struct {
+	Name string
+}
+
+func (e SSHNotEnabledForSpaceError) Error() string {
+	return fmt.Sprintf("SSH support is disabled for space '%s'.", e.Name)
+}
+
+// SSHNotEnabledForAppError is returned when the application does not have SSH
+// enabled.
+type SSHNotEnabledForAppError struct {
+	Name string
+}
+
+func (e SSHNotEnabledForAppError) Error() string {
+	return fmt.Sprintf("SSH support is disabled for application '%s'.", e.Name)
+}
+
+// SSHInfo contains everything needed to open an SSH session to an application
+// instance through the SSH proxy.
+type SSHInfo struct {
+	// Endpoint is the address of the SSH proxy.
+	Endpoint string
+
+	// HostKeyFingerprint is the fingerprint of the SSH proxy's host key.
+	HostKeyFingerprint string
+
+	// Passcode is a one time code used as the SSH password.
+	Passcode string
+
+	// Username identifies the application instance to the SSH proxy.
+	Username string
+}
+
+// GetSSHInfo returns the SSH proxy endpoint, its host key fingerprint and a
+// one time passcode for the given application instance. SSH must be enabled
+// for the Cloud Foundry, the application's space and the application itself.
+func (actor Actor) GetSSHInfo(appGUID string, instanceIndex int) (SSHInfo, Warnings, error) {
+	var allWarnings Warnings
+
+	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
+	if endpoint == "" {
+		return SSHInfo{}, nil, SSHDisabledGloballyError{}
+	}
+
+	app, warnings, err := actor.GetApplication(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SSHInfo{}, allWarnings, err
+	}
+
+	if instanceIndex < 0 || instanceIndex >= app.Instances {
+		return SSHInfo{}, allWarnings, InstanceNotFoundError{ApplicationGUID: appGUID, Index: instanceIndex}
+	}
+
+	spaces, ccWarnings, err := actor.CloudControllerClient.GetSpaces([]ccv2.Query{{
+		Filter:   ccv2.GUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    app.SpaceGUID,
+	}})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return SSHInfo{}, allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return SSHInfo{}, allWarnings, SpaceNotFoundError{GUID: app.SpaceGUID}
+	}
+
+	if !spaces[0].AllowSSH {
+		return SSHInfo{}, allWarnings, SSHNotEnabledForSpaceError{Name: spaces[0].Name}
+	}
+
+	if !app.EnableSSH {
+		return SSHInfo{}, allWarnings, SSHNotEnabledForAppError{Name: app.Name}
+	}
+
+	passcode, err := actor.UAAClient.GetSSHPasscode(actor.CloudControllerClient.AppSSHOAuthClient())
+	if err != nil {
+		return SSHInfo{}, allWarnings, err
+	}
+
+	return SSHInfo{
+		Endpoint:           endpoint,
+		HostKeyFingerprint: actor.CloudControllerClient.AppSSHHostKeyFingerprint(),
+		Passcode:           passcode,
+		Username:           fmt.Sprintf("cf:%s/%d", appGUID, instanceIndex),
+	}, allWarnings, nil
+}
@@ -0,0 +1,186 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSH Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeUAAClient             *v2actionfakes.FakeUAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient, nil)
+	})
+
+	Describe("GetSSHInfo", func() {
+		var (
+			instanceIndex int
+			sshInfo       SSHInfo
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			instanceIndex = 1
+
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-fingerprint")
+			fakeCloudControllerClient.AppSSHOAuthClientReturns("ssh-proxy")
+			fakeCloudControllerClient.GetApplicationReturns(
+				ccv2.Application{
+					GUID:      "some-app-guid",
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+					Instances: 2,
+					EnableSSH: true,
+				},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space", AllowSSH: true}},
+				ccv2.Warnings{"get-space-warning"},
+				nil,
+			)
+			fakeUAAClient.GetSSHPasscodeReturns("some-passcode", nil)
+		})
+
+		JustBeforeEach(func() {
+			sshInfo, warnings, executeErr = actor.GetSSHInfo("some-app-guid", instanceIndex)
+		})
+
+		Context("when SSH is enabled everywhere", func() {
+			It("returns the SSH information and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-space-warning"))
+				Expect(sshInfo).To(Equal(SSHInfo{
+					Endpoint:           "ssh.example.com:2222",
+					HostKeyFingerprint: "some-fingerprint",
+					Passcode:           "some-passcode",
+					Username:           "cf:some-app-guid/1",
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.GUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-space-guid",
+				}}))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.GetSSHPasscodeArgsForCall(0)).To(Equal("ssh-proxy"))
+			})
+		})
+
+		Context("when SSH is disabled globally", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHEndpointReturns("")
+			})
+
+			It("returns an SSHDisabledGloballyError", func() {
+				Expect(executeErr).To(MatchError(SSHDisabledGloballyError{}))
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the instance index is out of range", func() {
+			BeforeEach(func() {
+				instanceIndex = 2
+			})
+
+			It("returns an InstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(InstanceNotFoundError{ApplicationGUID: "some-app-guid", Index: 2}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		Context("when SSH is disabled for the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv2.Warnings{"get-space-warning"},
+					nil,
+				)
+			})
+
+			It("returns an SSHNotEnabledForSpaceError", func() {
+				Expect(executeErr).To(MatchError(SSHNotEnabledForSpaceError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-space-warning"))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{GUID: "some-space-guid"}))
+			})
+		})
+
+		Context("when SSH is disabled for the app", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationReturns(
+					ccv2.Application{
+						GUID:      "some-app-guid",
+						Name:      "some-app",
+						SpaceGUID: "some-space-guid",
+						Instances: 2,
+					},
+					ccv2.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns an SSHNotEnabledForAppError", func() {
+				Expect(executeErr).To(MatchError(SSHNotEnabledForAppError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-space-warning"))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get app error")
+				fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{}, ccv2.Warnings{"get-app-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		Context("when getting the passcode fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("passcode error")
+				fakeUAAClient.GetSSHPasscodeReturns("", expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-space-warning"))
+			})
+		})
+	})
+})
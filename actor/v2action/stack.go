@@ -2,7 +2,9 @@ package v2action
 
 import (
 	"fmt"
+	"strings"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -30,22 +32,161 @@ func (actor Actor) GetStack(guid string) (Stack, Warnings, error) {
 	return Stack(stack), Warnings(warnings), err
 }
 
-// GetStackByName returns the provided stack
+// EnableStackCache makes GetStackByName reuse stacks it has already looked
+// up for the lifetime of the actor. It is opt-in so that long running
+// processes, such as plugins, do not see stale stacks.
+func (actor *Actor) EnableStackCache() {
+	actor.stackCache = map[string]Stack{}
+}
+
+// ClearStackCache removes all cached stacks. It must be called after creating
+// or updating stacks when the stack cache is enabled.
+func (actor Actor) ClearStackCache() {
+	for name := range actor.stackCache {
+		delete(actor.stackCache, name)
+	}
+}
+
+// GetStackByName returns the provided stack. If the stack cache is enabled,
+// stacks are only fetched from the Cloud Controller the first time they are
+// requested.
 func (actor Actor) GetStackByName(stackName string) (Stack, Warnings, error) {
+	if stack, found := actor.stackCache[stackName]; found {
+		return stack, nil, nil
+	}
+
 	query := []ccv2.Query{
 		{
 			Filter:   ccv2.NameFilter,
 			Operator: ccv2.EqualOperator,
 			Value:    stackName,
 		}}
-	stacks, warnings, err := actor.CloudControllerClient.GetStacks(query)
+	var (
+		allWarnings sharedaction.Warnings
+		stacks      []ccv2.Stack
+	)
+	err := allWarnings.Collect(func() ([]string, error) {
+		var warnings ccv2.Warnings
+		var err error
+		stacks, warnings, err = actor.CloudControllerClient.GetStacks(query)
+		return warnings, err
+	})
 	if err != nil {
-		return Stack{}, Warnings(warnings), err
+		return Stack{}, Warnings(allWarnings), err
 	}
 
 	if len(stacks) == 0 {
-		return Stack{}, Warnings(warnings), StackNotFoundError{Name: stackName}
+		return Stack{}, Warnings(allWarnings), StackNotFoundError{Name: stackName}
+	}
+
+	stack := Stack(stacks[0])
+	if actor.stackCache != nil {
+		actor.stackCache[stackName] = stack
+	}
+
+	return stack, Warnings(allWarnings), nil
+}
+
+// GetStacks returns all the stacks.
+func (actor Actor) GetStacks() ([]Stack, Warnings, error) {
+	ccv2Stacks, warnings, err := actor.CloudControllerClient.GetStacks(nil)
+	if err != nil {
+		return []Stack{}, Warnings(warnings), err
+	}
+
+	stacks := make([]Stack, len(ccv2Stacks))
+	for i, ccv2Stack := range ccv2Stacks {
+		stacks[i] = Stack(ccv2Stack)
+	}
+
+	return stacks, Warnings(warnings), nil
+}
+
+// GetStacksByGUIDs returns the stacks with the provided GUIDs keyed by GUID.
+// Each GUID is only requested once, and GUIDs that do not match a stack are
+// left out of the map. If the Cloud Controller rejects filtering stacks by
+// GUID, the stacks are fetched individually in parallel.
+func (actor Actor) GetStacksByGUIDs(guids []string) (map[string]Stack, Warnings, error) {
+	var uniqueGUIDs []string
+	seen := map[string]bool{}
+	for _, guid := range guids {
+		if guid != "" && !seen[guid] {
+			seen[guid] = true
+			uniqueGUIDs = append(uniqueGUIDs, guid)
+		}
+	}
+
+	stacks := map[string]Stack{}
+	if len(uniqueGUIDs) == 0 {
+		return stacks, nil, nil
+	}
+
+	ccv2Stacks, warnings, err := actor.CloudControllerClient.GetStacks([]ccv2.Query{{
+		Filter:   ccv2.GUIDFilter,
+		Operator: ccv2.InOperator,
+		Value:    strings.Join(uniqueGUIDs, ","),
+	}})
+	allWarnings := Warnings(warnings)
+
+	switch err.(type) {
+	case nil:
+		for _, ccv2Stack := range ccv2Stacks {
+			stacks[ccv2Stack.GUID] = Stack(ccv2Stack)
+		}
+		return stacks, allWarnings, nil
+	case ccerror.BadRequestError:
+		return actor.getStacksByGUIDsIndividually(uniqueGUIDs, stacks, allWarnings)
+	default:
+		return map[string]Stack{}, allWarnings, err
+	}
+}
+
+type getStackResult struct {
+	stack    Stack
+	warnings Warnings
+	err      error
+}
+
+// getStacksByGUIDsIndividually fetches each stack with its own request, with
+// at most ccv2.DefaultPageConcurrency requests in flight, and adds the found
+// stacks to stacks.
+func (actor Actor) getStacksByGUIDsIndividually(guids []string, stacks map[string]Stack, allWarnings Warnings) (map[string]Stack, Warnings, error) {
+	pending := make(chan string, len(guids))
+	for _, guid := range guids {
+		pending <- guid
+	}
+	close(pending)
+
+	results := make(chan getStackResult, len(guids))
+	for i := 0; i < ccv2.DefaultPageConcurrency && i < len(guids); i++ {
+		go func() {
+			for guid := range pending {
+				stack, warnings, err := actor.GetStack(guid)
+				results <- getStackResult{stack: stack, warnings: warnings, err: err}
+			}
+		}()
+	}
+
+	var firstErr error
+	for range guids {
+		result := <-results
+		allWarnings = append(allWarnings, result.warnings...)
+
+		switch result.err.(type) {
+		case nil:
+			stacks[result.stack.GUID] = result.stack
+		case StackNotFoundError:
+			// leave missing stacks out of the map
+		default:
+			if firstErr == nil {
+				firstErr = result.err
+			}
+		}
+	}
+
+	if firstErr != nil {
+		return map[string]Stack{}, allWarnings, firstErr
 	}
 
-	return Stack(stacks[0]), Warnings(warnings), nil
+	return stacks, allWarnings, nil
 }
@@ -115,6 +115,77 @@ var _ = Describe("Stack Actions", func() {
 				})
 			})
 
+			Context("when the stack cache is enabled", func() {
+				BeforeEach(func() {
+					actor.EnableStackCache()
+					fakeCloudControllerClient.GetStacksReturns(
+						[]ccv2.Stack{{
+							Name:        "some-stack",
+							Description: "some stack description",
+						}},
+						ccv2.Warnings{"get-stacks-warning"},
+						nil,
+					)
+				})
+
+				It("only fetches the stack once", func() {
+					_, warnings, err := actor.GetStackByName("some-stack")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-stacks-warning"))
+
+					stack, warnings, err := actor.GetStackByName("some-stack")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(BeEmpty())
+					Expect(stack).To(Equal(Stack{
+						Name:        "some-stack",
+						Description: "some stack description",
+					}))
+
+					Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				})
+
+				It("fetches the stack again after the cache is cleared", func() {
+					_, _, err := actor.GetStackByName("some-stack")
+					Expect(err).NotTo(HaveOccurred())
+
+					actor.ClearStackCache()
+
+					_, _, err = actor.GetStackByName("some-stack")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(2))
+				})
+
+				It("does not cache stacks that are not found", func() {
+					fakeCloudControllerClient.GetStacksReturns([]ccv2.Stack{}, nil, nil)
+
+					_, _, err := actor.GetStackByName("some-other-stack")
+					Expect(err).To(MatchError(StackNotFoundError{Name: "some-other-stack"}))
+					_, _, err = actor.GetStackByName("some-other-stack")
+					Expect(err).To(MatchError(StackNotFoundError{Name: "some-other-stack"}))
+					Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(2))
+				})
+
+				Measure("the number of stack requests for a 50 app push", func(b Benchmarker) {
+					uncachedActor := NewActor(fakeCloudControllerClient, nil, nil)
+					for i := 0; i < 50; i++ {
+						_, _, err := uncachedActor.GetStackByName("some-stack")
+						Expect(err).NotTo(HaveOccurred())
+					}
+					uncachedRequests := fakeCloudControllerClient.GetStacksCallCount()
+
+					for i := 0; i < 50; i++ {
+						_, _, err := actor.GetStackByName("some-stack")
+						Expect(err).NotTo(HaveOccurred())
+					}
+					cachedRequests := fakeCloudControllerClient.GetStacksCallCount() - uncachedRequests
+
+					b.RecordValue("uncached GET /v2/stacks requests", float64(uncachedRequests))
+					b.RecordValue("cached GET /v2/stacks requests", float64(cachedRequests))
+					Expect(uncachedRequests).To(Equal(50))
+					Expect(cachedRequests).To(Equal(1))
+				}, 1)
+			})
+
 			Context("when it returns no stacks", func() {
 				BeforeEach(func() {
 					fakeCloudControllerClient.GetStacksReturns(
@@ -166,4 +237,175 @@ var _ = Describe("Stack Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStacks", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv2.Stack{
+						{Name: "some-stack", Description: "some stack description"},
+						{Name: "other-stack", Description: "other stack description"},
+					},
+					ccv2.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns all the stacks and all warnings", func() {
+				stacks, warnings, err := actor.GetStacks()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stacks).To(ConsistOf(
+					Stack{Name: "some-stack", Description: "some stack description"},
+					Stack{Name: "other-stack", Description: "other stack description"},
+				))
+
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when there are no stacks", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, nil)
+			})
+
+			It("returns an empty, non-nil list", func() {
+				stacks, warnings, err := actor.GetStacks()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stacks).NotTo(BeNil())
+				Expect(stacks).To(BeEmpty())
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					nil,
+					ccv2.Warnings{"get-stacks-warning"},
+					errors.New("get-stacks-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetStacks()
+				Expect(err).To(MatchError("get-stacks-error"))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+	})
+
+	Describe("GetStacksByGUIDs", func() {
+		var (
+			guids    []string
+			stacks   map[string]Stack
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			guids = []string{"stack-guid-1", "stack-guid-2", "stack-guid-1", ""}
+		})
+
+		JustBeforeEach(func() {
+			stacks, warnings, err = actor.GetStacksByGUIDs(guids)
+		})
+
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv2.Stack{
+						{GUID: "stack-guid-1", Name: "stack-1"},
+						{GUID: "stack-guid-2", Name: "stack-2"},
+					},
+					ccv2.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("fetches the unique stacks in a single request and returns them keyed by GUID", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stacks).To(Equal(map[string]Stack{
+					"stack-guid-1": {GUID: "stack-guid-1", Name: "stack-1"},
+					"stack-guid-2": {GUID: "stack-guid-2", Name: "stack-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.GUIDFilter,
+					Operator: ccv2.InOperator,
+					Value:    "stack-guid-1,stack-guid-2",
+				}}))
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the CC API does not support filtering stacks by GUID", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, ccerror.BadRequestError{})
+				fakeCloudControllerClient.GetStackStub = func(guid string) (ccv2.Stack, ccv2.Warnings, error) {
+					if guid == "stack-guid-2" {
+						return ccv2.Stack{}, ccv2.Warnings{"get-stack-warning-2"}, ccerror.ResourceNotFoundError{}
+					}
+					return ccv2.Stack{GUID: guid, Name: "stack-1"}, ccv2.Warnings{"get-stack-warning-1"}, nil
+				}
+			})
+
+			It("fetches each stack individually and leaves out missing stacks", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning", "get-stack-warning-1", "get-stack-warning-2"))
+				Expect(stacks).To(Equal(map[string]Stack{
+					"stack-guid-1": {GUID: "stack-guid-1", Name: "stack-1"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(2))
+			})
+
+			Context("when fetching an individual stack fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get stack error")
+					fakeCloudControllerClient.GetStackStub = nil
+					fakeCloudControllerClient.GetStackReturns(ccv2.Stack{}, ccv2.Warnings{"get-stack-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-stacks-warning", "get-stack-warning", "get-stack-warning"))
+					Expect(stacks).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the CC API client returns another error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get stacks error")
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no GUIDs are provided", func() {
+			BeforeEach(func() {
+				guids = nil
+			})
+
+			It("does not make any requests", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+				Expect(stacks).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
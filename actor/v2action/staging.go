@@ -0,0 +1,118 @@
+package v2action
+
+import (
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/noaa"
+	noaaErrors "github.com/cloudfoundry/noaa/errors"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// StageAndTail stages the application and writes its staging logs to out as
+// they arrive. A started application is restaged; a stopped application is
+// started, which stages it. It returns once staging completes or fails.
+//
+// If the log stream drops while staging, it is reconnected once. The recent
+// logs are fetched after staging to fill any gap, and messages seen on both
+// the stream and the recent logs are only written once.
+func (actor Actor) StageAndTail(appGUID string, client NOAAClient, out io.Writer) (Warnings, error) {
+	defer client.Close()
+
+	eventStream, errStream := client.TailingLogs(appGUID, "")
+
+	app, allWarnings, err := actor.GetApplication(appGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var warnings ccv2.Warnings
+	if app.State == ccv2.ApplicationStarted {
+		_, warnings, err = actor.CloudControllerClient.RestageApplication(ccv2.Application{GUID: appGUID})
+	} else {
+		_, warnings, err = actor.CloudControllerClient.UpdateApplication(ccv2.Application{
+			GUID:  appGUID,
+			State: ccv2.ApplicationStarted,
+		})
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings := make(chan string)
+	pollErr := make(chan error, 1)
+	go func() {
+		defer close(pollWarnings)
+		pollErr <- actor.pollStaging(app, actor.Config, pollWarnings)
+	}()
+
+	logs := stagingLogWriter{out: out, seen: map[string]bool{}}
+	reconnected := false
+	reconnect := func() {
+		if reconnected {
+			eventStream, errStream = nil, nil
+			return
+		}
+		reconnected = true
+		eventStream, errStream = client.TailingLogs(appGUID, "")
+	}
+
+	for pollWarnings != nil {
+		select {
+		case warning, ok := <-pollWarnings:
+			if !ok {
+				pollWarnings = nil
+				continue
+			}
+			allWarnings = append(allWarnings, warning)
+		case event, ok := <-eventStream:
+			if !ok {
+				reconnect()
+				continue
+			}
+			logs.write(event)
+		case err, ok := <-errStream:
+			if !ok {
+				errStream = nil
+				continue
+			}
+			if _, isRetry := err.(noaaErrors.RetryError); isRetry || err == nil {
+				continue
+			}
+			reconnect()
+		}
+	}
+	stagingErr := <-pollErr
+
+	recentMessages, err := client.RecentLogs(appGUID, "")
+	if err == nil {
+		for _, message := range noaa.SortRecent(recentMessages) {
+			logs.write(message)
+		}
+	}
+
+	return allWarnings, stagingErr
+}
+
+// stagingLogWriter writes staging log messages to out, skipping other sources
+// and messages it has already written.
+type stagingLogWriter struct {
+	out  io.Writer
+	seen map[string]bool
+}
+
+func (w stagingLogWriter) write(message *events.LogMessage) {
+	if message.GetSourceType() != StagingLog {
+		return
+	}
+
+	key := fmt.Sprintf("%d|%s|%s", message.GetTimestamp(), message.GetSourceInstance(), message.GetMessage())
+	if w.seen[key] {
+		return
+	}
+	w.seen[key] = true
+
+	fmt.Fprintf(w.out, "%s\n", message.GetMessage())
+}
@@ -0,0 +1,187 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Staging Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+		fakeNOAAClient            *v2actionfakes.FakeNOAAClient
+	)
+
+	logMessage := func(message string, timestamp int64, sourceType string) *events.LogMessage {
+		return &events.LogMessage{
+			Message:     []byte(message),
+			MessageType: events.LogMessage_OUT.Enum(),
+			Timestamp:   &timestamp,
+			SourceType:  &sourceType,
+		}
+	}
+
+	stagingMessage := func(message string, timestamp int64) *events.LogMessage {
+		return logMessage(message, timestamp, StagingLog)
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		fakeConfig.StagingTimeoutReturns(time.Minute)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("StageAndTail", func() {
+		var (
+			out        *Buffer
+			warnings   Warnings
+			executeErr error
+
+			stagingDone chan struct{}
+		)
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			stagingDone = make(chan struct{})
+
+			fakeCloudControllerClient.GetApplicationStub = func(appGUID string) (ccv2.Application, ccv2.Warnings, error) {
+				app := ccv2.Application{
+					GUID:         appGUID,
+					State:        ccv2.ApplicationStarted,
+					PackageState: ccv2.ApplicationPackagePending,
+				}
+				select {
+				case <-stagingDone:
+					app.PackageState = ccv2.ApplicationPackageStaged
+				default:
+				}
+				return app, ccv2.Warnings{"get-app-warning"}, nil
+			}
+			fakeCloudControllerClient.RestageApplicationReturns(ccv2.Application{}, ccv2.Warnings{"restage-warning"}, nil)
+
+			fakeNOAAClient.TailingLogsStub = func(_ string, _ string) (<-chan *events.LogMessage, <-chan error) {
+				eventStream := make(chan *events.LogMessage)
+				errStream := make(chan error)
+
+				if fakeNOAAClient.TailingLogsCallCount() == 1 {
+					go func() {
+						eventStream <- stagingMessage("first", 1)
+						eventStream <- logMessage("app log", 2, "APP")
+						// The connection drops mid-stage.
+						close(eventStream)
+					}()
+				} else {
+					go func() {
+						eventStream <- stagingMessage("second", 3)
+						close(stagingDone)
+					}()
+				}
+
+				return eventStream, errStream
+			}
+
+			fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+				stagingMessage("third", 4),
+				stagingMessage("second", 3),
+				stagingMessage("first", 1),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.StageAndTail("some-app-guid", fakeNOAAClient, out)
+		})
+
+		Context("when the application is started", func() {
+			It("restages it and writes each staging log once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-app-warning"))
+				Expect(warnings).To(ContainElement("restage-warning"))
+
+				Expect(fakeCloudControllerClient.RestageApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.RestageApplicationArgsForCall(0).GUID).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+
+				Expect(string(out.Contents())).To(Equal("first\nsecond\nthird\n"))
+			})
+
+			It("reconnects once after the log stream drops and closes the client", func() {
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the application is stopped", func() {
+			BeforeEach(func() {
+				getApplication := fakeCloudControllerClient.GetApplicationStub
+				fakeCloudControllerClient.GetApplicationStub = func(appGUID string) (ccv2.Application, ccv2.Warnings, error) {
+					app, warnings, err := getApplication(appGUID)
+					if fakeCloudControllerClient.GetApplicationCallCount() == 1 {
+						app.State = ccv2.ApplicationStopped
+					}
+					return app, warnings, err
+				}
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			It("starts it to stage it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("update-warning"))
+
+				Expect(fakeCloudControllerClient.RestageApplicationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID:  "some-app-guid",
+					State: ccv2.ApplicationStarted,
+				}))
+			})
+		})
+
+		Context("when staging fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationStub = func(appGUID string) (ccv2.Application, ccv2.Warnings, error) {
+					app := ccv2.Application{
+						GUID:         appGUID,
+						State:        ccv2.ApplicationStarted,
+						PackageState: ccv2.ApplicationPackagePending,
+					}
+					if fakeCloudControllerClient.GetApplicationCallCount() > 1 {
+						app.PackageState = ccv2.ApplicationPackageFailed
+						app.StagingFailedReason = "OhNoes"
+					}
+					return app, nil, nil
+				}
+			})
+
+			It("returns a StagingFailedError", func() {
+				Expect(executeErr).To(MatchError(StagingFailedError{Reason: "OhNoes"}))
+			})
+		})
+
+		Context("when restaging returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("restage-error")
+				fakeCloudControllerClient.RestageApplicationReturns(ccv2.Application{}, ccv2.Warnings{"restage-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "restage-warning"))
+				Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -6,5 +6,9 @@ import "code.cloudfoundry.org/cli/api/uaa"
 
 type UAAClient interface {
 	Authenticate(username string, password string) (string, string, error)
+	AuthenticateWithClientCredentials(clientID string, clientSecret string) (string, error)
+	AuthenticateWithPasscode(passcode string) (string, string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
+	GetSSHPasscode(sshOAuthClient string) (string, error)
+	GetUsers(userIDs []string) ([]uaa.User, error)
 }
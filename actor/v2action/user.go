@@ -1,6 +1,23 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+const (
+	// SpaceManagerRole is the key of the space managers returned by
+	// GetSpaceUsersByRole.
+	SpaceManagerRole = "SpaceManager"
+	// SpaceDeveloperRole is the key of the space developers returned by
+	// GetSpaceUsersByRole.
+	SpaceDeveloperRole = "SpaceDeveloper"
+	// SpaceAuditorRole is the key of the space auditors returned by
+	// GetSpaceUsersByRole.
+	SpaceAuditorRole = "SpaceAuditor"
+)
 
 // User represents a CLI user.
 type User ccv2.User
@@ -16,3 +33,76 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// GetSpaceUsersByRole returns the managers, developers and auditors of the
+// provided space, keyed by role and sorted by username. Usernames are looked
+// up in the UAA once per user, even when the user holds several roles. A user
+// that is not found in the UAA is returned with its GUID as the username, along
+// with a warning.
+func (actor Actor) GetSpaceUsersByRole(spaceGUID string) (map[string][]User, Warnings, error) {
+	var allWarnings Warnings
+	roleGetters := []struct {
+		role     string
+		getUsers func(string) ([]ccv2.User, ccv2.Warnings, error)
+	}{
+		{role: SpaceManagerRole, getUsers: actor.CloudControllerClient.GetSpaceManagers},
+		{role: SpaceDeveloperRole, getUsers: actor.CloudControllerClient.GetSpaceDevelopers},
+		{role: SpaceAuditorRole, getUsers: actor.CloudControllerClient.GetSpaceAuditors},
+	}
+
+	guidsByRole := map[string][]string{}
+	var uniqueGUIDs []string
+	seen := map[string]bool{}
+	for _, roleGetter := range roleGetters {
+		ccUsers, warnings, err := roleGetter.getUsers(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		inRole := map[string]bool{}
+		for _, ccUser := range ccUsers {
+			if inRole[ccUser.GUID] {
+				continue
+			}
+			inRole[ccUser.GUID] = true
+			guidsByRole[roleGetter.role] = append(guidsByRole[roleGetter.role], ccUser.GUID)
+
+			if !seen[ccUser.GUID] {
+				seen[ccUser.GUID] = true
+				uniqueGUIDs = append(uniqueGUIDs, ccUser.GUID)
+			}
+		}
+	}
+
+	uaaUsers, err := actor.UAAClient.GetUsers(uniqueGUIDs)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	usernames := map[string]string{}
+	for _, uaaUser := range uaaUsers {
+		usernames[uaaUser.ID] = uaaUser.Username
+	}
+
+	for _, guid := range uniqueGUIDs {
+		if _, found := usernames[guid]; !found {
+			usernames[guid] = guid
+			allWarnings = append(allWarnings, fmt.Sprintf("User with GUID %s was not found in UAA.", guid))
+		}
+	}
+
+	usersByRole := map[string][]User{}
+	for _, roleGetter := range roleGetters {
+		users := []User{}
+		for _, guid := range guidsByRole[roleGetter.role] {
+			users = append(users, User{GUID: guid, Username: usernames[guid]})
+		}
+		sort.Slice(users, func(i, j int) bool {
+			return users[i].Username < users[j].Username
+		})
+		usersByRole[roleGetter.role] = users
+	}
+
+	return usersByRole, allWarnings, nil
+}
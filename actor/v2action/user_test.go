@@ -121,4 +121,108 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		var (
+			usersByRole map[string][]User
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			usersByRole, warnings, executeErr = actor.GetSpaceUsersByRole("some-space-guid")
+		})
+
+		Context("when no API errors occur", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceManagersReturns(
+					[]ccv2.User{{GUID: "manager-guid"}, {GUID: "dev-and-manager-guid"}},
+					ccv2.Warnings{"managers-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceDevelopersReturns(
+					[]ccv2.User{{GUID: "dev-and-manager-guid"}, {GUID: "orphan-guid"}, {GUID: "orphan-guid"}},
+					ccv2.Warnings{"developers-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceAuditorsReturns(
+					nil,
+					ccv2.Warnings{"auditors-warning"},
+					nil,
+				)
+				fakeUAAClient.GetUsersReturns(
+					[]uaa.User{
+						{ID: "manager-guid", Username: "zed"},
+						{ID: "dev-and-manager-guid", Username: "alice"},
+					},
+					nil,
+				)
+			})
+
+			It("returns the users for each role sorted by username", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(usersByRole).To(Equal(map[string][]User{
+					SpaceManagerRole: {
+						{GUID: "dev-and-manager-guid", Username: "alice"},
+						{GUID: "manager-guid", Username: "zed"},
+					},
+					SpaceDeveloperRole: {
+						{GUID: "dev-and-manager-guid", Username: "alice"},
+						{GUID: "orphan-guid", Username: "orphan-guid"},
+					},
+					SpaceAuditorRole: {},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpaceManagersArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceDevelopersArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceAuditorsArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+
+			It("looks each user up in the UAA once", func() {
+				Expect(fakeUAAClient.GetUsersCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(Equal([]string{"manager-guid", "dev-and-manager-guid", "orphan-guid"}))
+			})
+
+			It("warns about users missing from the UAA", func() {
+				Expect(warnings).To(ConsistOf(
+					"managers-warning",
+					"developers-warning",
+					"auditors-warning",
+					"User with GUID orphan-guid was not found in UAA.",
+				))
+			})
+		})
+
+		Context("when getting a role's users returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("developers-error")
+				fakeCloudControllerClient.GetSpaceManagersReturns(nil, ccv2.Warnings{"managers-warning"}, nil)
+				fakeCloudControllerClient.GetSpaceDevelopersReturns(nil, ccv2.Warnings{"developers-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("managers-warning", "developers-warning"))
+				Expect(fakeCloudControllerClient.GetSpaceAuditorsCallCount()).To(Equal(0))
+				Expect(fakeUAAClient.GetUsersCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the UAA returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("uaa-error")
+				fakeCloudControllerClient.GetSpaceManagersReturns([]ccv2.User{{GUID: "manager-guid"}}, ccv2.Warnings{"managers-warning"}, nil)
+				fakeUAAClient.GetUsersReturns(nil, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("managers-warning"))
+			})
+		})
+	})
 })
@@ -68,6 +68,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CopyApplicationBitsStub        func(sourceAppGUID string, targetAppGUID string) (ccv2.Job, ccv2.Warnings, error)
+	copyApplicationBitsMutex       sync.RWMutex
+	copyApplicationBitsArgsForCall []struct {
+		sourceAppGUID string
+		targetAppGUID string
+	}
+	copyApplicationBitsReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	copyApplicationBitsReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -83,6 +99,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateOrganizationStub        func(orgName string, quotaGUID *string) (ccv2.Organization, ccv2.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName   string
+		quotaGUID *string
+	}
+	createOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateOrganizationQuotaStub        func(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	createOrganizationQuotaMutex       sync.RWMutex
+	createOrganizationQuotaArgsForCall []struct {
+		orgQuota ccv2.OrganizationQuota
+	}
+	createOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -116,6 +163,40 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateServiceKeyStub        func(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error)
+	createServiceKeyMutex       sync.RWMutex
+	createServiceKeyArgsForCall []struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}
+	createServiceKeyReturns struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string, quotaGUID *string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+		quotaGUID *string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -131,6 +212,33 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(guid string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteApplicationInstanceStub        func(appGUID string, index int) (ccv2.Warnings, error)
+	deleteApplicationInstanceMutex       sync.RWMutex
+	deleteApplicationInstanceArgsForCall []struct {
+		appGUID string
+		index   int
+	}
+	deleteApplicationInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -146,6 +254,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteApplicationRouteStub        func(appGUID string, routeGUID string) (ccv2.Warnings, error)
+	deleteApplicationRouteMutex       sync.RWMutex
+	deleteApplicationRouteArgsForCall []struct {
+		appGUID   string
+		routeGUID string
+	}
+	deleteApplicationRouteReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationRouteReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -202,6 +324,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetApplicationEnvironmentStub        func(appGUID string) (ccv2.ApplicationEnvironment, ccv2.Warnings, error)
+	getApplicationEnvironmentMutex       sync.RWMutex
+	getApplicationEnvironmentArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationEnvironmentReturns struct {
+		result1 ccv2.ApplicationEnvironment
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getApplicationEnvironmentReturnsOnCall map[int]struct {
+		result1 ccv2.ApplicationEnvironment
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetApplicationInstanceFilesStub        func(appGUID string, index int, path string) ([]byte, ccv2.Warnings, error)
+	getApplicationInstanceFilesMutex       sync.RWMutex
+	getApplicationInstanceFilesArgsForCall []struct {
+		appGUID string
+		index   int
+		path    string
+	}
+	getApplicationInstanceFilesReturns struct {
+		result1 []byte
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getApplicationInstanceFilesReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetApplicationInstancesByApplicationStub        func(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	getApplicationInstancesByApplicationMutex       sync.RWMutex
 	getApplicationInstancesByApplicationArgsForCall []struct {
@@ -248,6 +402,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetApplicationServiceBindingsStub        func(appGUID string, queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
+	getApplicationServiceBindingsMutex       sync.RWMutex
+	getApplicationServiceBindingsArgsForCall []struct {
+		appGUID string
+		queries []ccv2.Query
+	}
+	getApplicationServiceBindingsReturns struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getApplicationServiceBindingsReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetApplicationsStub        func(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
 	getApplicationsMutex       sync.RWMutex
 	getApplicationsArgsForCall []struct {
@@ -263,6 +433,65 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetBuildpacksStub        func(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetEnvironmentVariableGroupStub        func(group ccv2.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	getEnvironmentVariableGroupMutex       sync.RWMutex
+	getEnvironmentVariableGroupArgsForCall []struct {
+		group ccv2.EnvironmentVariableGroupName
+	}
+	getEnvironmentVariableGroupReturns struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetEventsStub        func(limit int, queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		limit   int
+		queries []ccv2.Query
+	}
+	getEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetFeatureFlagsStub        func() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
+	getFeatureFlagsMutex       sync.RWMutex
+	getFeatureFlagsArgsForCall []struct{}
+	getFeatureFlagsReturns     struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getFeatureFlagsReturnsOnCall map[int]struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -324,6 +553,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -385,6 +629,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouterGroupsStub        func() ([]ccv2.RouterGroup, ccv2.Warnings, error)
+	getRouterGroupsMutex       sync.RWMutex
+	getRouterGroupsArgsForCall []struct{}
+	getRouterGroupsReturns     struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouterGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRunningSpacesBySecurityGroupStub        func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	getRunningSpacesBySecurityGroupMutex       sync.RWMutex
 	getRunningSpacesBySecurityGroupArgsForCall []struct {
@@ -445,6 +702,81 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeyStub        func(guid string) (ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeyMutex       sync.RWMutex
+	getServiceKeyArgsForCall []struct {
+		guid string
+	}
+	getServiceKeyReturns struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceKeysStub        func(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlanMutex       sync.RWMutex
+	getServicePlanArgsForCall []struct {
+		servicePlanGUID string
+	}
+	getServicePlanReturns struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanReturnsOnCall map[int]struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlansStub        func(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicePlansReturns struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicesStub        func(queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
+	getServicesMutex       sync.RWMutex
+	getServicesArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSharedDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getSharedDomainMutex       sync.RWMutex
 	getSharedDomainArgsForCall []struct {
@@ -473,6 +805,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceAuditorsStub        func(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceAuditorsMutex       sync.RWMutex
+	getSpaceAuditorsArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceAuditorsReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceAuditorsReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetSpaceDevelopersStub        func(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceDevelopersMutex       sync.RWMutex
+	getSpaceDevelopersArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceDevelopersReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceDevelopersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetSpaceManagersStub        func(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceManagersMutex       sync.RWMutex
+	getSpaceManagersArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceManagersReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceManagersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceQuotaStub        func(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	getSpaceQuotaMutex       sync.RWMutex
 	getSpaceQuotaArgsForCall []struct {
@@ -552,6 +929,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceServicesStub        func(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
+	getSpaceServicesMutex       sync.RWMutex
+	getSpaceServicesArgsForCall []struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}
+	getSpaceServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceStagingSecurityGroupsBySpaceStub        func(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSpaceStagingSecurityGroupsBySpaceMutex       sync.RWMutex
 	getSpaceStagingSecurityGroupsBySpaceArgsForCall []struct {
@@ -697,59 +1090,217 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
-	RestageApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
-	restageApplicationMutex       sync.RWMutex
-	restageApplicationArgsForCall []struct {
-		app ccv2.Application
+	UpdateApplicationRouteStub        func(appGUID string, routeGUID string) (ccv2.Warnings, error)
+	updateApplicationRouteMutex       sync.RWMutex
+	updateApplicationRouteArgsForCall []struct {
+		appGUID   string
+		routeGUID string
 	}
-	restageApplicationReturns struct {
-		result1 ccv2.Application
+	updateApplicationRouteReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateApplicationRouteReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateBuildpackStub        func(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
+	updateBuildpackMutex       sync.RWMutex
+	updateBuildpackArgsForCall []struct {
+		buildpack ccv2.Buildpack
+	}
+	updateBuildpackReturns struct {
+		result1 ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}
-	restageApplicationReturnsOnCall map[int]struct {
-		result1 ccv2.Application
+	updateBuildpackReturnsOnCall map[int]struct {
+		result1 ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}
-	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
-	uploadApplicationPackageMutex       sync.RWMutex
-	uploadApplicationPackageArgsForCall []struct {
-		appGUID            string
-		existingResources  []ccv2.Resource
-		newResources       ccv2.Reader
-		newResourcesLength int64
+	UpdateEnvironmentVariableGroupStub        func(group ccv2.EnvironmentVariableGroupName, envVars ccv2.EnvironmentVariableGroup) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	updateEnvironmentVariableGroupMutex       sync.RWMutex
+	updateEnvironmentVariableGroupArgsForCall []struct {
+		group   ccv2.EnvironmentVariableGroupName
+		envVars ccv2.EnvironmentVariableGroup
 	}
-	uploadApplicationPackageReturns struct {
-		result1 ccv2.Job
+	updateEnvironmentVariableGroupReturns struct {
+		result1 ccv2.EnvironmentVariableGroup
 		result2 ccv2.Warnings
 		result3 error
 	}
-	uploadApplicationPackageReturnsOnCall map[int]struct {
-		result1 ccv2.Job
+	updateEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv2.EnvironmentVariableGroup
 		result2 ccv2.Warnings
 		result3 error
 	}
-	APIStub        func() string
-	aPIMutex       sync.RWMutex
-	aPIArgsForCall []struct{}
-	aPIReturns     struct {
-		result1 string
+	UpdateFeatureFlagStub        func(name string, enabled bool) (ccv2.FeatureFlag, ccv2.Warnings, error)
+	updateFeatureFlagMutex       sync.RWMutex
+	updateFeatureFlagArgsForCall []struct {
+		name    string
+		enabled bool
 	}
-	aPIReturnsOnCall map[int]struct {
-		result1 string
+	updateFeatureFlagReturns struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
 	}
-	APIVersionStub        func() string
-	aPIVersionMutex       sync.RWMutex
-	aPIVersionArgsForCall []struct{}
-	aPIVersionReturns     struct {
-		result1 string
+	updateFeatureFlagReturnsOnCall map[int]struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
 	}
-	aPIVersionReturnsOnCall map[int]struct {
-		result1 string
+	UpdateOrganizationManagerStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationManagerMutex       sync.RWMutex
+	updateOrganizationManagerArgsForCall []struct {
+		orgGUID  string
+		userGUID string
 	}
-	AuthorizationEndpointStub        func() string
-	authorizationEndpointMutex       sync.RWMutex
+	updateOrganizationManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationQuotaStub        func(orgGUID string, orgQuotaGUID string) (ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgGUID      string
+		orgQuotaGUID string
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationUserStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationUserMutex       sync.RWMutex
+	updateOrganizationUserArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	updateOrganizationUserReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceDeveloperStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	updateSpaceDeveloperMutex       sync.RWMutex
+	updateSpaceDeveloperArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	updateSpaceDeveloperReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceDeveloperReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceManagerStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	updateSpaceManagerMutex       sync.RWMutex
+	updateSpaceManagerArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	updateSpaceManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	RestageApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	restageApplicationMutex       sync.RWMutex
+	restageApplicationArgsForCall []struct {
+		app ccv2.Application
+	}
+	restageApplicationReturns struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	restageApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
+	uploadApplicationPackageMutex       sync.RWMutex
+	uploadApplicationPackageArgsForCall []struct {
+		appGUID            string
+		existingResources  []ccv2.Resource
+		newResources       ccv2.Reader
+		newResourcesLength int64
+	}
+	uploadApplicationPackageReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	uploadApplicationPackageReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	APIStub        func() string
+	aPIMutex       sync.RWMutex
+	aPIArgsForCall []struct{}
+	aPIReturns     struct {
+		result1 string
+	}
+	aPIReturnsOnCall map[int]struct {
+		result1 string
+	}
+	APIVersionStub        func() string
+	aPIVersionMutex       sync.RWMutex
+	aPIVersionArgsForCall []struct{}
+	aPIVersionReturns     struct {
+		result1 string
+	}
+	aPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHEndpointStub        func() string
+	appSSHEndpointMutex       sync.RWMutex
+	appSSHEndpointArgsForCall []struct{}
+	appSSHEndpointReturns     struct {
+		result1 string
+	}
+	appSSHEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHHostKeyFingerprintStub        func() string
+	appSSHHostKeyFingerprintMutex       sync.RWMutex
+	appSSHHostKeyFingerprintArgsForCall []struct{}
+	appSSHHostKeyFingerprintReturns     struct {
+		result1 string
+	}
+	appSSHHostKeyFingerprintReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHOAuthClientStub        func() string
+	appSSHOAuthClientMutex       sync.RWMutex
+	appSSHOAuthClientArgsForCall []struct{}
+	appSSHOAuthClientReturns     struct {
+		result1 string
+	}
+	appSSHOAuthClientReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AuthorizationEndpointStub        func() string
+	authorizationEndpointMutex       sync.RWMutex
 	authorizationEndpointArgsForCall []struct{}
 	authorizationEndpointReturns     struct {
 		result1 string
@@ -1010,6 +1561,61 @@ func (fake *FakeCloudControllerClient) CheckRouteReturnsOnCall(i int, result1 bo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CopyApplicationBits(sourceAppGUID string, targetAppGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.copyApplicationBitsMutex.Lock()
+	ret, specificReturn := fake.copyApplicationBitsReturnsOnCall[len(fake.copyApplicationBitsArgsForCall)]
+	fake.copyApplicationBitsArgsForCall = append(fake.copyApplicationBitsArgsForCall, struct {
+		sourceAppGUID string
+		targetAppGUID string
+	}{sourceAppGUID, targetAppGUID})
+	fake.recordInvocation("CopyApplicationBits", []interface{}{sourceAppGUID, targetAppGUID})
+	fake.copyApplicationBitsMutex.Unlock()
+	if fake.CopyApplicationBitsStub != nil {
+		return fake.CopyApplicationBitsStub(sourceAppGUID, targetAppGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.copyApplicationBitsReturns.result1, fake.copyApplicationBitsReturns.result2, fake.copyApplicationBitsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CopyApplicationBitsCallCount() int {
+	fake.copyApplicationBitsMutex.RLock()
+	defer fake.copyApplicationBitsMutex.RUnlock()
+	return len(fake.copyApplicationBitsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CopyApplicationBitsArgsForCall(i int) (string, string) {
+	fake.copyApplicationBitsMutex.RLock()
+	defer fake.copyApplicationBitsMutex.RUnlock()
+	return fake.copyApplicationBitsArgsForCall[i].sourceAppGUID, fake.copyApplicationBitsArgsForCall[i].targetAppGUID
+}
+
+func (fake *FakeCloudControllerClient) CopyApplicationBitsReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.CopyApplicationBitsStub = nil
+	fake.copyApplicationBitsReturns = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CopyApplicationBitsReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.CopyApplicationBitsStub = nil
+	if fake.copyApplicationBitsReturnsOnCall == nil {
+		fake.copyApplicationBitsReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.copyApplicationBitsReturnsOnCall[i] = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -1064,6 +1670,115 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateOrganization(orgName string, quotaGUID *string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName   string
+		quotaGUID *string
+	}{orgName, quotaGUID})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName, quotaGUID})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationArgsForCall(i int) (string, *string) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName, fake.createOrganizationArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuota(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.createOrganizationQuotaReturnsOnCall[len(fake.createOrganizationQuotaArgsForCall)]
+	fake.createOrganizationQuotaArgsForCall = append(fake.createOrganizationQuotaArgsForCall, struct {
+		orgQuota ccv2.OrganizationQuota
+	}{orgQuota})
+	fake.recordInvocation("CreateOrganizationQuota", []interface{}{orgQuota})
+	fake.createOrganizationQuotaMutex.Unlock()
+	if fake.CreateOrganizationQuotaStub != nil {
+		return fake.CreateOrganizationQuotaStub(orgQuota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationQuotaReturns.result1, fake.createOrganizationQuotaReturns.result2, fake.createOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCallCount() int {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return len(fake.createOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return fake.createOrganizationQuotaArgsForCall[i].orgQuota
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationQuotaStub = nil
+	fake.createOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationQuotaStub = nil
+	if fake.createOrganizationQuotaReturnsOnCall == nil {
+		fake.createOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -1175,6 +1890,118 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.createServiceKeyMutex.Lock()
+	ret, specificReturn := fake.createServiceKeyReturnsOnCall[len(fake.createServiceKeyArgsForCall)]
+	fake.createServiceKeyArgsForCall = append(fake.createServiceKeyArgsForCall, struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}{serviceInstanceGUID, keyName, parameters})
+	fake.recordInvocation("CreateServiceKey", []interface{}{serviceInstanceGUID, keyName, parameters})
+	fake.createServiceKeyMutex.Unlock()
+	if fake.CreateServiceKeyStub != nil {
+		return fake.CreateServiceKeyStub(serviceInstanceGUID, keyName, parameters)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceKeyReturns.result1, fake.createServiceKeyReturns.result2, fake.createServiceKeyReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyCallCount() int {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return len(fake.createServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return fake.createServiceKeyArgsForCall[i].serviceInstanceGUID, fake.createServiceKeyArgsForCall[i].keyName, fake.createServiceKeyArgsForCall[i].parameters
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturns(result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	fake.createServiceKeyReturns = struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturnsOnCall(i int, result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	if fake.createServiceKeyReturnsOnCall == nil {
+		fake.createServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string, quotaGUID *string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+		quotaGUID *string
+	}{spaceName, orgGUID, quotaGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID, quotaGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string, *string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID, fake.createSpaceArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -1229,38 +2056,141 @@ func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
-	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
-	fake.deleteOrganizationMutex.Unlock()
-	if fake.DeleteOrganizationStub != nil {
-		return fake.DeleteOrganizationStub(orgGUID)
+func (fake *FakeCloudControllerClient) DeleteApplication(guid string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return len(fake.deleteOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return fake.deleteOrganizationArgsForCall[i].orgGUID
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteOrganizationStub = nil
-	fake.deleteOrganizationReturns = struct {
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstance(appGUID string, index int) (ccv2.Warnings, error) {
+	fake.deleteApplicationInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationInstanceReturnsOnCall[len(fake.deleteApplicationInstanceArgsForCall)]
+	fake.deleteApplicationInstanceArgsForCall = append(fake.deleteApplicationInstanceArgsForCall, struct {
+		appGUID string
+		index   int
+	}{appGUID, index})
+	fake.recordInvocation("DeleteApplicationInstance", []interface{}{appGUID, index})
+	fake.deleteApplicationInstanceMutex.Unlock()
+	if fake.DeleteApplicationInstanceStub != nil {
+		return fake.DeleteApplicationInstanceStub(appGUID, index)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationInstanceReturns.result1, fake.deleteApplicationInstanceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceCallCount() int {
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	return len(fake.deleteApplicationInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceArgsForCall(i int) (string, int) {
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	return fake.deleteApplicationInstanceArgsForCall[i].appGUID, fake.deleteApplicationInstanceArgsForCall[i].index
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationInstanceStub = nil
+	fake.deleteApplicationInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationInstanceStub = nil
+	if fake.deleteApplicationInstanceReturnsOnCall == nil {
+		fake.deleteApplicationInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
+	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
+	fake.deleteOrganizationMutex.Unlock()
+	if fake.DeleteOrganizationStub != nil {
+		return fake.DeleteOrganizationStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return len(fake.deleteOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return fake.deleteOrganizationArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	fake.deleteOrganizationReturns = struct {
 		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
@@ -1283,6 +2213,58 @@ func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteApplicationRoute(appGUID string, routeGUID string) (ccv2.Warnings, error) {
+	fake.deleteApplicationRouteMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationRouteReturnsOnCall[len(fake.deleteApplicationRouteArgsForCall)]
+	fake.deleteApplicationRouteArgsForCall = append(fake.deleteApplicationRouteArgsForCall, struct {
+		appGUID   string
+		routeGUID string
+	}{appGUID, routeGUID})
+	fake.recordInvocation("DeleteApplicationRoute", []interface{}{appGUID, routeGUID})
+	fake.deleteApplicationRouteMutex.Unlock()
+	if fake.DeleteApplicationRouteStub != nil {
+		return fake.DeleteApplicationRouteStub(appGUID, routeGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationRouteReturns.result1, fake.deleteApplicationRouteReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationRouteCallCount() int {
+	fake.deleteApplicationRouteMutex.RLock()
+	defer fake.deleteApplicationRouteMutex.RUnlock()
+	return len(fake.deleteApplicationRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationRouteArgsForCall(i int) (string, string) {
+	fake.deleteApplicationRouteMutex.RLock()
+	defer fake.deleteApplicationRouteMutex.RUnlock()
+	return fake.deleteApplicationRouteArgsForCall[i].appGUID, fake.deleteApplicationRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationRouteReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationRouteStub = nil
+	fake.deleteApplicationRouteReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationRouteReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationRouteStub = nil
+	if fake.deleteApplicationRouteReturnsOnCall == nil {
+		fake.deleteApplicationRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationRouteReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
 	fake.deleteRouteMutex.Lock()
 	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
@@ -1493,6 +2475,116 @@ func (fake *FakeCloudControllerClient) GetApplicationReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationEnvironment(appGUID string) (ccv2.ApplicationEnvironment, ccv2.Warnings, error) {
+	fake.getApplicationEnvironmentMutex.Lock()
+	ret, specificReturn := fake.getApplicationEnvironmentReturnsOnCall[len(fake.getApplicationEnvironmentArgsForCall)]
+	fake.getApplicationEnvironmentArgsForCall = append(fake.getApplicationEnvironmentArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationEnvironment", []interface{}{appGUID})
+	fake.getApplicationEnvironmentMutex.Unlock()
+	if fake.GetApplicationEnvironmentStub != nil {
+		return fake.GetApplicationEnvironmentStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationEnvironmentReturns.result1, fake.getApplicationEnvironmentReturns.result2, fake.getApplicationEnvironmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentCallCount() int {
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	return len(fake.getApplicationEnvironmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentArgsForCall(i int) string {
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	return fake.getApplicationEnvironmentArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturns(result1 ccv2.ApplicationEnvironment, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationEnvironmentStub = nil
+	fake.getApplicationEnvironmentReturns = struct {
+		result1 ccv2.ApplicationEnvironment
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturnsOnCall(i int, result1 ccv2.ApplicationEnvironment, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationEnvironmentStub = nil
+	if fake.getApplicationEnvironmentReturnsOnCall == nil {
+		fake.getApplicationEnvironmentReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ApplicationEnvironment
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationEnvironmentReturnsOnCall[i] = struct {
+		result1 ccv2.ApplicationEnvironment
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationInstanceFiles(appGUID string, index int, path string) ([]byte, ccv2.Warnings, error) {
+	fake.getApplicationInstanceFilesMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstanceFilesReturnsOnCall[len(fake.getApplicationInstanceFilesArgsForCall)]
+	fake.getApplicationInstanceFilesArgsForCall = append(fake.getApplicationInstanceFilesArgsForCall, struct {
+		appGUID string
+		index   int
+		path    string
+	}{appGUID, index, path})
+	fake.recordInvocation("GetApplicationInstanceFiles", []interface{}{appGUID, index, path})
+	fake.getApplicationInstanceFilesMutex.Unlock()
+	if fake.GetApplicationInstanceFilesStub != nil {
+		return fake.GetApplicationInstanceFilesStub(appGUID, index, path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstanceFilesReturns.result1, fake.getApplicationInstanceFilesReturns.result2, fake.getApplicationInstanceFilesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationInstanceFilesCallCount() int {
+	fake.getApplicationInstanceFilesMutex.RLock()
+	defer fake.getApplicationInstanceFilesMutex.RUnlock()
+	return len(fake.getApplicationInstanceFilesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationInstanceFilesArgsForCall(i int) (string, int, string) {
+	fake.getApplicationInstanceFilesMutex.RLock()
+	defer fake.getApplicationInstanceFilesMutex.RUnlock()
+	return fake.getApplicationInstanceFilesArgsForCall[i].appGUID, fake.getApplicationInstanceFilesArgsForCall[i].index, fake.getApplicationInstanceFilesArgsForCall[i].path
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationInstanceFilesReturns(result1 []byte, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstanceFilesStub = nil
+	fake.getApplicationInstanceFilesReturns = struct {
+		result1 []byte
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationInstanceFilesReturnsOnCall(i int, result1 []byte, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstanceFilesStub = nil
+	if fake.getApplicationInstanceFilesReturnsOnCall == nil {
+		fake.getApplicationInstanceFilesReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstanceFilesReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
 	fake.getApplicationInstancesByApplicationMutex.Lock()
 	ret, specificReturn := fake.getApplicationInstancesByApplicationReturnsOnCall[len(fake.getApplicationInstancesByApplicationArgsForCall)]
@@ -1661,6 +2753,66 @@ func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationServiceBindings(appGUID string, queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getApplicationServiceBindingsMutex.Lock()
+	ret, specificReturn := fake.getApplicationServiceBindingsReturnsOnCall[len(fake.getApplicationServiceBindingsArgsForCall)]
+	fake.getApplicationServiceBindingsArgsForCall = append(fake.getApplicationServiceBindingsArgsForCall, struct {
+		appGUID string
+		queries []ccv2.Query
+	}{appGUID, queriesCopy})
+	fake.recordInvocation("GetApplicationServiceBindings", []interface{}{appGUID, queriesCopy})
+	fake.getApplicationServiceBindingsMutex.Unlock()
+	if fake.GetApplicationServiceBindingsStub != nil {
+		return fake.GetApplicationServiceBindingsStub(appGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationServiceBindingsReturns.result1, fake.getApplicationServiceBindingsReturns.result2, fake.getApplicationServiceBindingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationServiceBindingsCallCount() int {
+	fake.getApplicationServiceBindingsMutex.RLock()
+	defer fake.getApplicationServiceBindingsMutex.RUnlock()
+	return len(fake.getApplicationServiceBindingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationServiceBindingsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getApplicationServiceBindingsMutex.RLock()
+	defer fake.getApplicationServiceBindingsMutex.RUnlock()
+	return fake.getApplicationServiceBindingsArgsForCall[i].appGUID, fake.getApplicationServiceBindingsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationServiceBindingsReturns(result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationServiceBindingsStub = nil
+	fake.getApplicationServiceBindingsReturns = struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationServiceBindingsReturnsOnCall(i int, result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationServiceBindingsStub = nil
+	if fake.getApplicationServiceBindingsReturnsOnCall == nil {
+		fake.getApplicationServiceBindingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationServiceBindingsReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -1720,127 +2872,346 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.getJobMutex.Lock()
-	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
-	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
-		jobGUID string
-	}{jobGUID})
-	fake.recordInvocation("GetJob", []interface{}{jobGUID})
-	fake.getJobMutex.Unlock()
-	if fake.GetJobStub != nil {
-		return fake.GetJobStub(jobGUID)
+func (fake *FakeCloudControllerClient) GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetBuildpacks", []interface{}{queriesCopy})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetJobCallCount() int {
-	fake.getJobMutex.RLock()
-	defer fake.getJobMutex.RUnlock()
-	return len(fake.getJobArgsForCall)
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
-	fake.getJobMutex.RLock()
-	defer fake.getJobMutex.RUnlock()
-	return fake.getJobArgsForCall[i].jobGUID
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv2.Query {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.GetJobStub = nil
-	fake.getJobReturns = struct {
-		result1 ccv2.Job
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.GetJobStub = nil
-	if fake.getJobReturnsOnCall == nil {
-		fake.getJobReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Job
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Buildpack
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getJobReturnsOnCall[i] = struct {
-		result1 ccv2.Job
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error) {
-	fake.getOrganizationMutex.Lock()
-	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
-	fake.getOrganizationArgsForCall = append(fake.getOrganizationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetOrganization", []interface{}{guid})
-	fake.getOrganizationMutex.Unlock()
-	if fake.GetOrganizationStub != nil {
-		return fake.GetOrganizationStub(guid)
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroup(group ccv2.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error) {
+	fake.getEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentVariableGroupReturnsOnCall[len(fake.getEnvironmentVariableGroupArgsForCall)]
+	fake.getEnvironmentVariableGroupArgsForCall = append(fake.getEnvironmentVariableGroupArgsForCall, struct {
+		group ccv2.EnvironmentVariableGroupName
+	}{group})
+	fake.recordInvocation("GetEnvironmentVariableGroup", []interface{}{group})
+	fake.getEnvironmentVariableGroupMutex.Unlock()
+	if fake.GetEnvironmentVariableGroupStub != nil {
+		return fake.GetEnvironmentVariableGroupStub(group)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationReturns.result1, fake.getOrganizationReturns.result2, fake.getOrganizationReturns.result3
+	return fake.getEnvironmentVariableGroupReturns.result1, fake.getEnvironmentVariableGroupReturns.result2, fake.getEnvironmentVariableGroupReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationCallCount() int {
-	fake.getOrganizationMutex.RLock()
-	defer fake.getOrganizationMutex.RUnlock()
-	return len(fake.getOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupCallCount() int {
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.getEnvironmentVariableGroupArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationArgsForCall(i int) string {
-	fake.getOrganizationMutex.RLock()
-	defer fake.getOrganizationMutex.RUnlock()
-	return fake.getOrganizationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupArgsForCall(i int) ccv2.EnvironmentVariableGroupName {
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	return fake.getEnvironmentVariableGroupArgsForCall[i].group
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationStub = nil
-	fake.getOrganizationReturns = struct {
-		result1 ccv2.Organization
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupReturns(result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetEnvironmentVariableGroupStub = nil
+	fake.getEnvironmentVariableGroupReturns = struct {
+		result1 ccv2.EnvironmentVariableGroup
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationStub = nil
-	if fake.getOrganizationReturnsOnCall == nil {
-		fake.getOrganizationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Organization
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetEnvironmentVariableGroupStub = nil
+	if fake.getEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.getEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.EnvironmentVariableGroup
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getOrganizationReturnsOnCall[i] = struct {
-		result1 ccv2.Organization
+	fake.getEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv2.EnvironmentVariableGroup
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetEvents(limit int, queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getOrganizationPrivateDomainsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationPrivateDomainsReturnsOnCall[len(fake.getOrganizationPrivateDomainsArgsForCall)]
-	fake.getOrganizationPrivateDomainsArgsForCall = append(fake.getOrganizationPrivateDomainsArgsForCall, struct {
-		orgGUID string
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		limit   int
 		queries []ccv2.Query
-	}{orgGUID, queriesCopy})
-	fake.recordInvocation("GetOrganizationPrivateDomains", []interface{}{orgGUID, queriesCopy})
+	}{limit, queriesCopy})
+	fake.recordInvocation("GetEvents", []interface{}{limit, queriesCopy})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(limit, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEventsReturns.result1, fake.getEventsReturns.result2, fake.getEventsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEventsArgsForCall(i int) (int, []ccv2.Query) {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return fake.getEventsArgsForCall[i].limit, fake.getEventsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error) {
+	fake.getFeatureFlagsMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagsReturnsOnCall[len(fake.getFeatureFlagsArgsForCall)]
+	fake.getFeatureFlagsArgsForCall = append(fake.getFeatureFlagsArgsForCall, struct{}{})
+	fake.recordInvocation("GetFeatureFlags", []interface{}{})
+	fake.getFeatureFlagsMutex.Unlock()
+	if fake.GetFeatureFlagsStub != nil {
+		return fake.GetFeatureFlagsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFeatureFlagsReturns.result1, fake.getFeatureFlagsReturns.result2, fake.getFeatureFlagsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagsCallCount() int {
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
+	return len(fake.getFeatureFlagsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagsReturns(result1 []ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetFeatureFlagsStub = nil
+	fake.getFeatureFlagsReturns = struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagsReturnsOnCall(i int, result1 []ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetFeatureFlagsStub = nil
+	if fake.getFeatureFlagsReturnsOnCall == nil {
+		fake.getFeatureFlagsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.FeatureFlag
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagsReturnsOnCall[i] = struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		jobGUID string
+	}{jobGUID})
+	fake.recordInvocation("GetJob", []interface{}{jobGUID})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(jobGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].jobGUID
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.getOrganizationMutex.Lock()
+	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
+	fake.getOrganizationArgsForCall = append(fake.getOrganizationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetOrganization", []interface{}{guid})
+	fake.getOrganizationMutex.Unlock()
+	if fake.GetOrganizationStub != nil {
+		return fake.GetOrganizationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationReturns.result1, fake.getOrganizationReturns.result2, fake.getOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationCallCount() int {
+	fake.getOrganizationMutex.RLock()
+	defer fake.getOrganizationMutex.RUnlock()
+	return len(fake.getOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationArgsForCall(i int) string {
+	fake.getOrganizationMutex.RLock()
+	defer fake.getOrganizationMutex.RUnlock()
+	return fake.getOrganizationArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationStub = nil
+	fake.getOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationStub = nil
+	if fake.getOrganizationReturnsOnCall == nil {
+		fake.getOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getOrganizationPrivateDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationPrivateDomainsReturnsOnCall[len(fake.getOrganizationPrivateDomainsArgsForCall)]
+	fake.getOrganizationPrivateDomainsArgsForCall = append(fake.getOrganizationPrivateDomainsArgsForCall, struct {
+		orgGUID string
+		queries []ccv2.Query
+	}{orgGUID, queriesCopy})
+	fake.recordInvocation("GetOrganizationPrivateDomains", []interface{}{orgGUID, queriesCopy})
 	fake.getOrganizationPrivateDomainsMutex.Unlock()
 	if fake.GetOrganizationPrivateDomainsStub != nil {
 		return fake.GetOrganizationPrivateDomainsStub(orgGUID, queries)
@@ -1942,6 +3313,65 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{queriesCopy})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -2174,6 +3604,52 @@ func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRouterGroups() ([]ccv2.RouterGroup, ccv2.Warnings, error) {
+	fake.getRouterGroupsMutex.Lock()
+	ret, specificReturn := fake.getRouterGroupsReturnsOnCall[len(fake.getRouterGroupsArgsForCall)]
+	fake.getRouterGroupsArgsForCall = append(fake.getRouterGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetRouterGroups", []interface{}{})
+	fake.getRouterGroupsMutex.Unlock()
+	if fake.GetRouterGroupsStub != nil {
+		return fake.GetRouterGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouterGroupsReturns.result1, fake.getRouterGroupsReturns.result2, fake.getRouterGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouterGroupsCallCount() int {
+	fake.getRouterGroupsMutex.RLock()
+	defer fake.getRouterGroupsMutex.RUnlock()
+	return len(fake.getRouterGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouterGroupsReturns(result1 []ccv2.RouterGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouterGroupsStub = nil
+	fake.getRouterGroupsReturns = struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouterGroupsReturnsOnCall(i int, result1 []ccv2.RouterGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouterGroupsStub = nil
+	if fake.getRouterGroupsReturnsOnCall == nil {
+		fake.getRouterGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouterGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouterGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
 	fake.getRunningSpacesBySecurityGroupMutex.Lock()
 	ret, specificReturn := fake.getRunningSpacesBySecurityGroupReturnsOnCall[len(fake.getRunningSpacesBySecurityGroupArgsForCall)]
@@ -2405,64 +3881,349 @@ func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
-	fake.getSharedDomainMutex.Lock()
-	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
-	fake.getSharedDomainArgsForCall = append(fake.getSharedDomainArgsForCall, struct {
-		domainGUID string
-	}{domainGUID})
-	fake.recordInvocation("GetSharedDomain", []interface{}{domainGUID})
-	fake.getSharedDomainMutex.Unlock()
-	if fake.GetSharedDomainStub != nil {
-		return fake.GetSharedDomainStub(domainGUID)
+func (fake *FakeCloudControllerClient) GetServiceKey(guid string) (ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceKeyMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyReturnsOnCall[len(fake.getServiceKeyArgsForCall)]
+	fake.getServiceKeyArgsForCall = append(fake.getServiceKeyArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetServiceKey", []interface{}{guid})
+	fake.getServiceKeyMutex.Unlock()
+	if fake.GetServiceKeyStub != nil {
+		return fake.GetServiceKeyStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSharedDomainReturns.result1, fake.getSharedDomainReturns.result2, fake.getSharedDomainReturns.result3
+	return fake.getServiceKeyReturns.result1, fake.getServiceKeyReturns.result2, fake.getServiceKeyReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainCallCount() int {
-	fake.getSharedDomainMutex.RLock()
-	defer fake.getSharedDomainMutex.RUnlock()
-	return len(fake.getSharedDomainArgsForCall)
+func (fake *FakeCloudControllerClient) GetServiceKeyCallCount() int {
+	fake.getServiceKeyMutex.RLock()
+	defer fake.getServiceKeyMutex.RUnlock()
+	return len(fake.getServiceKeyArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainArgsForCall(i int) string {
-	fake.getSharedDomainMutex.RLock()
-	defer fake.getSharedDomainMutex.RUnlock()
-	return fake.getSharedDomainArgsForCall[i].domainGUID
+func (fake *FakeCloudControllerClient) GetServiceKeyArgsForCall(i int) string {
+	fake.getServiceKeyMutex.RLock()
+	defer fake.getServiceKeyMutex.RUnlock()
+	return fake.getServiceKeyArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainStub = nil
-	fake.getSharedDomainReturns = struct {
-		result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) GetServiceKeyReturns(result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeyStub = nil
+	fake.getServiceKeyReturns = struct {
+		result1 ccv2.ServiceKey
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainStub = nil
-	if fake.getSharedDomainReturnsOnCall == nil {
-		fake.getSharedDomainReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) GetServiceKeyReturnsOnCall(i int, result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeyStub = nil
+	if fake.getServiceKeyReturnsOnCall == nil {
+		fake.getServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceKey
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSharedDomainReturnsOnCall[i] = struct {
-		result1 ccv2.Domain
+	fake.getServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceKey
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error) {
-	fake.getSharedDomainsMutex.Lock()
-	ret, specificReturn := fake.getSharedDomainsReturnsOnCall[len(fake.getSharedDomainsArgsForCall)]
-	fake.getSharedDomainsArgsForCall = append(fake.getSharedDomainsArgsForCall, struct{}{})
+func (fake *FakeCloudControllerClient) GetServiceKeys(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServiceKeys", []interface{}{queriesCopy})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysReturns.result1, fake.getServiceKeysReturns.result2, fake.getServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Query {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlanMutex.Lock()
+	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
+	fake.getServicePlanArgsForCall = append(fake.getServicePlanArgsForCall, struct {
+		servicePlanGUID string
+	}{servicePlanGUID})
+	fake.recordInvocation("GetServicePlan", []interface{}{servicePlanGUID})
+	fake.getServicePlanMutex.Unlock()
+	if fake.GetServicePlanStub != nil {
+		return fake.GetServicePlanStub(servicePlanGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanReturns.result1, fake.getServicePlanReturns.result2, fake.getServicePlanReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanCallCount() int {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return len(fake.getServicePlanArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanArgsForCall(i int) string {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return fake.getServicePlanArgsForCall[i].servicePlanGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturns(result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	fake.getServicePlanReturns = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	if fake.getServicePlanReturnsOnCall == nil {
+		fake.getServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanReturnsOnCall[i] = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlans(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServicePlans", []interface{}{queriesCopy})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlansReturns.result1, fake.getServicePlansReturns.result2, fake.getServicePlansReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv2.Query {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return fake.getServicePlansArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServices(queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
+	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServices", []interface{}{queriesCopy})
+	fake.getServicesMutex.Unlock()
+	if fake.GetServicesStub != nil {
+		return fake.GetServicesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicesReturns.result1, fake.getServicesReturns.result2, fake.getServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicesCallCount() int {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return len(fake.getServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicesArgsForCall(i int) []ccv2.Query {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return fake.getServicesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	fake.getServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.getSharedDomainMutex.Lock()
+	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
+	fake.getSharedDomainArgsForCall = append(fake.getSharedDomainArgsForCall, struct {
+		domainGUID string
+	}{domainGUID})
+	fake.recordInvocation("GetSharedDomain", []interface{}{domainGUID})
+	fake.getSharedDomainMutex.Unlock()
+	if fake.GetSharedDomainStub != nil {
+		return fake.GetSharedDomainStub(domainGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSharedDomainReturns.result1, fake.getSharedDomainReturns.result2, fake.getSharedDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainCallCount() int {
+	fake.getSharedDomainMutex.RLock()
+	defer fake.getSharedDomainMutex.RUnlock()
+	return len(fake.getSharedDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainArgsForCall(i int) string {
+	fake.getSharedDomainMutex.RLock()
+	defer fake.getSharedDomainMutex.RUnlock()
+	return fake.getSharedDomainArgsForCall[i].domainGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainStub = nil
+	fake.getSharedDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainStub = nil
+	if fake.getSharedDomainReturnsOnCall == nil {
+		fake.getSharedDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSharedDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error) {
+	fake.getSharedDomainsMutex.Lock()
+	ret, specificReturn := fake.getSharedDomainsReturnsOnCall[len(fake.getSharedDomainsArgsForCall)]
+	fake.getSharedDomainsArgsForCall = append(fake.getSharedDomainsArgsForCall, struct{}{})
 	fake.recordInvocation("GetSharedDomains", []interface{}{})
 	fake.getSharedDomainsMutex.Unlock()
 	if fake.GetSharedDomainsStub != nil {
@@ -2505,6 +4266,168 @@ func (fake *FakeCloudControllerClient) GetSharedDomainsReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceAuditors(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceAuditorsMutex.Lock()
+	ret, specificReturn := fake.getSpaceAuditorsReturnsOnCall[len(fake.getSpaceAuditorsArgsForCall)]
+	fake.getSpaceAuditorsArgsForCall = append(fake.getSpaceAuditorsArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceAuditors", []interface{}{spaceGUID})
+	fake.getSpaceAuditorsMutex.Unlock()
+	if fake.GetSpaceAuditorsStub != nil {
+		return fake.GetSpaceAuditorsStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceAuditorsReturns.result1, fake.getSpaceAuditorsReturns.result2, fake.getSpaceAuditorsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsCallCount() int {
+	fake.getSpaceAuditorsMutex.RLock()
+	defer fake.getSpaceAuditorsMutex.RUnlock()
+	return len(fake.getSpaceAuditorsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsArgsForCall(i int) string {
+	fake.getSpaceAuditorsMutex.RLock()
+	defer fake.getSpaceAuditorsMutex.RUnlock()
+	return fake.getSpaceAuditorsArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceAuditorsStub = nil
+	fake.getSpaceAuditorsReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceAuditorsStub = nil
+	if fake.getSpaceAuditorsReturnsOnCall == nil {
+		fake.getSpaceAuditorsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceAuditorsReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceDevelopersMutex.Lock()
+	ret, specificReturn := fake.getSpaceDevelopersReturnsOnCall[len(fake.getSpaceDevelopersArgsForCall)]
+	fake.getSpaceDevelopersArgsForCall = append(fake.getSpaceDevelopersArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceDevelopers", []interface{}{spaceGUID})
+	fake.getSpaceDevelopersMutex.Unlock()
+	if fake.GetSpaceDevelopersStub != nil {
+		return fake.GetSpaceDevelopersStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceDevelopersReturns.result1, fake.getSpaceDevelopersReturns.result2, fake.getSpaceDevelopersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersCallCount() int {
+	fake.getSpaceDevelopersMutex.RLock()
+	defer fake.getSpaceDevelopersMutex.RUnlock()
+	return len(fake.getSpaceDevelopersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersArgsForCall(i int) string {
+	fake.getSpaceDevelopersMutex.RLock()
+	defer fake.getSpaceDevelopersMutex.RUnlock()
+	return fake.getSpaceDevelopersArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceDevelopersStub = nil
+	fake.getSpaceDevelopersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceDevelopersStub = nil
+	if fake.getSpaceDevelopersReturnsOnCall == nil {
+		fake.getSpaceDevelopersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceDevelopersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceManagersMutex.Lock()
+	ret, specificReturn := fake.getSpaceManagersReturnsOnCall[len(fake.getSpaceManagersArgsForCall)]
+	fake.getSpaceManagersArgsForCall = append(fake.getSpaceManagersArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceManagers", []interface{}{spaceGUID})
+	fake.getSpaceManagersMutex.Unlock()
+	if fake.GetSpaceManagersStub != nil {
+		return fake.GetSpaceManagersStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceManagersReturns.result1, fake.getSpaceManagersReturns.result2, fake.getSpaceManagersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersCallCount() int {
+	fake.getSpaceManagersMutex.RLock()
+	defer fake.getSpaceManagersMutex.RUnlock()
+	return len(fake.getSpaceManagersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersArgsForCall(i int) string {
+	fake.getSpaceManagersMutex.RLock()
+	defer fake.getSpaceManagersMutex.RUnlock()
+	return fake.getSpaceManagersArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceManagersStub = nil
+	fake.getSpaceManagersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceManagersStub = nil
+	if fake.getSpaceManagersReturnsOnCall == nil {
+		fake.getSpaceManagersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceManagersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error) {
 	fake.getSpaceQuotaMutex.Lock()
 	ret, specificReturn := fake.getSpaceQuotaReturnsOnCall[len(fake.getSpaceQuotaArgsForCall)]
@@ -2799,14 +4722,74 @@ func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetSpaceServices(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
-	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceServicesMutex.Lock()
+	ret, specificReturn := fake.getSpaceServicesReturnsOnCall[len(fake.getSpaceServicesArgsForCall)]
+	fake.getSpaceServicesArgsForCall = append(fake.getSpaceServicesArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queriesCopy})
+	fake.recordInvocation("GetSpaceServices", []interface{}{spaceGUID, queriesCopy})
+	fake.getSpaceServicesMutex.Unlock()
+	if fake.GetSpaceServicesStub != nil {
+		return fake.GetSpaceServicesStub(spaceGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceServicesReturns.result1, fake.getSpaceServicesReturns.result2, fake.getSpaceServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesCallCount() int {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return len(fake.getSpaceServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return fake.getSpaceServicesArgsForCall[i].spaceGUID, fake.getSpaceServicesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	fake.getSpaceServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	if fake.getSpaceServicesReturnsOnCall == nil {
+		fake.getSpaceServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
 	fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall, struct {
 		spaceGUID string
 		queries   []ccv2.Query
@@ -3345,6 +5328,482 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateApplicationRoute(appGUID string, routeGUID string) (ccv2.Warnings, error) {
+	fake.updateApplicationRouteMutex.Lock()
+	ret, specificReturn := fake.updateApplicationRouteReturnsOnCall[len(fake.updateApplicationRouteArgsForCall)]
+	fake.updateApplicationRouteArgsForCall = append(fake.updateApplicationRouteArgsForCall, struct {
+		appGUID   string
+		routeGUID string
+	}{appGUID, routeGUID})
+	fake.recordInvocation("UpdateApplicationRoute", []interface{}{appGUID, routeGUID})
+	fake.updateApplicationRouteMutex.Unlock()
+	if fake.UpdateApplicationRouteStub != nil {
+		return fake.UpdateApplicationRouteStub(appGUID, routeGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateApplicationRouteReturns.result1, fake.updateApplicationRouteReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationRouteCallCount() int {
+	fake.updateApplicationRouteMutex.RLock()
+	defer fake.updateApplicationRouteMutex.RUnlock()
+	return len(fake.updateApplicationRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationRouteArgsForCall(i int) (string, string) {
+	fake.updateApplicationRouteMutex.RLock()
+	defer fake.updateApplicationRouteMutex.RUnlock()
+	return fake.updateApplicationRouteArgsForCall[i].appGUID, fake.updateApplicationRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationRouteReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateApplicationRouteStub = nil
+	fake.updateApplicationRouteReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationRouteReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateApplicationRouteStub = nil
+	if fake.updateApplicationRouteReturnsOnCall == nil {
+		fake.updateApplicationRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationRouteReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error) {
+	fake.updateBuildpackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackReturnsOnCall[len(fake.updateBuildpackArgsForCall)]
+	fake.updateBuildpackArgsForCall = append(fake.updateBuildpackArgsForCall, struct {
+		buildpack ccv2.Buildpack
+	}{buildpack})
+	fake.recordInvocation("UpdateBuildpack", []interface{}{buildpack})
+	fake.updateBuildpackMutex.Unlock()
+	if fake.UpdateBuildpackStub != nil {
+		return fake.UpdateBuildpackStub(buildpack)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateBuildpackReturns.result1, fake.updateBuildpackReturns.result2, fake.updateBuildpackReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackCallCount() int {
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	return len(fake.updateBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackArgsForCall(i int) ccv2.Buildpack {
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	return fake.updateBuildpackArgsForCall[i].buildpack
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackReturns(result1 ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateBuildpackStub = nil
+	fake.updateBuildpackReturns = struct {
+		result1 ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackReturnsOnCall(i int, result1 ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateBuildpackStub = nil
+	if fake.updateBuildpackReturnsOnCall == nil {
+		fake.updateBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Buildpack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateBuildpackReturnsOnCall[i] = struct {
+		result1 ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroup(group ccv2.EnvironmentVariableGroupName, envVars ccv2.EnvironmentVariableGroup) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error) {
+	fake.updateEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.updateEnvironmentVariableGroupReturnsOnCall[len(fake.updateEnvironmentVariableGroupArgsForCall)]
+	fake.updateEnvironmentVariableGroupArgsForCall = append(fake.updateEnvironmentVariableGroupArgsForCall, struct {
+		group   ccv2.EnvironmentVariableGroupName
+		envVars ccv2.EnvironmentVariableGroup
+	}{group, envVars})
+	fake.recordInvocation("UpdateEnvironmentVariableGroup", []interface{}{group, envVars})
+	fake.updateEnvironmentVariableGroupMutex.Unlock()
+	if fake.UpdateEnvironmentVariableGroupStub != nil {
+		return fake.UpdateEnvironmentVariableGroupStub(group, envVars)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateEnvironmentVariableGroupReturns.result1, fake.updateEnvironmentVariableGroupReturns.result2, fake.updateEnvironmentVariableGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupCallCount() int {
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.updateEnvironmentVariableGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupArgsForCall(i int) (ccv2.EnvironmentVariableGroupName, ccv2.EnvironmentVariableGroup) {
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	return fake.updateEnvironmentVariableGroupArgsForCall[i].group, fake.updateEnvironmentVariableGroupArgsForCall[i].envVars
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupReturns(result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateEnvironmentVariableGroupStub = nil
+	fake.updateEnvironmentVariableGroupReturns = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateEnvironmentVariableGroupStub = nil
+	if fake.updateEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.updateEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.EnvironmentVariableGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlag(name string, enabled bool) (ccv2.FeatureFlag, ccv2.Warnings, error) {
+	fake.updateFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.updateFeatureFlagReturnsOnCall[len(fake.updateFeatureFlagArgsForCall)]
+	fake.updateFeatureFlagArgsForCall = append(fake.updateFeatureFlagArgsForCall, struct {
+		name    string
+		enabled bool
+	}{name, enabled})
+	fake.recordInvocation("UpdateFeatureFlag", []interface{}{name, enabled})
+	fake.updateFeatureFlagMutex.Unlock()
+	if fake.UpdateFeatureFlagStub != nil {
+		return fake.UpdateFeatureFlagStub(name, enabled)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateFeatureFlagReturns.result1, fake.updateFeatureFlagReturns.result2, fake.updateFeatureFlagReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagCallCount() int {
+	fake.updateFeatureFlagMutex.RLock()
+	defer fake.updateFeatureFlagMutex.RUnlock()
+	return len(fake.updateFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagArgsForCall(i int) (string, bool) {
+	fake.updateFeatureFlagMutex.RLock()
+	defer fake.updateFeatureFlagMutex.RUnlock()
+	return fake.updateFeatureFlagArgsForCall[i].name, fake.updateFeatureFlagArgsForCall[i].enabled
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagReturns(result1 ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateFeatureFlagStub = nil
+	fake.updateFeatureFlagReturns = struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagReturnsOnCall(i int, result1 ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateFeatureFlagStub = nil
+	if fake.updateFeatureFlagReturnsOnCall == nil {
+		fake.updateFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 ccv2.FeatureFlag
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateFeatureFlagReturnsOnCall[i] = struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManager(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationManagerMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationManagerReturnsOnCall[len(fake.updateOrganizationManagerArgsForCall)]
+	fake.updateOrganizationManagerArgsForCall = append(fake.updateOrganizationManagerArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationManager", []interface{}{orgGUID, userGUID})
+	fake.updateOrganizationManagerMutex.Unlock()
+	if fake.UpdateOrganizationManagerStub != nil {
+		return fake.UpdateOrganizationManagerStub(orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationManagerReturns.result1, fake.updateOrganizationManagerReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerCallCount() int {
+	fake.updateOrganizationManagerMutex.RLock()
+	defer fake.updateOrganizationManagerMutex.RUnlock()
+	return len(fake.updateOrganizationManagerArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerArgsForCall(i int) (string, string) {
+	fake.updateOrganizationManagerMutex.RLock()
+	defer fake.updateOrganizationManagerMutex.RUnlock()
+	return fake.updateOrganizationManagerArgsForCall[i].orgGUID, fake.updateOrganizationManagerArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationManagerStub = nil
+	fake.updateOrganizationManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationManagerStub = nil
+	if fake.updateOrganizationManagerReturnsOnCall == nil {
+		fake.updateOrganizationManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(orgGUID string, orgQuotaGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgGUID      string
+		orgQuotaGUID string
+	}{orgGUID, orgQuotaGUID})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgGUID, orgQuotaGUID})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgGUID, orgQuotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgGUID, fake.updateOrganizationQuotaArgsForCall[i].orgQuotaGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUser(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserReturnsOnCall[len(fake.updateOrganizationUserArgsForCall)]
+	fake.updateOrganizationUserArgsForCall = append(fake.updateOrganizationUserArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationUser", []interface{}{orgGUID, userGUID})
+	fake.updateOrganizationUserMutex.Unlock()
+	if fake.UpdateOrganizationUserStub != nil {
+		return fake.UpdateOrganizationUserStub(orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserReturns.result1, fake.updateOrganizationUserReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserCallCount() int {
+	fake.updateOrganizationUserMutex.RLock()
+	defer fake.updateOrganizationUserMutex.RUnlock()
+	return len(fake.updateOrganizationUserArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserArgsForCall(i int) (string, string) {
+	fake.updateOrganizationUserMutex.RLock()
+	defer fake.updateOrganizationUserMutex.RUnlock()
+	return fake.updateOrganizationUserArgsForCall[i].orgGUID, fake.updateOrganizationUserArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserStub = nil
+	fake.updateOrganizationUserReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserStub = nil
+	if fake.updateOrganizationUserReturnsOnCall == nil {
+		fake.updateOrganizationUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloper(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceDeveloperMutex.Lock()
+	ret, specificReturn := fake.updateSpaceDeveloperReturnsOnCall[len(fake.updateSpaceDeveloperArgsForCall)]
+	fake.updateSpaceDeveloperArgsForCall = append(fake.updateSpaceDeveloperArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("UpdateSpaceDeveloper", []interface{}{spaceGUID, userGUID})
+	fake.updateSpaceDeveloperMutex.Unlock()
+	if fake.UpdateSpaceDeveloperStub != nil {
+		return fake.UpdateSpaceDeveloperStub(spaceGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceDeveloperReturns.result1, fake.updateSpaceDeveloperReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperCallCount() int {
+	fake.updateSpaceDeveloperMutex.RLock()
+	defer fake.updateSpaceDeveloperMutex.RUnlock()
+	return len(fake.updateSpaceDeveloperArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperArgsForCall(i int) (string, string) {
+	fake.updateSpaceDeveloperMutex.RLock()
+	defer fake.updateSpaceDeveloperMutex.RUnlock()
+	return fake.updateSpaceDeveloperArgsForCall[i].spaceGUID, fake.updateSpaceDeveloperArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceDeveloperStub = nil
+	fake.updateSpaceDeveloperReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceDeveloperStub = nil
+	if fake.updateSpaceDeveloperReturnsOnCall == nil {
+		fake.updateSpaceDeveloperReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceDeveloperReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceManager(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceManagerMutex.Lock()
+	ret, specificReturn := fake.updateSpaceManagerReturnsOnCall[len(fake.updateSpaceManagerArgsForCall)]
+	fake.updateSpaceManagerArgsForCall = append(fake.updateSpaceManagerArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("UpdateSpaceManager", []interface{}{spaceGUID, userGUID})
+	fake.updateSpaceManagerMutex.Unlock()
+	if fake.UpdateSpaceManagerStub != nil {
+		return fake.UpdateSpaceManagerStub(spaceGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceManagerReturns.result1, fake.updateSpaceManagerReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerCallCount() int {
+	fake.updateSpaceManagerMutex.RLock()
+	defer fake.updateSpaceManagerMutex.RUnlock()
+	return len(fake.updateSpaceManagerArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerArgsForCall(i int) (string, string) {
+	fake.updateSpaceManagerMutex.RLock()
+	defer fake.updateSpaceManagerMutex.RUnlock()
+	return fake.updateSpaceManagerArgsForCall[i].spaceGUID, fake.updateSpaceManagerArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceManagerStub = nil
+	fake.updateSpaceManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceManagerStub = nil
+	if fake.updateSpaceManagerReturnsOnCall == nil {
+		fake.updateSpaceManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
 	fake.restageApplicationMutex.Lock()
 	ret, specificReturn := fake.restageApplicationReturnsOnCall[len(fake.restageApplicationArgsForCall)]
@@ -3541,6 +6000,126 @@ func (fake *FakeCloudControllerClient) APIVersionReturnsOnCall(i int, result1 st
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) AppSSHEndpoint() string {
+	fake.appSSHEndpointMutex.Lock()
+	ret, specificReturn := fake.appSSHEndpointReturnsOnCall[len(fake.appSSHEndpointArgsForCall)]
+	fake.appSSHEndpointArgsForCall = append(fake.appSSHEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHEndpoint", []interface{}{})
+	fake.appSSHEndpointMutex.Unlock()
+	if fake.AppSSHEndpointStub != nil {
+		return fake.AppSSHEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHEndpointReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointCallCount() int {
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	return len(fake.appSSHEndpointArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturns(result1 string) {
+	fake.AppSSHEndpointStub = nil
+	fake.appSSHEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturnsOnCall(i int, result1 string) {
+	fake.AppSSHEndpointStub = nil
+	if fake.appSSHEndpointReturnsOnCall == nil {
+		fake.appSSHEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprint() string {
+	fake.appSSHHostKeyFingerprintMutex.Lock()
+	ret, specificReturn := fake.appSSHHostKeyFingerprintReturnsOnCall[len(fake.appSSHHostKeyFingerprintArgsForCall)]
+	fake.appSSHHostKeyFingerprintArgsForCall = append(fake.appSSHHostKeyFingerprintArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHHostKeyFingerprint", []interface{}{})
+	fake.appSSHHostKeyFingerprintMutex.Unlock()
+	if fake.AppSSHHostKeyFingerprintStub != nil {
+		return fake.AppSSHHostKeyFingerprintStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHHostKeyFingerprintReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintCallCount() int {
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	return len(fake.appSSHHostKeyFingerprintArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturns(result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	fake.appSSHHostKeyFingerprintReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturnsOnCall(i int, result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	if fake.appSSHHostKeyFingerprintReturnsOnCall == nil {
+		fake.appSSHHostKeyFingerprintReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHHostKeyFingerprintReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClient() string {
+	fake.appSSHOAuthClientMutex.Lock()
+	ret, specificReturn := fake.appSSHOAuthClientReturnsOnCall[len(fake.appSSHOAuthClientArgsForCall)]
+	fake.appSSHOAuthClientArgsForCall = append(fake.appSSHOAuthClientArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHOAuthClient", []interface{}{})
+	fake.appSSHOAuthClientMutex.Unlock()
+	if fake.AppSSHOAuthClientStub != nil {
+		return fake.AppSSHOAuthClientStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHOAuthClientReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClientCallCount() int {
+	fake.appSSHOAuthClientMutex.RLock()
+	defer fake.appSSHOAuthClientMutex.RUnlock()
+	return len(fake.appSSHOAuthClientArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClientReturns(result1 string) {
+	fake.AppSSHOAuthClientStub = nil
+	fake.appSSHOAuthClientReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClientReturnsOnCall(i int, result1 string) {
+	fake.AppSSHOAuthClientStub = nil
+	if fake.appSSHOAuthClientReturnsOnCall == nil {
+		fake.appSSHOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHOAuthClientReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeCloudControllerClient) AuthorizationEndpoint() string {
 	fake.authorizationEndpointMutex.Lock()
 	ret, specificReturn := fake.authorizationEndpointReturnsOnCall[len(fake.authorizationEndpointArgsForCall)]
@@ -3752,16 +6331,32 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.bindRouteToApplicationMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.copyApplicationBitsMutex.RLock()
+	defer fake.copyApplicationBitsMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteApplicationRouteMutex.RLock()
+	defer fake.deleteApplicationRouteMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
@@ -3770,14 +6365,28 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteSpaceMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	fake.getApplicationInstanceFilesMutex.RLock()
+	defer fake.getApplicationInstanceFilesMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
 	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
 	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
 	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationServiceBindingsMutex.RLock()
+	defer fake.getApplicationServiceBindingsMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -3786,6 +6395,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
@@ -3794,6 +6405,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getRouteApplicationsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
+	fake.getRouterGroupsMutex.RLock()
+	defer fake.getRouterGroupsMutex.RUnlock()
 	fake.getRunningSpacesBySecurityGroupMutex.RLock()
 	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
@@ -3802,10 +6415,26 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceBindingsMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceKeyMutex.RLock()
+	defer fake.getServiceKeyMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
 	fake.getSharedDomainMutex.RLock()
 	defer fake.getSharedDomainMutex.RUnlock()
 	fake.getSharedDomainsMutex.RLock()
 	defer fake.getSharedDomainsMutex.RUnlock()
+	fake.getSpaceAuditorsMutex.RLock()
+	defer fake.getSpaceAuditorsMutex.RUnlock()
+	fake.getSpaceDevelopersMutex.RLock()
+	defer fake.getSpaceDevelopersMutex.RUnlock()
+	fake.getSpaceManagersMutex.RLock()
+	defer fake.getSpaceManagersMutex.RUnlock()
 	fake.getSpaceQuotaMutex.RLock()
 	defer fake.getSpaceQuotaMutex.RUnlock()
 	fake.getSpaceRoutesMutex.RLock()
@@ -3816,6 +6445,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getSpaceServiceInstancesMutex.RLock()
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
 	fake.getStackMutex.RLock()
@@ -3836,6 +6467,24 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateApplicationRouteMutex.RLock()
+	defer fake.updateApplicationRouteMutex.RUnlock()
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	fake.updateFeatureFlagMutex.RLock()
+	defer fake.updateFeatureFlagMutex.RUnlock()
+	fake.updateOrganizationManagerMutex.RLock()
+	defer fake.updateOrganizationManagerMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserMutex.RLock()
+	defer fake.updateOrganizationUserMutex.RUnlock()
+	fake.updateSpaceDeveloperMutex.RLock()
+	defer fake.updateSpaceDeveloperMutex.RUnlock()
+	fake.updateSpaceManagerMutex.RLock()
+	defer fake.updateSpaceManagerMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
 	defer fake.restageApplicationMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
@@ -3844,6 +6493,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	fake.appSSHOAuthClientMutex.RLock()
+	defer fake.appSSHOAuthClientMutex.RUnlock()
 	fake.authorizationEndpointMutex.RLock()
 	defer fake.authorizationEndpointMutex.RUnlock()
 	fake.dopplerEndpointMutex.RLock()
@@ -25,6 +25,35 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthenticateWithClientCredentialsStub        func(clientID string, clientSecret string) (string, error)
+	authenticateWithClientCredentialsMutex       sync.RWMutex
+	authenticateWithClientCredentialsArgsForCall []struct {
+		clientID     string
+		clientSecret string
+	}
+	authenticateWithClientCredentialsReturns struct {
+		result1 string
+		result2 error
+	}
+	authenticateWithClientCredentialsReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	AuthenticateWithPasscodeStub        func(passcode string) (string, string, error)
+	authenticateWithPasscodeMutex       sync.RWMutex
+	authenticateWithPasscodeArgsForCall []struct {
+		passcode string
+	}
+	authenticateWithPasscodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithPasscodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
 	CreateUserStub        func(username string, password string, origin string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -40,6 +69,32 @@ type FakeUAAClient struct {
 		result1 uaa.User
 		result2 error
 	}
+	GetSSHPasscodeStub        func(sshOAuthClient string) (string, error)
+	getSSHPasscodeMutex       sync.RWMutex
+	getSSHPasscodeArgsForCall []struct {
+		sshOAuthClient string
+	}
+	getSSHPasscodeReturns struct {
+		result1 string
+		result2 error
+	}
+	getSSHPasscodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetUsersStub        func(userIDs []string) ([]uaa.User, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		userIDs []string
+	}
+	getUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	getUsersReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -99,6 +154,112 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthenticateWithClientCredentials(clientID string, clientSecret string) (string, error) {
+	fake.authenticateWithClientCredentialsMutex.Lock()
+	ret, specificReturn := fake.authenticateWithClientCredentialsReturnsOnCall[len(fake.authenticateWithClientCredentialsArgsForCall)]
+	fake.authenticateWithClientCredentialsArgsForCall = append(fake.authenticateWithClientCredentialsArgsForCall, struct {
+		clientID     string
+		clientSecret string
+	}{clientID, clientSecret})
+	fake.recordInvocation("AuthenticateWithClientCredentials", []interface{}{clientID, clientSecret})
+	fake.authenticateWithClientCredentialsMutex.Unlock()
+	if fake.AuthenticateWithClientCredentialsStub != nil {
+		return fake.AuthenticateWithClientCredentialsStub(clientID, clientSecret)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.authenticateWithClientCredentialsReturns.result1, fake.authenticateWithClientCredentialsReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthenticateWithClientCredentialsCallCount() int {
+	fake.authenticateWithClientCredentialsMutex.RLock()
+	defer fake.authenticateWithClientCredentialsMutex.RUnlock()
+	return len(fake.authenticateWithClientCredentialsArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithClientCredentialsArgsForCall(i int) (string, string) {
+	fake.authenticateWithClientCredentialsMutex.RLock()
+	defer fake.authenticateWithClientCredentialsMutex.RUnlock()
+	return fake.authenticateWithClientCredentialsArgsForCall[i].clientID, fake.authenticateWithClientCredentialsArgsForCall[i].clientSecret
+}
+
+func (fake *FakeUAAClient) AuthenticateWithClientCredentialsReturns(result1 string, result2 error) {
+	fake.AuthenticateWithClientCredentialsStub = nil
+	fake.authenticateWithClientCredentialsReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithClientCredentialsReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AuthenticateWithClientCredentialsStub = nil
+	if fake.authenticateWithClientCredentialsReturnsOnCall == nil {
+		fake.authenticateWithClientCredentialsReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authenticateWithClientCredentialsReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithPasscode(passcode string) (string, string, error) {
+	fake.authenticateWithPasscodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithPasscodeReturnsOnCall[len(fake.authenticateWithPasscodeArgsForCall)]
+	fake.authenticateWithPasscodeArgsForCall = append(fake.authenticateWithPasscodeArgsForCall, struct {
+		passcode string
+	}{passcode})
+	fake.recordInvocation("AuthenticateWithPasscode", []interface{}{passcode})
+	fake.authenticateWithPasscodeMutex.Unlock()
+	if fake.AuthenticateWithPasscodeStub != nil {
+		return fake.AuthenticateWithPasscodeStub(passcode)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.authenticateWithPasscodeReturns.result1, fake.authenticateWithPasscodeReturns.result2, fake.authenticateWithPasscodeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithPasscodeCallCount() int {
+	fake.authenticateWithPasscodeMutex.RLock()
+	defer fake.authenticateWithPasscodeMutex.RUnlock()
+	return len(fake.authenticateWithPasscodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithPasscodeArgsForCall(i int) string {
+	fake.authenticateWithPasscodeMutex.RLock()
+	defer fake.authenticateWithPasscodeMutex.RUnlock()
+	return fake.authenticateWithPasscodeArgsForCall[i].passcode
+}
+
+func (fake *FakeUAAClient) AuthenticateWithPasscodeReturns(result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithPasscodeStub = nil
+	fake.authenticateWithPasscodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithPasscodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithPasscodeStub = nil
+	if fake.authenticateWithPasscodeReturnsOnCall == nil {
+		fake.authenticateWithPasscodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithPasscodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeUAAClient) CreateUser(username string, password string, origin string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -152,13 +313,128 @@ func (fake *FakeUAAClient) CreateUserReturnsOnCall(i int, result1 uaa.User, resu
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) GetSSHPasscode(sshOAuthClient string) (string, error) {
+	fake.getSSHPasscodeMutex.Lock()
+	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
+	fake.getSSHPasscodeArgsForCall = append(fake.getSSHPasscodeArgsForCall, struct {
+		sshOAuthClient string
+	}{sshOAuthClient})
+	fake.recordInvocation("GetSSHPasscode", []interface{}{sshOAuthClient})
+	fake.getSSHPasscodeMutex.Unlock()
+	if fake.GetSSHPasscodeStub != nil {
+		return fake.GetSSHPasscodeStub(sshOAuthClient)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSSHPasscodeReturns.result1, fake.getSSHPasscodeReturns.result2
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeCallCount() int {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return len(fake.getSSHPasscodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeArgsForCall(i int) string {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return fake.getSSHPasscodeArgsForCall[i].sshOAuthClient
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeReturns(result1 string, result2 error) {
+	fake.GetSSHPasscodeStub = nil
+	fake.getSSHPasscodeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetSSHPasscodeStub = nil
+	if fake.getSSHPasscodeReturnsOnCall == nil {
+		fake.getSSHPasscodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getSSHPasscodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsers(userIDs []string) ([]uaa.User, error) {
+	var userIDsCopy []string
+	if userIDs != nil {
+		userIDsCopy = make([]string, len(userIDs))
+		copy(userIDsCopy, userIDs)
+	}
+	fake.getUsersMutex.Lock()
+	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		userIDs []string
+	}{userIDsCopy})
+	fake.recordInvocation("GetUsers", []interface{}{userIDsCopy})
+	fake.getUsersMutex.Unlock()
+	if fake.GetUsersStub != nil {
+		return fake.GetUsersStub(userIDs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUsersReturns.result1, fake.getUsersReturns.result2
+}
+
+func (fake *FakeUAAClient) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetUsersArgsForCall(i int) []string {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return fake.getUsersArgsForCall[i].userIDs
+}
+
+func (fake *FakeUAAClient) GetUsersReturns(result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsersReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	if fake.getUsersReturnsOnCall == nil {
+		fake.getUsersReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.getUsersReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithClientCredentialsMutex.RLock()
+	defer fake.authenticateWithClientCredentialsMutex.RUnlock()
+	fake.authenticateWithPasscodeMutex.RLock()
+	defer fake.authenticateWithPasscodeMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -20,10 +20,15 @@ func (app Application) Started() bool {
 // ApplicationNotFoundError represents the error that occurs when the
 // application is not found.
 type ApplicationNotFoundError struct {
+	GUID string
 	Name string
 }
 
 func (e ApplicationNotFoundError) Error() string {
+	if e.GUID != "" {
+		return fmt.Sprintf("Application with GUID '%s' not found.", e.GUID)
+	}
+
 	return fmt.Sprintf("Application '%s' not found.", e.Name)
 }
 
@@ -37,12 +42,25 @@ func (e ApplicationAlreadyExistsError) Error() string {
 	return fmt.Sprintf("Application '%s' already exists.", e.Name)
 }
 
+// MultipleApplicationsFoundError is returned when the Cloud Controller
+// returns more than one application for a name and space, which it should
+// never do.
+type MultipleApplicationsFoundError struct {
+	Name      string
+	SpaceGUID string
+	Count     int
+}
+
+func (e MultipleApplicationsFoundError) Error() string {
+	return fmt.Sprintf("Found %d applications named '%s' in space '%s'; expected exactly one.", e.Count, e.Name, e.SpaceGUID)
+}
+
 // GetApplicationByNameAndSpace returns the application with the given
 // name in the given space.
 func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (Application, Warnings, error) {
 	apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
-		"space_guids": []string{spaceGUID},
-		"names":       []string{appName},
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+		ccv3.NameFilter:      []string{appName},
 	})
 	if err != nil {
 		return Application{}, Warnings(warnings), err
@@ -52,6 +70,14 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 		return Application{}, Warnings(warnings), ApplicationNotFoundError{Name: appName}
 	}
 
+	if len(apps) > 1 {
+		return Application{}, Warnings(warnings), MultipleApplicationsFoundError{
+			Name:      appName,
+			SpaceGUID: spaceGUID,
+			Count:     len(apps),
+		}
+	}
+
 	return Application(apps[0]), Warnings(warnings), nil
 }
 
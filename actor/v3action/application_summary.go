@@ -71,6 +71,7 @@ func (actor Actor) getProcessesForApp(appGUID string) (Processes, Warnings, erro
 			Type:       ccv3Process.Type,
 			Instances:  []Instance{},
 			MemoryInMB: ccv3Process.MemoryInMB,
+			DiskInMB:   ccv3Process.DiskInMB,
 		}
 		for _, instance := range instances {
 			process.Instances = append(process.Instances, Instance(instance))
@@ -111,6 +111,29 @@ var _ = Describe("Application Actions", func() {
 				Expect(query).To(Equal(expectedQuery))
 			})
 		})
+
+		Context("when the cloud controller returns more than one app", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{Name: "some-app-name", GUID: "some-app-guid-1"},
+						{Name: "some-app-name", GUID: "some-app-guid-2"},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns a MultipleApplicationsFoundError and the warnings", func() {
+				_, warnings, err := actor.GetApplicationByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(MultipleApplicationsFoundError{
+					Name:      "some-app-name",
+					SpaceGUID: "some-space-guid",
+					Count:     2,
+				}))
+			})
+		})
 	})
 
 	Describe("CreateApplicationByNameAndSpace", func() {
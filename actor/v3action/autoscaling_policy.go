@@ -0,0 +1,76 @@
+package v3action
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// AutoscalerNotAvailableError is returned when the targeted Cloud Controller
+// does not advertise an application autoscaler.
+type AutoscalerNotAvailableError struct{}
+
+func (AutoscalerNotAvailableError) Error() string {
+	return "App autoscaling is not available on the targeted Cloud Foundry."
+}
+
+// InvalidAutoscalingPolicyError is returned when an autoscaling policy fails
+// validation before being sent to the autoscaler.
+type InvalidAutoscalingPolicyError struct {
+	Reason string
+}
+
+func (e InvalidAutoscalingPolicyError) Error() string {
+	return fmt.Sprintf("Invalid autoscaling policy: %s", e.Reason)
+}
+
+// GetApplicationAutoscalingPolicy returns the raw autoscaling policy for the
+// provided application GUID.
+func (actor Actor) GetApplicationAutoscalingPolicy(appGUID string) (json.RawMessage, Warnings, error) {
+	policy, warnings, err := actor.CloudControllerClient.GetApplicationAutoscalingPolicy(appGUID)
+	if _, ok := err.(ccerror.AutoscalerNotFoundError); ok {
+		return nil, Warnings(warnings), AutoscalerNotAvailableError{}
+	}
+	return policy, Warnings(warnings), err
+}
+
+// SetApplicationAutoscalingPolicy validates the provided autoscaling policy
+// and attaches it to the provided application GUID.
+func (actor Actor) SetApplicationAutoscalingPolicy(appGUID string, policy json.RawMessage) (Warnings, error) {
+	err := validateAutoscalingPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateApplicationAutoscalingPolicy(appGUID, policy)
+	if _, ok := err.(ccerror.AutoscalerNotFoundError); ok {
+		return Warnings(warnings), AutoscalerNotAvailableError{}
+	}
+	return Warnings(warnings), err
+}
+
+// validateAutoscalingPolicy checks the instance count bounds of a policy. The
+// rest of the policy is left for the autoscaler to validate.
+func validateAutoscalingPolicy(policy json.RawMessage) error {
+	var counts struct {
+		InstanceMinCount *int `json:"instance_min_count"`
+		InstanceMaxCount *int `json:"instance_max_count"`
+	}
+	err := json.Unmarshal(policy, &counts)
+	if err != nil {
+		return InvalidAutoscalingPolicyError{Reason: "policy must be a JSON object with integer instance_min_count and instance_max_count"}
+	}
+
+	switch {
+	case counts.InstanceMinCount == nil:
+		return InvalidAutoscalingPolicyError{Reason: "instance_min_count is required"}
+	case counts.InstanceMaxCount == nil:
+		return InvalidAutoscalingPolicyError{Reason: "instance_max_count is required"}
+	case *counts.InstanceMinCount < 1:
+		return InvalidAutoscalingPolicyError{Reason: "instance_min_count must be at least 1"}
+	case *counts.InstanceMaxCount < *counts.InstanceMinCount:
+		return InvalidAutoscalingPolicyError{Reason: "instance_max_count must be greater than or equal to instance_min_count"}
+	}
+	return nil
+}
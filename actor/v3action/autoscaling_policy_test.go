@@ -0,0 +1,121 @@
+package v3action_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Autoscaling Policy Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationAutoscalingPolicy", func() {
+		Context("when the policy is returned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationAutoscalingPolicyReturns(
+					json.RawMessage(`{"instance_min_count":1,"instance_max_count":3}`),
+					ccv3.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns the policy and warnings", func() {
+				policy, warnings, err := actor.GetApplicationAutoscalingPolicy("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy).To(MatchJSON(`{"instance_min_count":1,"instance_max_count":3}`))
+				Expect(warnings).To(ConsistOf("get-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationAutoscalingPolicyCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationAutoscalingPolicyArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the autoscaler is not available", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationAutoscalingPolicyReturns(nil, nil, ccerror.AutoscalerNotFoundError{})
+			})
+
+			It("returns an AutoscalerNotAvailableError", func() {
+				_, _, err := actor.GetApplicationAutoscalingPolicy("some-app-guid")
+				Expect(err).To(MatchError(AutoscalerNotAvailableError{}))
+			})
+		})
+
+		Context("when getting the policy fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-error")
+				fakeCloudControllerClient.GetApplicationAutoscalingPolicyReturns(nil, ccv3.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationAutoscalingPolicy("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationAutoscalingPolicy", func() {
+		Context("when the policy is valid", func() {
+			var policy json.RawMessage
+
+			BeforeEach(func() {
+				policy = json.RawMessage(`{"instance_min_count":1,"instance_max_count":3,"scaling_rules":[]}`)
+				fakeCloudControllerClient.UpdateApplicationAutoscalingPolicyReturns(ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("sends the policy and returns warnings", func() {
+				warnings, err := actor.SetApplicationAutoscalingPolicy("some-app-guid", policy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationAutoscalingPolicyCallCount()).To(Equal(1))
+				appGUID, sentPolicy := fakeCloudControllerClient.UpdateApplicationAutoscalingPolicyArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(sentPolicy).To(Equal(policy))
+			})
+
+			Context("when the autoscaler is not available", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationAutoscalingPolicyReturns(nil, ccerror.AutoscalerNotFoundError{})
+				})
+
+				It("returns an AutoscalerNotAvailableError", func() {
+					_, err := actor.SetApplicationAutoscalingPolicy("some-app-guid", policy)
+					Expect(err).To(MatchError(AutoscalerNotAvailableError{}))
+				})
+			})
+		})
+
+		DescribeTable("when the policy is invalid",
+			func(policy string, reason string) {
+				_, err := actor.SetApplicationAutoscalingPolicy("some-app-guid", json.RawMessage(policy))
+				Expect(err).To(MatchError(InvalidAutoscalingPolicyError{Reason: reason}))
+				Expect(fakeCloudControllerClient.UpdateApplicationAutoscalingPolicyCallCount()).To(Equal(0))
+			},
+			Entry("not an object", `[]`, "policy must be a JSON object with integer instance_min_count and instance_max_count"),
+			Entry("non-integer count", `{"instance_min_count":"one","instance_max_count":3}`, "policy must be a JSON object with integer instance_min_count and instance_max_count"),
+			Entry("missing min", `{"instance_max_count":3}`, "instance_min_count is required"),
+			Entry("missing max", `{"instance_min_count":1}`, "instance_max_count is required"),
+			Entry("min below 1", `{"instance_min_count":0,"instance_max_count":3}`, "instance_min_count must be at least 1"),
+			Entry("max below min", `{"instance_min_count":4,"instance_max_count":3}`, "instance_max_count must be greater than or equal to instance_min_count"),
+		)
+	})
+})
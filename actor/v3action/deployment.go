@@ -0,0 +1,81 @@
+package v3action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Deployment represents a V3 actor rolling deployment.
+type Deployment ccv3.Deployment
+
+// DeploymentCanceledError is returned when a deployment is canceled before it
+// finishes.
+type DeploymentCanceledError struct {
+	GUID string
+}
+
+func (e DeploymentCanceledError) Error() string {
+	return fmt.Sprintf("Deployment '%s' was canceled.", e.GUID)
+}
+
+// DeploymentTimeoutError is returned when a deployment does not finish within
+// the configured startup timeout.
+type DeploymentTimeoutError struct {
+	GUID    string
+	Timeout time.Duration
+}
+
+func (e DeploymentTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for deployment '%s' to finish.", e.GUID)
+}
+
+// CreateDeployment starts a rolling deployment of the given droplet for the
+// given application and returns the deployment's GUID.
+func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	return deployment.GUID, Warnings(warnings), nil
+}
+
+// PollDeployment waits for the deployment with the given GUID to finish.
+// progress, if non-nil, is called with the deployment after every poll so
+// callers can report processes scaling up and down. A deployment that ends up
+// canceled returns a DeploymentCanceledError.
+func (actor Actor) PollDeployment(deploymentGUID string, progress func(Deployment)) (Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if progress != nil {
+			progress(Deployment(deployment))
+		}
+
+		switch deployment.StatusReason {
+		case ccv3.DeploymentStatusReasonDeployed:
+			return allWarnings, nil
+		case ccv3.DeploymentStatusReasonCanceled:
+			return allWarnings, DeploymentCanceledError{GUID: deploymentGUID}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return allWarnings, DeploymentTimeoutError{GUID: deploymentGUID, Timeout: actor.Config.StartupTimeout()}
+}
+
+// CancelDeployment cancels the deployment with the given GUID.
+func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
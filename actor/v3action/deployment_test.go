@@ -0,0 +1,203 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("CreateDeployment", func() {
+		Context("when the deployment is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid"},
+					ccv3.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("returns the deployment GUID and warnings", func() {
+				deploymentGUID, warnings, err := actor.CreateDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
+				appGUID, dropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			})
+		})
+
+		Context("when creating the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create deployment error")
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{},
+					ccv3.Warnings{"create-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.CreateDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			progressUpdates []Deployment
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			progressUpdates = nil
+			fakeConfig.StartupTimeoutReturns(time.Minute)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollDeployment("some-deployment-guid", func(deployment Deployment) {
+				progressUpdates = append(progressUpdates, deployment)
+			})
+		})
+
+		Context("when the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+					ccv3.Deployment{
+						GUID:         "some-deployment-guid",
+						StatusReason: ccv3.DeploymentStatusReasonDeploying,
+						NewProcesses: []ccv3.Process{{GUID: "some-process-guid", Type: "web"}},
+					},
+					ccv3.Warnings{"get-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+					ccv3.Deployment{
+						GUID:         "some-deployment-guid",
+						StatusReason: ccv3.DeploymentStatusReasonDeployed,
+					},
+					ccv3.Warnings{"get-warning-2"},
+					nil,
+				)
+			})
+
+			It("polls until the deployment is deployed and reports progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning-1", "get-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
+
+				Expect(progressUpdates).To(HaveLen(2))
+				Expect(progressUpdates[0].NewProcesses).To(ConsistOf(ccv3.Process{GUID: "some-process-guid", Type: "web"}))
+				Expect(progressUpdates[1].StatusReason).To(Equal(ccv3.DeploymentStatusReasonDeployed))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{
+						GUID:         "some-deployment-guid",
+						StatusReason: ccv3.DeploymentStatusReasonCanceled,
+					},
+					ccv3.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DeploymentCanceledError and warnings", func() {
+				Expect(executeErr).To(MatchError(DeploymentCanceledError{GUID: "some-deployment-guid"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when getting the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get deployment error")
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{},
+					ccv3.Warnings{"get-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(progressUpdates).To(BeEmpty())
+			})
+		})
+
+		Context("when the deployment does not finish before the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(0)
+			})
+
+			It("returns a DeploymentTimeoutError", func() {
+				Expect(executeErr).To(MatchError(DeploymentTimeoutError{GUID: "some-deployment-guid", Timeout: 0}))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, nil)
+			})
+
+			It("cancels the deployment and returns warnings", func() {
+				warnings, err := actor.CancelDeployment("some-deployment-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("cancel-warning"))
+
+				Expect(fakeCloudControllerClient.CancelDeploymentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when canceling the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cancel deployment error")
+				fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.CancelDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("cancel-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+const (
+	// MaxLabelKeyPrefixLength is the maximum length of the DNS subdomain
+	// prefix of a label key.
+	MaxLabelKeyPrefixLength = 253
+	// MaxLabelKeyNameLength is the maximum length of the name part of a label
+	// key.
+	MaxLabelKeyNameLength = 63
+	// ReservedLabelKeyPrefix is the label key prefix reserved for use by
+	// Cloud Foundry itself.
+	ReservedLabelKeyPrefix = "cloudfoundry.org"
+)
+
+var (
+	labelKeyPrefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	labelKeyNameRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+)
+
+// Metadata represents the labels and annotations of a V3 actor resource.
+type Metadata ccv3.Metadata
+
+// InvalidLabelKeyError is returned when a label key does not follow the
+// Cloud Controller's label key format.
+type InvalidLabelKeyError struct {
+	Key    string
+	Reason string
+}
+
+func (e InvalidLabelKeyError) Error() string {
+	return fmt.Sprintf("Label key '%s' is invalid: %s", e.Key, e.Reason)
+}
+
+// GetApplicationMetadata returns the labels and annotations of the
+// application with the given GUID.
+func (actor Actor) GetApplicationMetadata(appGUID string) (Metadata, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		ccv3.GUIDFilter: []string{appGUID},
+	})
+	if err != nil {
+		return Metadata{}, Warnings(warnings), err
+	}
+
+	if len(apps) == 0 {
+		return Metadata{}, Warnings(warnings), ApplicationNotFoundError{GUID: appGUID}
+	}
+
+	return Metadata(apps[0].Metadata), Warnings(warnings), nil
+}
+
+// SetApplicationLabels updates the labels of the application with the given
+// GUID. A label with a nil value is removed from the application. All keys
+// are validated before any request is made.
+func (actor Actor) SetApplicationLabels(appGUID string, labels map[string]*string) (Warnings, error) {
+	for key := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateApplicationLabels(appGUID, labels)
+	return Warnings(warnings), err
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	if i := strings.Index(key, "/"); i != -1 {
+		prefix := key[:i]
+		name = key[i+1:]
+
+		switch {
+		case strings.Contains(name, "/"):
+			return InvalidLabelKeyError{Key: key, Reason: "a key may contain at most one '/'"}
+		case prefix == "":
+			return InvalidLabelKeyError{Key: key, Reason: "the prefix must not be empty"}
+		case len(prefix) > MaxLabelKeyPrefixLength:
+			return InvalidLabelKeyError{Key: key, Reason: fmt.Sprintf("the prefix must be no more than %d characters", MaxLabelKeyPrefixLength)}
+		case !labelKeyPrefixRegexp.MatchString(prefix):
+			return InvalidLabelKeyError{Key: key, Reason: "the prefix must be a valid DNS subdomain"}
+		case prefix == ReservedLabelKeyPrefix:
+			return InvalidLabelKeyError{Key: key, Reason: fmt.Sprintf("the prefix '%s' is reserved", ReservedLabelKeyPrefix)}
+		}
+	}
+
+	switch {
+	case name == "":
+		return InvalidLabelKeyError{Key: key, Reason: "the name must not be empty"}
+	case len(name) > MaxLabelKeyNameLength:
+		return InvalidLabelKeyError{Key: key, Reason: fmt.Sprintf("the name must be no more than %d characters", MaxLabelKeyNameLength)}
+	case !labelKeyNameRegexp.MatchString(name):
+		return InvalidLabelKeyError{Key: key, Reason: "the name must begin and end with an alphanumeric character and contain only alphanumerics, '-', '_' or '.'"}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metadata Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationMetadata", func() {
+		var (
+			metadata   Metadata
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			metadata, warnings, executeErr = actor.GetApplicationMetadata("some-app-guid")
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							GUID: "some-app-guid",
+							Metadata: ccv3.Metadata{
+								Labels:      map[string]string{"env": "production"},
+								Annotations: map[string]string{"contact": "someone@example.com"},
+							},
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and annotations of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(metadata).To(Equal(Metadata{
+					Labels:      map[string]string{"env": "production"},
+					Annotations: map[string]string{"contact": "someone@example.com"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"some-app-guid"},
+				}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"some-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationLabels", func() {
+		Context("when all label keys are valid", func() {
+			var (
+				labels     map[string]*string
+				value      string
+				warnings   Warnings
+				executeErr error
+			)
+
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.SetApplicationLabels("some-app-guid", labels)
+			})
+
+			BeforeEach(func() {
+				value = "production"
+				labels = map[string]*string{
+					"env":                 &value,
+					"example.com/old-key": nil,
+				}
+			})
+
+			Context("when the update succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationLabelsReturns(
+						ccv3.Application{GUID: "some-app-guid"},
+						ccv3.Warnings{"some-warning"},
+						nil,
+					)
+				})
+
+				It("updates the labels and returns the warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-warning"))
+
+					Expect(fakeCloudControllerClient.UpdateApplicationLabelsCallCount()).To(Equal(1))
+					appGUID, passedLabels := fakeCloudControllerClient.UpdateApplicationLabelsArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(passedLabels).To(Equal(labels))
+				})
+			})
+
+			Context("when the update fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("I am a CloudControllerClient Error")
+					fakeCloudControllerClient.UpdateApplicationLabelsReturns(
+						ccv3.Application{},
+						ccv3.Warnings{"some-warning"},
+						expectedErr,
+					)
+				})
+
+				It("returns the error and the warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("some-warning"))
+				})
+			})
+		})
+
+		DescribeTable("when a label key is invalid",
+			func(key string, reason string) {
+				_, err := actor.SetApplicationLabels("some-app-guid", map[string]*string{key: nil})
+				Expect(err).To(MatchError(InvalidLabelKeyError{Key: key, Reason: reason}))
+				Expect(fakeCloudControllerClient.UpdateApplicationLabelsCallCount()).To(Equal(0))
+			},
+
+			Entry("empty key", "", "the name must not be empty"),
+			Entry("empty name after prefix", "example.com/", "the name must not be empty"),
+			Entry("empty prefix", "/env", "the prefix must not be empty"),
+			Entry("multiple slashes", "example.com/a/b", "a key may contain at most one '/'"),
+			Entry("name too long", strings.Repeat("a", 64), "the name must be no more than 63 characters"),
+			Entry("prefix too long", strings.Repeat("a", 254)+"/env", "the prefix must be no more than 253 characters"),
+			Entry("prefix not a DNS subdomain", "Example.com/env", "the prefix must be a valid DNS subdomain"),
+			Entry("reserved prefix", "cloudfoundry.org/env", "the prefix 'cloudfoundry.org' is reserved"),
+			Entry("name starting with a dash", "-env", "the name must begin and end with an alphanumeric character and contain only alphanumerics, '-', '_' or '.'"),
+			Entry("name with invalid characters", "env!", "the name must begin and end with an alphanumeric character and contain only alphanumerics, '-', '_' or '.'"),
+		)
+
+		DescribeTable("when a label key is valid",
+			func(key string) {
+				_, err := actor.SetApplicationLabels("some-app-guid", map[string]*string{key: nil})
+				Expect(err).ToNot(HaveOccurred())
+			},
+
+			Entry("simple name", "env"),
+			Entry("name with punctuation", "my_app.tier-1"),
+			Entry("name of maximum length", strings.Repeat("a", 63)),
+			Entry("prefixed name", "example.com/env"),
+		)
+	})
+})
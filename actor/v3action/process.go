@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
@@ -14,6 +15,17 @@ type Process struct {
 	Type       string
 	Instances  []Instance
 	MemoryInMB int
+	DiskInMB   int
+}
+
+// ProcessNotFoundError is returned when an application has no process of the
+// requested type.
+type ProcessNotFoundError struct {
+	ProcessType string
+}
+
+func (e ProcessNotFoundError) Error() string {
+	return fmt.Sprintf("Process %s not found", e.ProcessType)
 }
 
 // Instance represents a V3 actor instance.
@@ -79,3 +91,25 @@ func (ps Processes) Summary() string {
 
 	return strings.Join(summaries, ", ")
 }
+
+// GetApplicationProcesses returns every process of the application with the
+// given GUID, along with each process's instances.
+func (actor Actor) GetApplicationProcesses(appGUID string) ([]Process, Warnings, error) {
+	processes, warnings, err := actor.getProcessesForApp(appGUID)
+	return []Process(processes), warnings, err
+}
+
+// ScaleProcess scales the process of the given type for the application with
+// the given GUID. Nil values are left unchanged.
+func (actor Actor) ScaleProcess(appGUID string, processType string, instances *int, memoryMB *int, diskMB *int) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.ScaleApplicationProcess(appGUID, processType, ccv3.ProcessScale{
+		Instances:  instances,
+		MemoryInMB: memoryMB,
+		DiskInMB:   diskMB,
+	})
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), ProcessNotFoundError{ProcessType: processType}
+	}
+
+	return Warnings(warnings), err
+}
@@ -1,15 +1,29 @@
 package v3action_test
 
 import (
+	"errors"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("Process Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
 	Describe("Instance", func() {
 		Describe("StartTime", func() {
 			It("returns the time that the instance started", func() {
@@ -88,4 +102,142 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationProcesses", func() {
+		Context("when the processes and their instances are found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "web-process-guid", Type: "web", MemoryInMB: 64, DiskInMB: 512},
+						{GUID: "worker-process-guid", Type: "worker", MemoryInMB: 128, DiskInMB: 1024},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+					[]ccv3.Instance{{State: "RUNNING"}},
+					ccv3.Warnings{"get-instances-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+					[]ccv3.Instance{},
+					ccv3.Warnings{"get-instances-warning-2"},
+					nil,
+				)
+			})
+
+			It("returns every process with its instances and all warnings", func() {
+				processes, warnings, err := actor.GetApplicationProcesses("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning-1", "get-instances-warning-2"))
+				Expect(processes).To(Equal([]Process{
+					{Type: "web", Instances: []Instance{{State: "RUNNING"}}, MemoryInMB: 64, DiskInMB: 512},
+					{Type: "worker", Instances: []Instance{}, MemoryInMB: 128, DiskInMB: 1024},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("web-process-guid"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(1)).To(Equal("worker-process-guid"))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationProcesses("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+	})
+
+	Describe("ScaleProcess", func() {
+		var (
+			instances  *int
+			memoryMB   *int
+			diskMB     *int
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			instances, memoryMB, diskMB = nil, nil, nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ScaleProcess("some-app-guid", "worker", instances, memoryMB, diskMB)
+		})
+
+		Context("when only the instances are provided", func() {
+			BeforeEach(func() {
+				count := 5
+				instances = &count
+				fakeCloudControllerClient.ScaleApplicationProcessReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, nil)
+			})
+
+			It("scales the instances and leaves memory and disk unset", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("scale-warning"))
+
+				Expect(fakeCloudControllerClient.ScaleApplicationProcessCallCount()).To(Equal(1))
+				appGUID, processType, scale := fakeCloudControllerClient.ScaleApplicationProcessArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(*scale.Instances).To(Equal(5))
+				Expect(scale.MemoryInMB).To(BeNil())
+				Expect(scale.DiskInMB).To(BeNil())
+			})
+		})
+
+		Context("when memory and disk are provided", func() {
+			BeforeEach(func() {
+				memory, disk := 256, 2048
+				memoryMB, diskMB = &memory, &disk
+			})
+
+			It("passes them through without changing the instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, scale := fakeCloudControllerClient.ScaleApplicationProcessArgsForCall(0)
+				Expect(scale.Instances).To(BeNil())
+				Expect(*scale.MemoryInMB).To(Equal(256))
+				Expect(*scale.DiskInMB).To(Equal(2048))
+			})
+		})
+
+		Context("when the process type does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ScaleApplicationProcessReturns(
+					ccv3.Process{},
+					ccv3.Warnings{"scale-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns a ProcessNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(ConsistOf("scale-warning"))
+			})
+		})
+
+		Context("when scaling fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("scale error")
+				fakeCloudControllerClient.ScaleApplicationProcessReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("scale-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,141 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// RevisionsFeature is the name of the application feature that controls
+// whether revisions are recorded.
+const RevisionsFeature = "revisions"
+
+// RevisionsDisabledWarning is returned as a warning when revisions are listed
+// for an application that does not record them.
+const RevisionsDisabledWarning = "Revisions are disabled for this app."
+
+// Revision represents a V3 actor application revision.
+type Revision ccv3.Revision
+
+// RevisionNotFoundError is returned when an application has no revision with
+// the requested version or GUID.
+type RevisionNotFoundError struct {
+	GUID    string
+	Version int
+}
+
+func (e RevisionNotFoundError) Error() string {
+	if e.GUID != "" {
+		return fmt.Sprintf("Revision '%s' not found.", e.GUID)
+	}
+	return fmt.Sprintf("Revision '%d' not found.", e.Version)
+}
+
+// RevisionDropletNotFoundError is returned when the droplet a revision refers
+// to no longer exists, usually because the platform has garbage collected it.
+type RevisionDropletNotFoundError struct {
+	RevisionGUID string
+	DropletGUID  string
+}
+
+func (e RevisionDropletNotFoundError) Error() string {
+	return fmt.Sprintf("Droplet '%s' for revision '%s' no longer exists.", e.DropletGUID, e.RevisionGUID)
+}
+
+// GetApplicationRevisions returns the revisions of the application, newest
+// first. If revisions are disabled for the application, an empty list is
+// returned along with an informational warning.
+func (actor Actor) GetApplicationRevisions(appGUID string) ([]Revision, Warnings, error) {
+	feature, allWarnings, err := actor.CloudControllerClient.GetApplicationFeature(appGUID, RevisionsFeature)
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	if !feature.Enabled {
+		return []Revision{}, append(Warnings(allWarnings), RevisionsDisabledWarning), nil
+	}
+
+	ccRevisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(appGUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	revisions := make([]Revision, 0, len(ccRevisions))
+	for _, ccRevision := range ccRevisions {
+		revisions = append(revisions, Revision(ccRevision))
+	}
+	sort.Slice(revisions, func(i int, j int) bool {
+		return revisions[i].Version > revisions[j].Version
+	})
+
+	return revisions, Warnings(allWarnings), nil
+}
+
+// GetRevisionByVersion returns the application's revision with the given
+// version.
+func (actor Actor) GetRevisionByVersion(appGUID string, version int) (Revision, Warnings, error) {
+	ccRevisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(appGUID, url.Values{
+		"versions": []string{strconv.Itoa(version)},
+	})
+	if err != nil {
+		return Revision{}, Warnings(warnings), err
+	}
+
+	for _, ccRevision := range ccRevisions {
+		if ccRevision.Version == version {
+			return Revision(ccRevision), Warnings(warnings), nil
+		}
+	}
+
+	return Revision{}, Warnings(warnings), RevisionNotFoundError{Version: version}
+}
+
+// CreateDeploymentByRevision starts a rolling deployment of an earlier
+// revision of the application and waits for it to finish. The revision must
+// belong to the application and its droplet must still exist.
+func (actor Actor) CreateDeploymentByRevision(appGUID string, revisionGUID string) (string, Warnings, error) {
+	ccRevisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(appGUID, nil)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	var (
+		revision Revision
+		found    bool
+	)
+	for _, ccRevision := range ccRevisions {
+		if ccRevision.GUID == revisionGUID {
+			revision = Revision(ccRevision)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", allWarnings, RevisionNotFoundError{GUID: revisionGUID}
+	}
+
+	_, warnings, err = actor.CloudControllerClient.GetDroplet(revision.DropletGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return "", allWarnings, RevisionDropletNotFoundError{RevisionGUID: revisionGUID, DropletGUID: revision.DropletGUID}
+	} else if err != nil {
+		return "", allWarnings, err
+	}
+
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentByRevision(appGUID, revisionGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	pollWarnings, err := actor.PollDeployment(deployment.GUID, nil)
+	allWarnings = append(allWarnings, pollWarnings...)
+
+	return deployment.GUID, allWarnings, err
+}
@@ -0,0 +1,295 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("GetApplicationRevisions", func() {
+		var (
+			revisions  []Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revisions, warnings, executeErr = actor.GetApplicationRevisions("some-app-guid")
+		})
+
+		Context("when revisions are enabled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationFeatureReturns(
+					ccv3.ApplicationFeature{Name: "revisions", Enabled: true},
+					ccv3.Warnings{"feature-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]ccv3.Revision{
+						{GUID: "revision-1-guid", Version: 1, DropletGUID: "droplet-1-guid", Description: "Initial revision.", CreatedAt: "2018-03-08T23:11:56Z"},
+						{GUID: "revision-3-guid", Version: 3, DropletGUID: "droplet-3-guid", Description: "Rollback.", CreatedAt: "2018-03-10T23:11:56Z"},
+						{GUID: "revision-2-guid", Version: 2, DropletGUID: "droplet-2-guid", Description: "New droplet deployed.", CreatedAt: "2018-03-09T23:11:56Z"},
+					},
+					ccv3.Warnings{"revisions-warning"},
+					nil,
+				)
+			})
+
+			It("returns the revisions newest first and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("feature-warning", "revisions-warning"))
+				Expect(revisions).To(Equal([]Revision{
+					{GUID: "revision-3-guid", Version: 3, DropletGUID: "droplet-3-guid", Description: "Rollback.", CreatedAt: "2018-03-10T23:11:56Z"},
+					{GUID: "revision-2-guid", Version: 2, DropletGUID: "droplet-2-guid", Description: "New droplet deployed.", CreatedAt: "2018-03-09T23:11:56Z"},
+					{GUID: "revision-1-guid", Version: 1, DropletGUID: "droplet-1-guid", Description: "Initial revision.", CreatedAt: "2018-03-08T23:11:56Z"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationFeatureCallCount()).To(Equal(1))
+				appGUID, featureName := fakeCloudControllerClient.GetApplicationFeatureArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(featureName).To(Equal("revisions"))
+
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(1))
+				appGUID, _ = fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+
+			Context("when getting the revisions fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("revisions-error")
+					fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"revisions-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("feature-warning", "revisions-warning"))
+				})
+			})
+		})
+
+		Context("when revisions are disabled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationFeatureReturns(
+					ccv3.ApplicationFeature{Name: "revisions", Enabled: false},
+					ccv3.Warnings{"feature-warning"},
+					nil,
+				)
+			})
+
+			It("returns an empty list and an informational warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(revisions).To(BeEmpty())
+				Expect(revisions).ToNot(BeNil())
+				Expect(warnings).To(ConsistOf("feature-warning", RevisionsDisabledWarning))
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the feature fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("feature-error")
+				fakeCloudControllerClient.GetApplicationFeatureReturns(ccv3.ApplicationFeature{}, ccv3.Warnings{"feature-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("feature-warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionByVersion", func() {
+		var (
+			revision   Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revision, warnings, executeErr = actor.GetRevisionByVersion("some-app-guid", 2)
+		})
+
+		Context("when the revision exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]ccv3.Revision{{GUID: "revision-2-guid", Version: 2, DropletGUID: "droplet-2-guid"}},
+					ccv3.Warnings{"revisions-warning"},
+					nil,
+				)
+			})
+
+			It("returns the revision and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("revisions-warning"))
+				Expect(revision).To(Equal(Revision{GUID: "revision-2-guid", Version: 2, DropletGUID: "droplet-2-guid"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"versions": []string{"2"}}))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"revisions-warning"}, nil)
+			})
+
+			It("returns a RevisionNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(RevisionNotFoundError{Version: 2}))
+				Expect(warnings).To(ConsistOf("revisions-warning"))
+			})
+		})
+
+		Context("when getting the revisions fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("revisions-error")
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"revisions-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("revisions-warning"))
+			})
+		})
+	})
+
+	Describe("CreateDeploymentByRevision", func() {
+		var (
+			deploymentGUID string
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Minute)
+			fakeCloudControllerClient.GetApplicationRevisionsReturns(
+				[]ccv3.Revision{
+					{GUID: "revision-1-guid", Version: 1, DropletGUID: "droplet-1-guid"},
+					{GUID: "revision-2-guid", Version: 2, DropletGUID: "droplet-2-guid"},
+				},
+				ccv3.Warnings{"revisions-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDropletReturns(ccv3.Droplet{GUID: "droplet-1-guid"}, ccv3.Warnings{"droplet-warning"}, nil)
+			fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(
+				ccv3.Deployment{GUID: "some-deployment-guid"},
+				ccv3.Warnings{"create-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDeploymentReturns(
+				ccv3.Deployment{GUID: "some-deployment-guid", StatusReason: ccv3.DeploymentStatusReasonDeployed},
+				ccv3.Warnings{"get-deployment-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			deploymentGUID, warnings, executeErr = actor.CreateDeploymentByRevision("some-app-guid", "revision-1-guid")
+		})
+
+		Context("when the deployment finishes", func() {
+			It("deploys the revision, waits for it and returns the deployment GUID", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(warnings).To(ConsistOf("revisions-warning", "droplet-warning", "create-warning", "get-deployment-warning"))
+
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("droplet-1-guid"))
+
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(1))
+				appGUID, revisionGUID := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(revisionGUID).To(Equal("revision-1-guid"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid", StatusReason: ccv3.DeploymentStatusReasonCanceled},
+					ccv3.Warnings{"get-deployment-warning"},
+					nil,
+				)
+			})
+
+			It("returns the deployment GUID, a DeploymentCanceledError and all warnings", func() {
+				Expect(executeErr).To(MatchError(DeploymentCanceledError{GUID: "some-deployment-guid"}))
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(warnings).To(ConsistOf("revisions-warning", "droplet-warning", "create-warning", "get-deployment-warning"))
+			})
+		})
+
+		Context("when the revision does not belong to the application", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]ccv3.Revision{{GUID: "revision-2-guid", Version: 2, DropletGUID: "droplet-2-guid"}},
+					ccv3.Warnings{"revisions-warning"},
+					nil,
+				)
+			})
+
+			It("returns a RevisionNotFoundError and does not deploy", func() {
+				Expect(executeErr).To(MatchError(RevisionNotFoundError{GUID: "revision-1-guid"}))
+				Expect(warnings).To(ConsistOf("revisions-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the revision's droplet no longer exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(ccv3.Droplet{}, ccv3.Warnings{"droplet-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a RevisionDropletNotFoundError and does not deploy", func() {
+				Expect(executeErr).To(MatchError(RevisionDropletNotFoundError{RevisionGUID: "revision-1-guid", DropletGUID: "droplet-1-guid"}))
+				Expect(warnings).To(ConsistOf("revisions-warning", "droplet-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create-error")
+				fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(ccv3.Deployment{}, ccv3.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("revisions-warning", "droplet-warning", "create-warning"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
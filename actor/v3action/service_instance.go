@@ -0,0 +1,91 @@
+package v3action
+
+// ServiceInstanceSharingFeatureFlag is the feature flag that must be enabled
+// to share service instances across spaces.
+const ServiceInstanceSharingFeatureFlag = "service_instance_sharing"
+
+// ServiceInstanceSharingDisabledError is returned when sharing is attempted
+// while the service_instance_sharing feature flag is disabled.
+type ServiceInstanceSharingDisabledError struct{}
+
+func (ServiceInstanceSharingDisabledError) Error() string {
+	return `The "service_instance_sharing" feature flag is disabled for this Cloud Foundry platform.`
+}
+
+// ServiceInstanceSharingOutcome is the result of sharing or unsharing a
+// service instance with a single space. Err is nil if it succeeded.
+type ServiceInstanceSharingOutcome struct {
+	SpaceGUID string
+	Err       error
+}
+
+// ServiceInstanceSharingResult reports the spaces a service instance is
+// shared with after sharing or unsharing it, along with the outcome for each
+// requested space.
+type ServiceInstanceSharingResult struct {
+	SharedSpaceGUIDs []string
+	Outcomes         []ServiceInstanceSharingOutcome
+}
+
+// Failed returns the outcomes that did not succeed.
+func (result ServiceInstanceSharingResult) Failed() []ServiceInstanceSharingOutcome {
+	var failed []ServiceInstanceSharingOutcome
+	for _, outcome := range result.Outcomes {
+		if outcome.Err != nil {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+// ShareServiceInstance shares the service instance with each of the target
+// spaces. Each space is shared separately so that a failure for one space does
+// not prevent sharing with the others; per-space failures are reported in the
+// result's outcomes rather than as the returned error.
+func (actor Actor) ShareServiceInstance(instanceGUID string, targetSpaceGUIDs []string) (ServiceInstanceSharingResult, Warnings, error) {
+	flag, warnings, err := actor.CloudControllerClient.GetFeatureFlag(ServiceInstanceSharingFeatureFlag)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return ServiceInstanceSharingResult{}, allWarnings, err
+	}
+	if !flag.Enabled {
+		return ServiceInstanceSharingResult{}, allWarnings, ServiceInstanceSharingDisabledError{}
+	}
+
+	var result ServiceInstanceSharingResult
+	for _, spaceGUID := range targetSpaceGUIDs {
+		_, warnings, err = actor.CloudControllerClient.ShareServiceInstanceToSpaces(instanceGUID, []string{spaceGUID})
+		allWarnings = append(allWarnings, warnings...)
+		result.Outcomes = append(result.Outcomes, ServiceInstanceSharingOutcome{SpaceGUID: spaceGUID, Err: err})
+	}
+
+	return actor.withSharedSpaces(instanceGUID, result, allWarnings)
+}
+
+// UnshareServiceInstance stops sharing the service instance with each of the
+// target spaces. As with ShareServiceInstance, per-space failures are reported
+// in the result's outcomes.
+func (actor Actor) UnshareServiceInstance(instanceGUID string, targetSpaceGUIDs []string) (ServiceInstanceSharingResult, Warnings, error) {
+	var (
+		result      ServiceInstanceSharingResult
+		allWarnings Warnings
+	)
+	for _, spaceGUID := range targetSpaceGUIDs {
+		warnings, err := actor.CloudControllerClient.UnshareServiceInstanceFromSpace(instanceGUID, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		result.Outcomes = append(result.Outcomes, ServiceInstanceSharingOutcome{SpaceGUID: spaceGUID, Err: err})
+	}
+
+	return actor.withSharedSpaces(instanceGUID, result, allWarnings)
+}
+
+func (actor Actor) withSharedSpaces(instanceGUID string, result ServiceInstanceSharingResult, allWarnings Warnings) (ServiceInstanceSharingResult, Warnings, error) {
+	sharedSpaces, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedSpaces(instanceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return result, allWarnings, err
+	}
+
+	result.SharedSpaceGUIDs = sharedSpaces.GUIDs
+	return result, allWarnings, nil
+}
@@ -0,0 +1,195 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+
+		fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+			ccv3.RelationshipList{GUIDs: []string{"space-guid-1", "space-guid-2"}},
+			ccv3.Warnings{"get-shared-spaces-warning"},
+			nil,
+		)
+	})
+
+	Describe("ShareServiceInstance", func() {
+		var (
+			result     ServiceInstanceSharingResult
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetFeatureFlagReturns(
+				ccv3.FeatureFlag{Name: "service_instance_sharing", Enabled: true},
+				ccv3.Warnings{"feature-flag-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"share-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			result, warnings, executeErr = actor.ShareServiceInstance("some-instance-guid", []string{"space-guid-1", "space-guid-2"})
+		})
+
+		Context("when sharing with every space succeeds", func() {
+			It("shares with each space and returns the shared spaces and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("feature-flag-warning", "share-warning", "share-warning", "get-shared-spaces-warning"))
+				Expect(result.SharedSpaceGUIDs).To(Equal([]string{"space-guid-1", "space-guid-2"}))
+				Expect(result.Outcomes).To(Equal([]ServiceInstanceSharingOutcome{
+					{SpaceGUID: "space-guid-1"},
+					{SpaceGUID: "space-guid-2"},
+				}))
+				Expect(result.Failed()).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetFeatureFlagArgsForCall(0)).To(Equal("service_instance_sharing"))
+
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(2))
+				instanceGUID, spaceGUIDs := fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(0)
+				Expect(instanceGUID).To(Equal("some-instance-guid"))
+				Expect(spaceGUIDs).To(Equal([]string{"space-guid-1"}))
+				_, spaceGUIDs = fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(1)
+				Expect(spaceGUIDs).To(Equal([]string{"space-guid-2"}))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesArgsForCall(0)).To(Equal("some-instance-guid"))
+			})
+		})
+
+		Context("when sharing with one of the spaces fails", func() {
+			var shareErr error
+
+			BeforeEach(func() {
+				shareErr = errors.New("share-error")
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturnsOnCall(1, ccv3.RelationshipList{}, ccv3.Warnings{"share-warning"}, shareErr)
+				fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+					ccv3.RelationshipList{GUIDs: []string{"space-guid-1"}},
+					ccv3.Warnings{"get-shared-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("reports the outcome for each space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result.SharedSpaceGUIDs).To(Equal([]string{"space-guid-1"}))
+				Expect(result.Outcomes).To(Equal([]ServiceInstanceSharingOutcome{
+					{SpaceGUID: "space-guid-1"},
+					{SpaceGUID: "space-guid-2", Err: shareErr},
+				}))
+				Expect(result.Failed()).To(Equal([]ServiceInstanceSharingOutcome{
+					{SpaceGUID: "space-guid-2", Err: shareErr},
+				}))
+			})
+		})
+
+		Context("when the feature flag is disabled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetFeatureFlagReturns(
+					ccv3.FeatureFlag{Name: "service_instance_sharing", Enabled: false},
+					ccv3.Warnings{"feature-flag-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceSharingDisabledError without sharing", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceSharingDisabledError{}))
+				Expect(warnings).To(ConsistOf("feature-flag-warning"))
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the feature flag fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("feature-flag-error")
+				fakeCloudControllerClient.GetFeatureFlagReturns(ccv3.FeatureFlag{}, ccv3.Warnings{"feature-flag-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("feature-flag-warning"))
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the shared spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-shared-spaces-error")
+				fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"get-shared-spaces-warning"}, expectedErr)
+			})
+
+			It("returns the outcomes, the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("feature-flag-warning", "share-warning", "share-warning", "get-shared-spaces-warning"))
+				Expect(result.Outcomes).To(HaveLen(2))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstance", func() {
+		var (
+			result     ServiceInstanceSharingResult
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(ccv3.Warnings{"unshare-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			result, warnings, executeErr = actor.UnshareServiceInstance("some-instance-guid", []string{"space-guid-3", "space-guid-4"})
+		})
+
+		Context("when unsharing from every space succeeds", func() {
+			It("unshares from each space and returns the remaining shared spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unshare-warning", "unshare-warning", "get-shared-spaces-warning"))
+				Expect(result.SharedSpaceGUIDs).To(Equal([]string{"space-guid-1", "space-guid-2"}))
+				Expect(result.Failed()).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(2))
+				instanceGUID, spaceGUID := fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(0)
+				Expect(instanceGUID).To(Equal("some-instance-guid"))
+				Expect(spaceGUID).To(Equal("space-guid-3"))
+				_, spaceGUID = fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(1)
+				Expect(spaceGUID).To(Equal("space-guid-4"))
+			})
+		})
+
+		Context("when unsharing from one of the spaces fails", func() {
+			var unshareErr error
+
+			BeforeEach(func() {
+				unshareErr = errors.New("unshare-error")
+				fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturnsOnCall(0, ccv3.Warnings{"unshare-warning"}, unshareErr)
+			})
+
+			It("reports the outcome for each space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result.Outcomes).To(Equal([]ServiceInstanceSharingOutcome{
+					{SpaceGUID: "space-guid-3", Err: unshareErr},
+					{SpaceGUID: "space-guid-4"},
+				}))
+			})
+		})
+	})
+})
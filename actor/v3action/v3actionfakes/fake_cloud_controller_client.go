@@ -2,6 +2,7 @@
 package v3actionfakes
 
 import (
+	"encoding/json"
 	"net/url"
 	"sync"
 
@@ -26,6 +27,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CancelDeploymentStub        func(deploymentGUID string) (ccv3.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -50,6 +64,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentStub        func(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	CreateApplicationDeploymentByRevisionStub        func(appGUID string, revisionGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentByRevisionMutex       sync.RWMutex
+	createApplicationDeploymentByRevisionArgsForCall []struct {
+		appGUID      string
+		revisionGUID string
+	}
+	createApplicationDeploymentByRevisionReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentByRevisionReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationTaskStub        func(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	createApplicationTaskMutex       sync.RWMutex
 	createApplicationTaskArgsForCall []struct {
@@ -155,6 +201,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationAutoscalingPolicyStub        func(appGUID string) (json.RawMessage, ccv3.Warnings, error)
+	getApplicationAutoscalingPolicyMutex       sync.RWMutex
+	getApplicationAutoscalingPolicyArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationAutoscalingPolicyReturns struct {
+		result1 json.RawMessage
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationAutoscalingPolicyReturnsOnCall map[int]struct {
+		result1 json.RawMessage
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationCurrentDropletStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	getApplicationCurrentDropletMutex       sync.RWMutex
 	getApplicationCurrentDropletArgsForCall []struct {
@@ -185,6 +246,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationFeatureStub        func(appGUID string, featureName string) (ccv3.ApplicationFeature, ccv3.Warnings, error)
+	getApplicationFeatureMutex       sync.RWMutex
+	getApplicationFeatureArgsForCall []struct {
+		appGUID     string
+		featureName string
+	}
+	getApplicationFeatureReturns struct {
+		result1 ccv3.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationFeatureReturnsOnCall map[int]struct {
+		result1 ccv3.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationProcessByTypeStub        func(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error)
 	getApplicationProcessByTypeMutex       sync.RWMutex
 	getApplicationProcessByTypeArgsForCall []struct {
@@ -231,6 +308,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationRevisionsStub        func(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error)
+	getApplicationRevisionsMutex       sync.RWMutex
+	getApplicationRevisionsArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationRevisionsReturns struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationRevisionsReturnsOnCall map[int]struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -262,6 +355,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDropletStub        func(dropletGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	getDropletMutex       sync.RWMutex
+	getDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	getDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetFeatureFlagStub        func(flagName string) (ccv3.FeatureFlag, ccv3.Warnings, error)
+	getFeatureFlagMutex       sync.RWMutex
+	getFeatureFlagArgsForCall []struct {
+		flagName string
+	}
+	getFeatureFlagReturns struct {
+		result1 ccv3.FeatureFlag
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getFeatureFlagReturnsOnCall map[int]struct {
+		result1 ccv3.FeatureFlag
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetIsolationSegmentStub        func(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	getIsolationSegmentMutex       sync.RWMutex
 	getIsolationSegmentArgsForCall []struct {
@@ -352,6 +490,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceInstanceSharedSpacesStub        func(serviceInstanceGUID string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	getServiceInstanceSharedSpacesMutex       sync.RWMutex
+	getServiceInstanceSharedSpacesArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -381,6 +534,23 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	ScaleApplicationProcessStub        func(appGUID string, processType string, scale ccv3.ProcessScale) (ccv3.Process, ccv3.Warnings, error)
+	scaleApplicationProcessMutex       sync.RWMutex
+	scaleApplicationProcessArgsForCall []struct {
+		appGUID     string
+		processType string
+		scale       ccv3.ProcessScale
+	}
+	scaleApplicationProcessReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	scaleApplicationProcessReturnsOnCall map[int]struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
 	SetApplicationDropletStub        func(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	setApplicationDropletMutex       sync.RWMutex
 	setApplicationDropletArgsForCall []struct {
@@ -411,6 +581,22 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	ShareServiceInstanceToSpacesStub        func(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	shareServiceInstanceToSpacesMutex       sync.RWMutex
+	shareServiceInstanceToSpacesArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}
+	shareServiceInstanceToSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	shareServiceInstanceToSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
 	StartApplicationStub        func(appGUID string) (ccv3.Application, ccv3.Warnings, error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -439,6 +625,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	UnshareServiceInstanceFromSpaceStub        func(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error)
+	unshareServiceInstanceFromSpaceMutex       sync.RWMutex
+	unshareServiceInstanceFromSpaceArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}
+	unshareServiceInstanceFromSpaceReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	unshareServiceInstanceFromSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -454,6 +654,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateApplicationAutoscalingPolicyStub        func(appGUID string, policy json.RawMessage) (ccv3.Warnings, error)
+	updateApplicationAutoscalingPolicyMutex       sync.RWMutex
+	updateApplicationAutoscalingPolicyArgsForCall []struct {
+		appGUID string
+		policy  json.RawMessage
+	}
+	updateApplicationAutoscalingPolicyReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	updateApplicationAutoscalingPolicyReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	UpdateApplicationLabelsStub        func(appGUID string, labels map[string]*string) (ccv3.Application, ccv3.Warnings, error)
+	updateApplicationLabelsMutex       sync.RWMutex
+	updateApplicationLabelsArgsForCall []struct {
+		appGUID string
+		labels  map[string]*string
+	}
+	updateApplicationLabelsReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationLabelsReturnsOnCall map[int]struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -544,6 +774,57 @@ func (fake *FakeCloudControllerClient) AssignSpaceToIsolationSegmentReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CancelDeployment(deploymentGUID string) (ccv3.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -638,6 +919,116 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].appGUID, fake.createApplicationDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentByRevisionMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentByRevisionReturnsOnCall[len(fake.createApplicationDeploymentByRevisionArgsForCall)]
+	fake.createApplicationDeploymentByRevisionArgsForCall = append(fake.createApplicationDeploymentByRevisionArgsForCall, struct {
+		appGUID      string
+		revisionGUID string
+	}{appGUID, revisionGUID})
+	fake.recordInvocation("CreateApplicationDeploymentByRevision", []interface{}{appGUID, revisionGUID})
+	fake.createApplicationDeploymentByRevisionMutex.Unlock()
+	if fake.CreateApplicationDeploymentByRevisionStub != nil {
+		return fake.CreateApplicationDeploymentByRevisionStub(appGUID, revisionGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentByRevisionReturns.result1, fake.createApplicationDeploymentByRevisionReturns.result2, fake.createApplicationDeploymentByRevisionReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCallCount() int {
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
+	return len(fake.createApplicationDeploymentByRevisionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
+	return fake.createApplicationDeploymentByRevisionArgsForCall[i].appGUID, fake.createApplicationDeploymentByRevisionArgsForCall[i].revisionGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentByRevisionStub = nil
+	fake.createApplicationDeploymentByRevisionReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentByRevisionStub = nil
+	if fake.createApplicationDeploymentByRevisionReturnsOnCall == nil {
+		fake.createApplicationDeploymentByRevisionReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentByRevisionReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error) {
 	fake.createApplicationTaskMutex.Lock()
 	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
@@ -1020,6 +1411,60 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationAutoscalingPolicy(appGUID string) (json.RawMessage, ccv3.Warnings, error) {
+	fake.getApplicationAutoscalingPolicyMutex.Lock()
+	ret, specificReturn := fake.getApplicationAutoscalingPolicyReturnsOnCall[len(fake.getApplicationAutoscalingPolicyArgsForCall)]
+	fake.getApplicationAutoscalingPolicyArgsForCall = append(fake.getApplicationAutoscalingPolicyArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationAutoscalingPolicy", []interface{}{appGUID})
+	fake.getApplicationAutoscalingPolicyMutex.Unlock()
+	if fake.GetApplicationAutoscalingPolicyStub != nil {
+		return fake.GetApplicationAutoscalingPolicyStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationAutoscalingPolicyReturns.result1, fake.getApplicationAutoscalingPolicyReturns.result2, fake.getApplicationAutoscalingPolicyReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationAutoscalingPolicyCallCount() int {
+	fake.getApplicationAutoscalingPolicyMutex.RLock()
+	defer fake.getApplicationAutoscalingPolicyMutex.RUnlock()
+	return len(fake.getApplicationAutoscalingPolicyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationAutoscalingPolicyArgsForCall(i int) string {
+	fake.getApplicationAutoscalingPolicyMutex.RLock()
+	defer fake.getApplicationAutoscalingPolicyMutex.RUnlock()
+	return fake.getApplicationAutoscalingPolicyArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationAutoscalingPolicyReturns(result1 json.RawMessage, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationAutoscalingPolicyStub = nil
+	fake.getApplicationAutoscalingPolicyReturns = struct {
+		result1 json.RawMessage
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationAutoscalingPolicyReturnsOnCall(i int, result1 json.RawMessage, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationAutoscalingPolicyStub = nil
+	if fake.getApplicationAutoscalingPolicyReturnsOnCall == nil {
+		fake.getApplicationAutoscalingPolicyReturnsOnCall = make(map[int]struct {
+			result1 json.RawMessage
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationAutoscalingPolicyReturnsOnCall[i] = struct {
+		result1 json.RawMessage
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationCurrentDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getApplicationCurrentDropletMutex.Lock()
 	ret, specificReturn := fake.getApplicationCurrentDropletReturnsOnCall[len(fake.getApplicationCurrentDropletArgsForCall)]
@@ -1128,6 +1573,61 @@ func (fake *FakeCloudControllerClient) GetApplicationProcessesReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationFeature(appGUID string, featureName string) (ccv3.ApplicationFeature, ccv3.Warnings, error) {
+	fake.getApplicationFeatureMutex.Lock()
+	ret, specificReturn := fake.getApplicationFeatureReturnsOnCall[len(fake.getApplicationFeatureArgsForCall)]
+	fake.getApplicationFeatureArgsForCall = append(fake.getApplicationFeatureArgsForCall, struct {
+		appGUID     string
+		featureName string
+	}{appGUID, featureName})
+	fake.recordInvocation("GetApplicationFeature", []interface{}{appGUID, featureName})
+	fake.getApplicationFeatureMutex.Unlock()
+	if fake.GetApplicationFeatureStub != nil {
+		return fake.GetApplicationFeatureStub(appGUID, featureName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationFeatureReturns.result1, fake.getApplicationFeatureReturns.result2, fake.getApplicationFeatureReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationFeatureCallCount() int {
+	fake.getApplicationFeatureMutex.RLock()
+	defer fake.getApplicationFeatureMutex.RUnlock()
+	return len(fake.getApplicationFeatureArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationFeatureArgsForCall(i int) (string, string) {
+	fake.getApplicationFeatureMutex.RLock()
+	defer fake.getApplicationFeatureMutex.RUnlock()
+	return fake.getApplicationFeatureArgsForCall[i].appGUID, fake.getApplicationFeatureArgsForCall[i].featureName
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationFeatureReturns(result1 ccv3.ApplicationFeature, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationFeatureStub = nil
+	fake.getApplicationFeatureReturns = struct {
+		result1 ccv3.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationFeatureReturnsOnCall(i int, result1 ccv3.ApplicationFeature, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationFeatureStub = nil
+	if fake.getApplicationFeatureReturnsOnCall == nil {
+		fake.getApplicationFeatureReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ApplicationFeature
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationFeatureReturnsOnCall[i] = struct {
+		result1 ccv3.ApplicationFeature
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationProcessByType(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error) {
 	fake.getApplicationProcessByTypeMutex.Lock()
 	ret, specificReturn := fake.getApplicationProcessByTypeReturnsOnCall[len(fake.getApplicationProcessByTypeArgsForCall)]
@@ -1290,10 +1790,65 @@ func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
-	fake.getApplicationTasksMutex.Lock()
-	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
-	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+func (fake *FakeCloudControllerClient) GetApplicationRevisions(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsReturnsOnCall[len(fake.getApplicationRevisionsArgsForCall)]
+	fake.getApplicationRevisionsArgsForCall = append(fake.getApplicationRevisionsArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationRevisions", []interface{}{appGUID, query})
+	fake.getApplicationRevisionsMutex.Unlock()
+	if fake.GetApplicationRevisionsStub != nil {
+		return fake.GetApplicationRevisionsStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRevisionsReturns.result1, fake.getApplicationRevisionsReturns.result2, fake.getApplicationRevisionsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCallCount() int {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return len(fake.getApplicationRevisionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return fake.getApplicationRevisionsArgsForCall[i].appGUID, fake.getApplicationRevisionsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturns(result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationRevisionsStub = nil
+	fake.getApplicationRevisionsReturns = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturnsOnCall(i int, result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationRevisionsStub = nil
+	if fake.getApplicationRevisionsReturnsOnCall == nil {
+		fake.getApplicationRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Revision
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionsReturnsOnCall[i] = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+	fake.getApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
+	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
 		appGUID string
 		query   url.Values
 	}{appGUID, query})
@@ -1399,6 +1954,168 @@ func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("GetDeployment", []interface{}{deploymentGUID})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDroplet(dropletGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getDropletMutex.Lock()
+	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
+	fake.getDropletArgsForCall = append(fake.getDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("GetDroplet", []interface{}{dropletGUID})
+	fake.getDropletMutex.Unlock()
+	if fake.GetDropletStub != nil {
+		return fake.GetDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDropletReturns.result1, fake.getDropletReturns.result2, fake.getDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDropletCallCount() int {
+	fake.getDropletMutex.RLock()
+	defer fake.getDropletMutex.RUnlock()
+	return len(fake.getDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDropletArgsForCall(i int) string {
+	fake.getDropletMutex.RLock()
+	defer fake.getDropletMutex.RUnlock()
+	return fake.getDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletStub = nil
+	fake.getDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDropletReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletStub = nil
+	if fake.getDropletReturnsOnCall == nil {
+		fake.getDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlag(flagName string) (ccv3.FeatureFlag, ccv3.Warnings, error) {
+	fake.getFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagReturnsOnCall[len(fake.getFeatureFlagArgsForCall)]
+	fake.getFeatureFlagArgsForCall = append(fake.getFeatureFlagArgsForCall, struct {
+		flagName string
+	}{flagName})
+	fake.recordInvocation("GetFeatureFlag", []interface{}{flagName})
+	fake.getFeatureFlagMutex.Unlock()
+	if fake.GetFeatureFlagStub != nil {
+		return fake.GetFeatureFlagStub(flagName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFeatureFlagReturns.result1, fake.getFeatureFlagReturns.result2, fake.getFeatureFlagReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagCallCount() int {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return len(fake.getFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagArgsForCall(i int) string {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return fake.getFeatureFlagArgsForCall[i].flagName
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagReturns(result1 ccv3.FeatureFlag, result2 ccv3.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	fake.getFeatureFlagReturns = struct {
+		result1 ccv3.FeatureFlag
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagReturnsOnCall(i int, result1 ccv3.FeatureFlag, result2 ccv3.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	if fake.getFeatureFlagReturnsOnCall == nil {
+		fake.getFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 ccv3.FeatureFlag
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagReturnsOnCall[i] = struct {
+		result1 ccv3.FeatureFlag
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.getIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
@@ -1723,6 +2440,60 @@ func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpaces(serviceInstanceGUID string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	fake.getServiceInstanceSharedSpacesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedSpacesReturnsOnCall[len(fake.getServiceInstanceSharedSpacesArgsForCall)]
+	fake.getServiceInstanceSharedSpacesArgsForCall = append(fake.getServiceInstanceSharedSpacesArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedSpaces", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedSpacesMutex.Unlock()
+	if fake.GetServiceInstanceSharedSpacesStub != nil {
+		return fake.GetServiceInstanceSharedSpacesStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedSpacesReturns.result1, fake.getServiceInstanceSharedSpacesReturns.result2, fake.getServiceInstanceSharedSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesCallCount() int {
+	fake.getServiceInstanceSharedSpacesMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesArgsForCall(i int) string {
+	fake.getServiceInstanceSharedSpacesMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesMutex.RUnlock()
+	return fake.getServiceInstanceSharedSpacesArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedSpacesStub = nil
+	fake.getServiceInstanceSharedSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedSpacesStub = nil
+	if fake.getServiceInstanceSharedSpacesReturnsOnCall == nil {
+		fake.getServiceInstanceSharedSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.getSpaceIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
@@ -1829,6 +2600,62 @@ func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationRet
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) ScaleApplicationProcess(appGUID string, processType string, scale ccv3.ProcessScale) (ccv3.Process, ccv3.Warnings, error) {
+	fake.scaleApplicationProcessMutex.Lock()
+	ret, specificReturn := fake.scaleApplicationProcessReturnsOnCall[len(fake.scaleApplicationProcessArgsForCall)]
+	fake.scaleApplicationProcessArgsForCall = append(fake.scaleApplicationProcessArgsForCall, struct {
+		appGUID     string
+		processType string
+		scale       ccv3.ProcessScale
+	}{appGUID, processType, scale})
+	fake.recordInvocation("ScaleApplicationProcess", []interface{}{appGUID, processType, scale})
+	fake.scaleApplicationProcessMutex.Unlock()
+	if fake.ScaleApplicationProcessStub != nil {
+		return fake.ScaleApplicationProcessStub(appGUID, processType, scale)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.scaleApplicationProcessReturns.result1, fake.scaleApplicationProcessReturns.result2, fake.scaleApplicationProcessReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ScaleApplicationProcessCallCount() int {
+	fake.scaleApplicationProcessMutex.RLock()
+	defer fake.scaleApplicationProcessMutex.RUnlock()
+	return len(fake.scaleApplicationProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ScaleApplicationProcessArgsForCall(i int) (string, string, ccv3.ProcessScale) {
+	fake.scaleApplicationProcessMutex.RLock()
+	defer fake.scaleApplicationProcessMutex.RUnlock()
+	return fake.scaleApplicationProcessArgsForCall[i].appGUID, fake.scaleApplicationProcessArgsForCall[i].processType, fake.scaleApplicationProcessArgsForCall[i].scale
+}
+
+func (fake *FakeCloudControllerClient) ScaleApplicationProcessReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.ScaleApplicationProcessStub = nil
+	fake.scaleApplicationProcessReturns = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ScaleApplicationProcessReturnsOnCall(i int, result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.ScaleApplicationProcessStub = nil
+	if fake.scaleApplicationProcessReturnsOnCall == nil {
+		fake.scaleApplicationProcessReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.scaleApplicationProcessReturnsOnCall[i] = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.setApplicationDropletMutex.Lock()
 	ret, specificReturn := fake.setApplicationDropletReturnsOnCall[len(fake.setApplicationDropletArgsForCall)]
@@ -1936,6 +2763,66 @@ func (fake *FakeCloudControllerClient) PatchOrganizationDefaultIsolationSegmentR
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	var spaceGUIDsCopy []string
+	if spaceGUIDs != nil {
+		spaceGUIDsCopy = make([]string, len(spaceGUIDs))
+		copy(spaceGUIDsCopy, spaceGUIDs)
+	}
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpacesReturnsOnCall[len(fake.shareServiceInstanceToSpacesArgsForCall)]
+	fake.shareServiceInstanceToSpacesArgsForCall = append(fake.shareServiceInstanceToSpacesArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.recordInvocation("ShareServiceInstanceToSpaces", []interface{}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.shareServiceInstanceToSpacesMutex.Unlock()
+	if fake.ShareServiceInstanceToSpacesStub != nil {
+		return fake.ShareServiceInstanceToSpacesStub(serviceInstanceGUID, spaceGUIDs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.shareServiceInstanceToSpacesReturns.result1, fake.shareServiceInstanceToSpacesReturns.result2, fake.shareServiceInstanceToSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesCallCount() int {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesArgsForCall(i int) (string, []string) {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return fake.shareServiceInstanceToSpacesArgsForCall[i].serviceInstanceGUID, fake.shareServiceInstanceToSpacesArgsForCall[i].spaceGUIDs
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	fake.shareServiceInstanceToSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	if fake.shareServiceInstanceToSpacesReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.shareServiceInstanceToSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) StartApplication(appGUID string) (ccv3.Application, ccv3.Warnings, error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -2041,6 +2928,58 @@ func (fake *FakeCloudControllerClient) StopApplicationReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error) {
+	fake.unshareServiceInstanceFromSpaceMutex.Lock()
+	ret, specificReturn := fake.unshareServiceInstanceFromSpaceReturnsOnCall[len(fake.unshareServiceInstanceFromSpaceArgsForCall)]
+	fake.unshareServiceInstanceFromSpaceArgsForCall = append(fake.unshareServiceInstanceFromSpaceArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}{serviceInstanceGUID, spaceGUID})
+	fake.recordInvocation("UnshareServiceInstanceFromSpace", []interface{}{serviceInstanceGUID, spaceGUID})
+	fake.unshareServiceInstanceFromSpaceMutex.Unlock()
+	if fake.UnshareServiceInstanceFromSpaceStub != nil {
+		return fake.UnshareServiceInstanceFromSpaceStub(serviceInstanceGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unshareServiceInstanceFromSpaceReturns.result1, fake.unshareServiceInstanceFromSpaceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceCallCount() int {
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	return len(fake.unshareServiceInstanceFromSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceArgsForCall(i int) (string, string) {
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	return fake.unshareServiceInstanceFromSpaceArgsForCall[i].serviceInstanceGUID, fake.unshareServiceInstanceFromSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceReturns(result1 ccv3.Warnings, result2 error) {
+	fake.UnshareServiceInstanceFromSpaceStub = nil
+	fake.unshareServiceInstanceFromSpaceReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.UnshareServiceInstanceFromSpaceStub = nil
+	if fake.unshareServiceInstanceFromSpaceReturnsOnCall == nil {
+		fake.unshareServiceInstanceFromSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.unshareServiceInstanceFromSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -2095,6 +3034,113 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateApplicationAutoscalingPolicy(appGUID string, policy json.RawMessage) (ccv3.Warnings, error) {
+	fake.updateApplicationAutoscalingPolicyMutex.Lock()
+	ret, specificReturn := fake.updateApplicationAutoscalingPolicyReturnsOnCall[len(fake.updateApplicationAutoscalingPolicyArgsForCall)]
+	fake.updateApplicationAutoscalingPolicyArgsForCall = append(fake.updateApplicationAutoscalingPolicyArgsForCall, struct {
+		appGUID string
+		policy  json.RawMessage
+	}{appGUID, policy})
+	fake.recordInvocation("UpdateApplicationAutoscalingPolicy", []interface{}{appGUID, policy})
+	fake.updateApplicationAutoscalingPolicyMutex.Unlock()
+	if fake.UpdateApplicationAutoscalingPolicyStub != nil {
+		return fake.UpdateApplicationAutoscalingPolicyStub(appGUID, policy)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateApplicationAutoscalingPolicyReturns.result1, fake.updateApplicationAutoscalingPolicyReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationAutoscalingPolicyCallCount() int {
+	fake.updateApplicationAutoscalingPolicyMutex.RLock()
+	defer fake.updateApplicationAutoscalingPolicyMutex.RUnlock()
+	return len(fake.updateApplicationAutoscalingPolicyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationAutoscalingPolicyArgsForCall(i int) (string, json.RawMessage) {
+	fake.updateApplicationAutoscalingPolicyMutex.RLock()
+	defer fake.updateApplicationAutoscalingPolicyMutex.RUnlock()
+	return fake.updateApplicationAutoscalingPolicyArgsForCall[i].appGUID, fake.updateApplicationAutoscalingPolicyArgsForCall[i].policy
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationAutoscalingPolicyReturns(result1 ccv3.Warnings, result2 error) {
+	fake.UpdateApplicationAutoscalingPolicyStub = nil
+	fake.updateApplicationAutoscalingPolicyReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationAutoscalingPolicyReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.UpdateApplicationAutoscalingPolicyStub = nil
+	if fake.updateApplicationAutoscalingPolicyReturnsOnCall == nil {
+		fake.updateApplicationAutoscalingPolicyReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationAutoscalingPolicyReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationLabels(appGUID string, labels map[string]*string) (ccv3.Application, ccv3.Warnings, error) {
+	fake.updateApplicationLabelsMutex.Lock()
+	ret, specificReturn := fake.updateApplicationLabelsReturnsOnCall[len(fake.updateApplicationLabelsArgsForCall)]
+	fake.updateApplicationLabelsArgsForCall = append(fake.updateApplicationLabelsArgsForCall, struct {
+		appGUID string
+		labels  map[string]*string
+	}{appGUID, labels})
+	fake.recordInvocation("UpdateApplicationLabels", []interface{}{appGUID, labels})
+	fake.updateApplicationLabelsMutex.Unlock()
+	if fake.UpdateApplicationLabelsStub != nil {
+		return fake.UpdateApplicationLabelsStub(appGUID, labels)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationLabelsReturns.result1, fake.updateApplicationLabelsReturns.result2, fake.updateApplicationLabelsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationLabelsCallCount() int {
+	fake.updateApplicationLabelsMutex.RLock()
+	defer fake.updateApplicationLabelsMutex.RUnlock()
+	return len(fake.updateApplicationLabelsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationLabelsArgsForCall(i int) (string, map[string]*string) {
+	fake.updateApplicationLabelsMutex.RLock()
+	defer fake.updateApplicationLabelsMutex.RUnlock()
+	return fake.updateApplicationLabelsArgsForCall[i].appGUID, fake.updateApplicationLabelsArgsForCall[i].labels
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationLabelsReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationLabelsStub = nil
+	fake.updateApplicationLabelsReturns = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationLabelsReturnsOnCall(i int, result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationLabelsStub = nil
+	if fake.updateApplicationLabelsReturnsOnCall == nil {
+		fake.updateApplicationLabelsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Application
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationLabelsReturnsOnCall[i] = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
@@ -2209,10 +3255,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.invocationsMutex.RUnlock()
 	fake.assignSpaceToIsolationSegmentMutex.RLock()
 	defer fake.assignSpaceToIsolationSegmentMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -2227,20 +3279,32 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getApplicationAutoscalingPolicyMutex.RLock()
+	defer fake.getApplicationAutoscalingPolicyMutex.RUnlock()
 	fake.getApplicationCurrentDropletMutex.RLock()
 	defer fake.getApplicationCurrentDropletMutex.RUnlock()
 	fake.getApplicationProcessesMutex.RLock()
 	defer fake.getApplicationProcessesMutex.RUnlock()
+	fake.getApplicationFeatureMutex.RLock()
+	defer fake.getApplicationFeatureMutex.RUnlock()
 	fake.getApplicationProcessByTypeMutex.RLock()
 	defer fake.getApplicationProcessByTypeMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getDropletMutex.RLock()
+	defer fake.getDropletMutex.RUnlock()
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
 	fake.getIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
@@ -2253,20 +3317,32 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
 	defer fake.getPackageMutex.RUnlock()
+	fake.getServiceInstanceSharedSpacesMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
 	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	fake.scaleApplicationProcessMutex.RLock()
+	defer fake.scaleApplicationProcessMutex.RUnlock()
 	fake.setApplicationDropletMutex.RLock()
 	defer fake.setApplicationDropletMutex.RUnlock()
 	fake.patchOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.patchOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
 	defer fake.stopApplicationMutex.RUnlock()
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateApplicationAutoscalingPolicyMutex.RLock()
+	defer fake.updateApplicationAutoscalingPolicyMutex.RUnlock()
+	fake.updateApplicationLabelsMutex.RLock()
+	defer fake.updateApplicationLabelsMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
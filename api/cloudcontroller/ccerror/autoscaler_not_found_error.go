@@ -0,0 +1,9 @@
+package ccerror
+
+// AutoscalerNotFoundError is returned when the Cloud Controller does not link
+// to an application autoscaler.
+type AutoscalerNotFoundError struct{}
+
+func (AutoscalerNotFoundError) Error() string {
+	return "No application autoscaler is linked from the Cloud Controller API"
+}
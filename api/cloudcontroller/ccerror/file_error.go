@@ -0,0 +1,11 @@
+package ccerror
+
+// FileError is returned when the files of an application instance cannot be
+// retrieved.
+type FileError struct {
+	Message string
+}
+
+func (e FileError) Error() string {
+	return e.Message
+}
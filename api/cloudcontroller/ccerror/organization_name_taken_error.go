@@ -0,0 +1,11 @@
+package ccerror
+
+// OrganizationNameTakenError is returned when creating a organization is rejected because
+// the name is already in use.
+type OrganizationNameTakenError struct {
+	Message string
+}
+
+func (e OrganizationNameTakenError) Error() string {
+	return e.Message
+}
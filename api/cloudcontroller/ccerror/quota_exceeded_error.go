@@ -0,0 +1,11 @@
+package ccerror
+
+// QuotaExceededError is returned when a request is rejected because it would
+// exceed an organization or space quota.
+type QuotaExceededError struct {
+	Message string
+}
+
+func (e QuotaExceededError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// RouteMappingTakenError is returned when mapping a route to an application
+// is rejected because the route is already mapped.
+type RouteMappingTakenError struct {
+	Message string
+}
+
+func (e RouteMappingTakenError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// SpaceNameTakenError is returned when creating a space is rejected because
+// the name is already in use.
+type SpaceNameTakenError struct {
+	Message string
+}
+
+func (e SpaceNameTakenError) Error() string {
+	return e.Message
+}
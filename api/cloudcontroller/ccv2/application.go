@@ -3,6 +3,7 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -48,6 +49,16 @@ type Application struct {
 	// DockerImage is the docker image location.
 	DockerImage string `json:"docker_image,omitempty"`
 
+	// EnableSSH is true when SSH access to the application's instances is
+	// allowed.
+	EnableSSH bool `json:"-"`
+
+	// EnvironmentVariables are the user provided environment variables. They
+	// are only sent when set and are never read from Cloud Controller
+	// responses, so updating a fetched application leaves its environment
+	// alone; use GetApplicationEnvironment to read them.
+	EnvironmentVariables map[string]interface{} `json:"environment_json,omitempty"`
+
 	// GUID is the unique application identifier.
 	GUID string `json:"guid,omitempty"`
 
@@ -93,6 +104,26 @@ type Application struct {
 	State ApplicationState `json:"state,omitempty"`
 }
 
+// MarshalJSON converts an application into a Cloud Controller Application.
+// EnvironmentVariables replaces all of the application's environment
+// variables, so it is only sent when the caller sets it; a non-nil but empty
+// map is sent as an empty object so that all of them can be removed.
+func (application Application) MarshalJSON() ([]byte, error) {
+	type alias Application
+	ccApp := struct {
+		alias
+		EnvironmentVariables *map[string]interface{} `json:"environment_json,omitempty"`
+	}{
+		alias: alias(application),
+	}
+
+	if application.EnvironmentVariables != nil {
+		ccApp.EnvironmentVariables = &application.EnvironmentVariables
+	}
+
+	return json.Marshal(ccApp)
+}
+
 // UnmarshalJSON helps unmarshal a Cloud Controller Application response.
 func (application *Application) UnmarshalJSON(data []byte) error {
 	var ccApp struct {
@@ -104,6 +135,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 			DetectedStartCommand     string     `json:"detected_start_command"`
 			DiskQuota                uint64     `json:"disk_quota"`
 			DockerImage              string     `json:"docker_image"`
+			EnableSSH                bool       `json:"enable_ssh"`
 			HealthCheckHTTPEndpoint  string     `json:"health_check_http_endpoint"`
 			HealthCheckTimeout       int        `json:"health_check_timeout"`
 			HealthCheckType          string     `json:"health_check_type"`
@@ -112,6 +144,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 			Name                     string     `json:"name"`
 			PackageState             string     `json:"package_state"`
 			PackageUpdatedAt         *time.Time `json:"package_updated_at"`
+			SpaceGUID                string     `json:"space_guid"`
 			StackGUID                string     `json:"stack_guid"`
 			StagingFailedDescription string     `json:"staging_failed_description"`
 			StagingFailedReason      string     `json:"staging_failed_reason"`
@@ -128,6 +161,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	application.DetectedStartCommand = ccApp.Entity.DetectedStartCommand
 	application.DiskQuota = ccApp.Entity.DiskQuota
 	application.DockerImage = ccApp.Entity.DockerImage
+	application.EnableSSH = ccApp.Entity.EnableSSH
 	application.GUID = ccApp.Metadata.GUID
 	application.HealthCheckHTTPEndpoint = ccApp.Entity.HealthCheckHTTPEndpoint
 	application.HealthCheckTimeout = ccApp.Entity.HealthCheckTimeout
@@ -136,6 +170,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	application.Memory = ccApp.Entity.Memory
 	application.Name = ccApp.Entity.Name
 	application.PackageState = ApplicationPackageState(ccApp.Entity.PackageState)
+	application.SpaceGUID = ccApp.Entity.SpaceGUID
 	application.StackGUID = ccApp.Entity.StackGUID
 	application.StagingFailedDescription = ccApp.Entity.StagingFailedDescription
 	application.StagingFailedReason = ccApp.Entity.StagingFailedReason
@@ -172,6 +207,26 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the application with the given GUID. Service
+// bindings and route mappings belonging to the application are removed with
+// it.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+		Query: url.Values{
+			"recursive": {"true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -266,6 +321,35 @@ func (client *Client) RestageApplication(app Application) (Application, Warnings
 	return restagedApp, response.Warnings, err
 }
 
+// CopyApplicationBits copies the bits of the source application to the
+// target application. The copy happens asynchronously; the returned job can
+// be polled for its completion.
+func (client *Client) CopyApplicationBits(sourceAppGUID string, targetAppGUID string) (Job, Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{
+		"source_app_guid": sourceAppGUID,
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostAppCopyBitsRequest,
+		URIParams:   Params{"app_guid": targetAppGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
+
 // GetRouteApplications returns a list of Applications associated with a route
 // GUID, filtered by provided queries.
 func (client *Client) GetRouteApplications(routeGUID string, queryParams []Query) ([]Application, Warnings, error) {
@@ -0,0 +1,45 @@
+package ccv2
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ApplicationEnvironment represents the environment variables available to
+// an application, grouped by where they come from.
+type ApplicationEnvironment struct {
+	// Application holds the VCAP_APPLICATION variable.
+	Application map[string]interface{} `json:"application_env_json"`
+
+	// Running holds the running environment variable group.
+	Running map[string]interface{} `json:"running_env_json"`
+
+	// Staging holds the staging environment variable group.
+	Staging map[string]interface{} `json:"staging_env_json"`
+
+	// System holds the VCAP_SERVICES variable.
+	System map[string]interface{} `json:"system_env_json"`
+
+	// UserProvided holds the variables set by the user.
+	UserProvided map[string]interface{} `json:"environment_json"`
+}
+
+// GetApplicationEnvironment returns the environment variables of the
+// application with the provided GUID.
+func (client *Client) GetApplicationEnvironment(appGUID string) (ApplicationEnvironment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppEnvRequest,
+		URIParams:   Params{"app_guid": appGUID},
+	})
+	if err != nil {
+		return ApplicationEnvironment{}, nil, err
+	}
+
+	var env ApplicationEnvironment
+	response := cloudcontroller.Response{
+		Result: &env,
+	}
+
+	err = client.connection.Make(request, &response)
+	return env, response.Warnings, err
+}
@@ -0,0 +1,83 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Application Environment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		Context("when the app is found", func() {
+			BeforeEach(func() {
+				response := `{
+					"staging_env_json": {"STAGING_VAR": "staging-value"},
+					"running_env_json": {"RUNNING_VAR": "running-value"},
+					"environment_json": {"USER_VAR": "user-value"},
+					"system_env_json": {
+						"VCAP_SERVICES": {
+							"some-service": [{"name": "some-instance", "credentials": {"password": "secret"}}]
+						}
+					},
+					"application_env_json": {
+						"VCAP_APPLICATION": {"application_name": "some-app"}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/env"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns each group of environment variables and warnings", func() {
+				env, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(env.Staging).To(Equal(map[string]interface{}{"STAGING_VAR": "staging-value"}))
+				Expect(env.Running).To(Equal(map[string]interface{}{"RUNNING_VAR": "running-value"}))
+				Expect(env.UserProvided).To(Equal(map[string]interface{}{"USER_VAR": "user-value"}))
+				Expect(env.System).To(HaveKey("VCAP_SERVICES"))
+				Expect(env.Application).To(Equal(map[string]interface{}{
+					"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+				}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/env"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
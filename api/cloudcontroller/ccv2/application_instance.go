@@ -90,3 +90,20 @@ func (client *Client) GetApplicationInstancesByApplication(guid string) (map[int
 
 	return returnedInstances, response.Warnings, err
 }
+
+// DeleteApplicationInstance stops the application instance at the given
+// index. The Cloud Controller will start a replacement instance to maintain
+// the application's instance count.
+func (client *Client) DeleteApplicationInstance(appGUID string, index int) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppInstanceRequest,
+		URIParams:   Params{"app_guid": appGUID, "index": strconv.Itoa(index)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -0,0 +1,30 @@
+package ccv2
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// GetApplicationInstanceFiles returns the raw response for the provided path
+// on the application instance. For a directory this is a plain text listing
+// of its contents; for a file it is the file's contents.
+func (client *Client) GetApplicationInstanceFiles(appGUID string, index int, path string) ([]byte, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppInstanceFilesRequest,
+		URIParams: Params{
+			"app_guid": appGUID,
+			"index":    strconv.Itoa(index),
+			"path":     strings.TrimPrefix(path, "/"),
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.RawResponse, response.Warnings, err
+}
@@ -0,0 +1,63 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Application Instance File", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationInstanceFiles", func() {
+		Context("when the path exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/instances/1/files/app/some-file"),
+						RespondWith(http.StatusOK, "some file contents", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the raw response and warnings", func() {
+				contents, warnings, err := client.GetApplicationInstanceFiles("some-app-guid", 1, "/app/some-file")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some file contents"))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 190001,
+					"description": "File error: Request failed for app: some-app as the instance is not found.",
+					"error_code": "CF-FileError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/instances/0/files/"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetApplicationInstanceFiles("some-app-guid", 0, "")
+				Expect(err).To(MatchError(ccerror.FileError{
+					Message: "File error: Request failed for app: some-app as the instance is not found.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -90,4 +90,46 @@ var _ = Describe("Application Instance", func() {
 			})
 		})
 	})
+	Describe("DeleteApplicationInstance", func() {
+		Context("when the instance is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid/instances/2"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the instance at the given index and returns warnings", func() {
+				warnings, err := client.DeleteApplicationInstance("some-app-guid", 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid/instances/0"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.DeleteApplicationInstance("some-app-guid", 0)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
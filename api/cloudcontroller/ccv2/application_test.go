@@ -53,8 +53,9 @@ var _ = Describe("Application", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(app).To(Equal(Application{
-						GUID: "some-app-guid",
-						Name: "some-app-name",
+						GUID:      "some-app-guid",
+						Name:      "some-app-name",
+						SpaceGUID: "some-space-guid",
 					}))
 					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				})
@@ -86,6 +87,49 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid", "recursive=true"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the application and returns all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid", "recursive=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		BeforeEach(func() {
 			response := `{
@@ -100,6 +144,7 @@ var _ = Describe("Application", func() {
 							"disk_quota": 586,
 							"detected_buildpack": null,
 							"docker_image": "some-docker-path",
+							"enable_ssh": true,
 							"health_check_timeout": 120,
 							"health_check_type": "port",
 							"health_check_http_endpoint": "/",
@@ -137,6 +182,7 @@ var _ = Describe("Application", func() {
 					DetectedStartCommand:     "echo 'I am a banana'",
 					DiskQuota:                586,
 					DockerImage:              "some-docker-path",
+					EnableSSH:                true,
 					GUID:                     "app-guid-1",
 					HealthCheckTimeout:       120,
 					HealthCheckType:          "port",
@@ -421,6 +467,132 @@ var _ = Describe("Application", func() {
 					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				})
 			})
+
+			Context("when removing all environment variables", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"environment_json":{}}`)),
+							RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}, "entity": {}}`, nil),
+						),
+					)
+				})
+
+				It("sends an empty environment", func() {
+					_, _, err := client.UpdateApplication(Application{
+						GUID:                 "some-app-guid",
+						EnvironmentVariables: map[string]interface{}{},
+					})
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when renaming the application", func() {
+				BeforeEach(func() {
+					response1 := `{
+				"metadata": {
+					"guid": "some-app-guid"
+				},
+				"entity": {
+					"name": "new-app-name",
+					"space_guid": "some-space-guid"
+				}
+			}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"name":"new-app-name"}`)),
+							RespondWith(http.StatusCreated, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends only the name", func() {
+					app, warnings, err := client.UpdateApplication(Application{
+						GUID: "some-app-guid",
+						Name: "new-app-name",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(app).To(Equal(Application{
+						GUID:      "some-app-guid",
+						Name:      "new-app-name",
+						SpaceGUID: "some-space-guid",
+					}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+
+			Context("when updating an application that was fetched", func() {
+				BeforeEach(func() {
+					response := `{
+				"metadata": {
+					"guid": "some-app-guid"
+				},
+				"entity": {
+					"name": "some-app",
+					"environment_json": {
+						"PORT": 8080
+					}
+				}
+			}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid"),
+							RespondWith(http.StatusOK, response, nil),
+						),
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"name":"some-app"}`)),
+							RespondWith(http.StatusCreated, response, nil),
+						),
+					)
+				})
+
+				It("does not send the application's environment variables", func() {
+					app, _, err := client.GetApplication("some-app-guid")
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, err = client.UpdateApplication(app)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when updating environment variables", func() {
+				BeforeEach(func() {
+					response1 := `{
+				"metadata": {
+					"guid": "some-app-guid"
+				},
+				"entity": {
+					"environment_json": {
+						"FOO": "bar",
+						"PORT": 8080,
+						"ENABLED": true
+					}
+				}
+			}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"environment_json":{"FOO":"bar","PORT":8080}}`)),
+							RespondWith(http.StatusCreated, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends the environment variables as is without reading them back", func() {
+					app, warnings, err := client.UpdateApplication(Application{
+						GUID:                 "some-app-guid",
+						EnvironmentVariables: map[string]interface{}{"FOO": "bar", "PORT": 8080},
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(app.EnvironmentVariables).To(BeNil())
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
 		})
 
 		Context("when the update returns an error", func() {
@@ -546,6 +718,64 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("CopyApplicationBits", func() {
+		Context("when the copy is successfully queued", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-job-guid"
+					},
+					"entity": {
+						"guid": "some-job-guid",
+						"status": "queued"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps/target-app-guid/copy_bits"),
+						VerifyJSON(`{"source_app_guid":"source-app-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the job and warnings", func() {
+				job, warnings, err := client.CopyApplicationBits("source-app-guid", "target-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job).To(Equal(Job{
+					GUID:   "some-job-guid",
+					Status: JobStatusQueued,
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the copy returns an error", func() {
+			BeforeEach(func() {
+				response := `
+{
+  "code": 100004,
+  "description": "The app could not be found: target-app-guid",
+  "error_code": "CF-AppNotFound"
+}
+			`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps/target-app-guid/copy_bits"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CopyApplicationBits("source-app-guid", "target-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found: target-app-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetRouteApplications", func() {
 		Context("when the route guid is not found", func() {
 			BeforeEach(func() {
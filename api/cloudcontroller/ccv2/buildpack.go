@@ -0,0 +1,110 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Buildpack represents a Cloud Controller Buildpack.
+type Buildpack struct {
+	// GUID is the unique buildpack identifier.
+	GUID string `json:"-"`
+
+	// Name is the name of the buildpack.
+	Name string `json:"name,omitempty"`
+
+	// Position is the order in which the buildpack is checked during
+	// buildpack auto-detection.
+	Position int `json:"position,omitempty"`
+
+	// Enabled is true when the buildpack can be used for staging.
+	Enabled bool `json:"-"`
+
+	// Locked is true when the buildpack cannot be updated.
+	Locked bool `json:"-"`
+
+	// Filename is the name of the uploaded buildpack file.
+	Filename string `json:"-"`
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name     string `json:"name"`
+			Position int    `json:"position"`
+			Enabled  bool   `json:"enabled"`
+			Locked   bool   `json:"locked"`
+			Filename string `json:"filename"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccBuildpack); err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	buildpack.Position = ccBuildpack.Entity.Position
+	buildpack.Enabled = ccBuildpack.Entity.Enabled
+	buildpack.Locked = ccBuildpack.Entity.Locked
+	buildpack.Filename = ccBuildpack.Entity.Filename
+	return nil
+}
+
+// GetBuildpacks returns a list of Buildpacks based off of the provided
+// queries.
+func (client *Client) GetBuildpacks(queries []Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
+
+// UpdateBuildpack updates the buildpack with the provided GUID. Only the name
+// and position are sent; fields left empty are not updated.
+func (client *Client) UpdateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	body, err := json.Marshal(buildpack)
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutBuildpackRequest,
+		URIParams:   Params{"buildpack_guid": buildpack.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	var updatedBuildpack Buildpack
+	response := cloudcontroller.Response{
+		Result: &updatedBuildpack,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedBuildpack, response.Warnings, err
+}
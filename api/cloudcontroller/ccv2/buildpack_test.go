@@ -0,0 +1,193 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when no errors are encountered", func() {
+			Context("when results are paginated", func() {
+				BeforeEach(func() {
+					response1 := `{
+						"next_url": "/v2/buildpacks?page=2",
+						"resources": [
+							{
+								"metadata": {
+									"guid": "some-buildpack-guid-1"
+								},
+								"entity": {
+									"name": "some-buildpack-1",
+									"position": 1,
+									"enabled": true,
+									"locked": false,
+									"filename": "some-buildpack-1.zip"
+								}
+							}
+						]
+					}`
+					response2 := `{
+						"next_url": null,
+						"resources": [
+							{
+								"metadata": {
+									"guid": "some-buildpack-guid-2"
+								},
+								"entity": {
+									"name": "some-buildpack-2",
+									"position": 2,
+									"enabled": false,
+									"locked": true,
+									"filename": "some-buildpack-2.zip"
+								}
+							}
+						]
+					}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+							RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+						))
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/buildpacks", "page=2"),
+							RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+						))
+				})
+
+				It("returns paginated results and all warnings", func() {
+					buildpacks, warnings, err := client.GetBuildpacks(nil)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+					Expect(buildpacks).To(Equal([]Buildpack{
+						{
+							GUID:     "some-buildpack-guid-1",
+							Name:     "some-buildpack-1",
+							Position: 1,
+							Enabled:  true,
+							Locked:   false,
+							Filename: "some-buildpack-1.zip",
+						},
+						{
+							GUID:     "some-buildpack-guid-2",
+							Name:     "some-buildpack-2",
+							Position: 2,
+							Enabled:  false,
+							Locked:   true,
+							Filename: "some-buildpack-2.zip",
+						},
+					}))
+				})
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetBuildpacks(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateBuildpack", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-buildpack-guid"
+					},
+					"entity": {
+						"name": "some-buildpack",
+						"position": 3,
+						"enabled": true,
+						"locked": false,
+						"filename": "some-buildpack.zip"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid"),
+						VerifyJSON(`{"position":3}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends only the updated fields and returns the updated buildpack and warnings", func() {
+				buildpack, warnings, err := client.UpdateBuildpack(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Position: 3,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildpack).To(Equal(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Name:     "some-buildpack",
+					Position: 3,
+					Enabled:  true,
+					Filename: "some-buildpack.zip",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the buildpack is not found", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateBuildpack(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Position: 3,
+				})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Unknown request"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
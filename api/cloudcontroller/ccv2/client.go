@@ -83,6 +83,9 @@ type Warnings []string
 // Client is a client that can be used to talk to a Cloud Controller's V2
 // Endpoints.
 type Client struct {
+	appSSHEndpoint            string
+	appSSHHostKeyFingerprint  string
+	appSSHOAuthClient         string
 	authorizationEndpoint     string
 	cloudControllerAPIVersion string
 	cloudControllerURL        string
@@ -93,6 +96,7 @@ type Client struct {
 
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
+	pageConcurrency    int
 
 	connection cloudcontroller.Connection
 	router     *rata.RequestGenerator
@@ -114,6 +118,11 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PageConcurrency is the maximum number of pages of a list request that
+	// are fetched at the same time. Defaults to DefaultPageConcurrency; set to
+	// 1 to fetch pages one at a time.
+	PageConcurrency int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -121,10 +130,17 @@ type Config struct {
 // NewClient returns a new Cloud Controller Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	pageConcurrency := config.PageConcurrency
+	if pageConcurrency <= 0 {
+		pageConcurrency = DefaultPageConcurrency
+	}
+
 	return &Client{
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
+		pageConcurrency:    pageConcurrency,
 		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
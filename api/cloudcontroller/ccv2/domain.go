@@ -8,10 +8,23 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
+// RouterGroupTypeTCP is the router group type of domains that support TCP
+// routes.
+const RouterGroupTypeTCP = "tcp"
+
 // Domain represents a Cloud Controller Domain.
 type Domain struct {
 	GUID string
 	Name string
+
+	// RouterGroupGUID and RouterGroupType are only set for shared domains that
+	// belong to a router group, such as TCP domains.
+	RouterGroupGUID string
+	RouterGroupType string
+
+	// Shared is true for domains returned by the shared domains endpoints and
+	// false for private domains.
+	Shared bool
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Domain response.
@@ -19,7 +32,9 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 	var ccDomain struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name            string `json:"name"`
+			RouterGroupGUID string `json:"router_group_guid"`
+			RouterGroupType string `json:"router_group_type"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccDomain); err != nil {
@@ -28,6 +43,8 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 
 	domain.GUID = ccDomain.Metadata.GUID
 	domain.Name = ccDomain.Entity.Name
+	domain.RouterGroupGUID = ccDomain.Entity.RouterGroupGUID
+	domain.RouterGroupType = ccDomain.Entity.RouterGroupType
 	return nil
 }
 
@@ -52,6 +69,7 @@ func (client *Client) GetSharedDomain(domainGUID string) (Domain, Warnings, erro
 		return Domain{}, response.Warnings, err
 	}
 
+	domain.Shared = true
 	return domain, response.Warnings, nil
 }
 
@@ -91,6 +109,7 @@ func (client *Client) GetSharedDomains() ([]Domain, Warnings, error) {
 	fullDomainsList := []Domain{}
 	warnings, err := client.paginate(request, Domain{}, func(item interface{}) error {
 		if domain, ok := item.(Domain); ok {
+			domain.Shared = true
 			fullDomainsList = append(fullDomainsList, domain)
 		} else {
 			return ccerror.UnknownObjectInListError{
@@ -26,7 +26,9 @@ var _ = Describe("Domain", func() {
 							"updated_at": null
 						},
 						"entity": {
-							"name": "shared-domain-1.com"
+							"name": "shared-domain-1.com",
+							"router_group_guid": "some-router-group-guid",
+							"router_group_type": "tcp"
 						}
 				}`
 				server.AppendHandlers(
@@ -40,7 +42,13 @@ var _ = Describe("Domain", func() {
 			It("returns the shared domain and all warnings", func() {
 				domain, warnings, err := client.GetSharedDomain("shared-domain-guid")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(domain).To(Equal(Domain{Name: "shared-domain-1.com", GUID: "shared-domain-guid"}))
+				Expect(domain).To(Equal(Domain{
+					Name:            "shared-domain-1.com",
+					GUID:            "shared-domain-guid",
+					RouterGroupGUID: "some-router-group-guid",
+					RouterGroupType: RouterGroupTypeTCP,
+					Shared:          true,
+				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
 		})
@@ -187,20 +195,24 @@ var _ = Describe("Domain", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(domains).To(Equal([]Domain{
 					{
-						GUID: "domain-guid-1",
-						Name: "domain-name-1",
+						GUID:   "domain-guid-1",
+						Name:   "domain-name-1",
+						Shared: true,
 					},
 					{
-						GUID: "domain-guid-2",
-						Name: "domain-name-2",
+						GUID:   "domain-guid-2",
+						Name:   "domain-name-2",
+						Shared: true,
 					},
 					{
-						GUID: "domain-guid-3",
-						Name: "domain-name-3",
+						GUID:   "domain-guid-3",
+						Name:   "domain-name-3",
+						Shared: true,
 					},
 					{
-						GUID: "domain-guid-4",
-						Name: "domain-name-4",
+						GUID:   "domain-guid-4",
+						Name:   "domain-name-4",
+						Shared: true,
 					},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
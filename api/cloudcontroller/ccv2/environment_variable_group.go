@@ -0,0 +1,101 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// EnvironmentVariableGroupName is the name of a platform-wide environment
+// variable group.
+type EnvironmentVariableGroupName string
+
+const (
+	// RunningEnvironmentVariableGroup holds the environment variables given to
+	// every running application instance.
+	RunningEnvironmentVariableGroup EnvironmentVariableGroupName = "running"
+
+	// StagingEnvironmentVariableGroup holds the environment variables given to
+	// every staging task.
+	StagingEnvironmentVariableGroup EnvironmentVariableGroupName = "staging"
+)
+
+// EnvironmentVariableGroup represents the environment variables of a Cloud
+// Controller Environment Variable Group.
+type EnvironmentVariableGroup map[string]string
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Environment Variable Group
+// response.
+func (group *EnvironmentVariableGroup) UnmarshalJSON(data []byte) error {
+	var ccGroup map[string]interface{}
+	if err := json.Unmarshal(data, &ccGroup); err != nil {
+		return err
+	}
+
+	*group = EnvironmentVariableGroup{}
+	for name, value := range ccGroup {
+		// Non-string values are kept in their JSON representation.
+		if str, ok := value.(string); ok {
+			(*group)[name] = str
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		(*group)[name] = string(raw)
+	}
+	return nil
+}
+
+// GetEnvironmentVariableGroup returns the environment variables of the
+// provided group.
+func (client *Client) GetEnvironmentVariableGroup(group EnvironmentVariableGroupName) (EnvironmentVariableGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEnvironmentVariableGroupRequest,
+		URIParams:   Params{"group": string(group)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var envVars EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		Result: &envVars,
+	}
+
+	err = client.connection.Make(request, &response)
+	return envVars, response.Warnings, err
+}
+
+// UpdateEnvironmentVariableGroup replaces all the environment variables of
+// the provided group with envVars.
+func (client *Client) UpdateEnvironmentVariableGroup(group EnvironmentVariableGroupName, envVars EnvironmentVariableGroup) (EnvironmentVariableGroup, Warnings, error) {
+	if envVars == nil {
+		envVars = EnvironmentVariableGroup{}
+	}
+
+	body, err := json.Marshal(map[string]string(envVars))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutEnvironmentVariableGroupRequest,
+		URIParams:   Params{"group": string(group)},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedEnvVars EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		Result: &updatedEnvVars,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedEnvVars, response.Warnings, err
+}
@@ -0,0 +1,108 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment Variable Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/running"),
+						RespondWith(http.StatusOK, `{"FOO": "foo", "PORT": 8080}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the group's environment variables and warnings", func() {
+				envVars, warnings, err := client.GetEnvironmentVariableGroup(RunningEnvironmentVariableGroup)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(envVars).To(Equal(EnvironmentVariableGroup{
+					"FOO":  "foo",
+					"PORT": "8080",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/staging"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetEnvironmentVariableGroup(StagingEnvironmentVariableGroup)
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateEnvironmentVariableGroup", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/staging"),
+						VerifyJSON(`{"FOO": "foo", "BAR": "bar"}`),
+						RespondWith(http.StatusOK, `{"FOO": "foo", "BAR": "bar"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("replaces the group's environment variables and returns warnings", func() {
+				envVars, warnings, err := client.UpdateEnvironmentVariableGroup(StagingEnvironmentVariableGroup, EnvironmentVariableGroup{
+					"FOO": "foo",
+					"BAR": "bar",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(envVars).To(Equal(EnvironmentVariableGroup{"FOO": "foo", "BAR": "bar"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when clearing the group", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/running"),
+						VerifyJSON(`{}`),
+						RespondWith(http.StatusOK, `{}`, nil),
+					),
+				)
+			})
+
+			It("sends an empty object", func() {
+				envVars, _, err := client.UpdateEnvironmentVariableGroup(RunningEnvironmentVariableGroup, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(envVars).To(BeEmpty())
+			})
+		})
+	})
+})
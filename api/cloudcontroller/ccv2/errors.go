@@ -70,16 +70,33 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 
 func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 	switch errorResponse.ErrorCode {
+	case "CF-AppNameTaken":
+		return ccerror.NameNotUniqueInSpaceError{}
+	case "CF-AppMemoryQuotaExceeded",
+		"CF-QuotaInstanceLimitExceeded",
+		"CF-QuotaInstanceMemoryLimitExceeded",
+		"CF-SpaceQuotaInstanceLimitExceeded",
+		"CF-SpaceQuotaInstanceMemoryLimitExceeded",
+		"CF-SpaceQuotaMemoryLimitExceeded":
+		return ccerror.QuotaExceededError{Message: errorResponse.Description}
 	case "CF-AppStoppedStatsError":
 		return ccerror.ApplicationStoppedStatsError{Message: errorResponse.Description}
+	case "CF-FileError":
+		return ccerror.FileError{Message: errorResponse.Description}
 	case "CF-InstancesError":
 		return ccerror.InstancesError{Message: errorResponse.Description}
 	case "CF-InvalidRelation":
 		return ccerror.InvalidRelationError{Message: errorResponse.Description}
 	case "CF-NotStaged":
 		return ccerror.NotStagedError{Message: errorResponse.Description}
+	case "CF-OrganizationNameTaken":
+		return ccerror.OrganizationNameTakenError{Message: errorResponse.Description}
+	case "CF-RouteMappingTaken":
+		return ccerror.RouteMappingTakenError{Message: errorResponse.Description}
 	case "CF-ServiceBindingAppServiceTaken":
 		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
+	case "CF-SpaceNameTaken":
+		return ccerror.SpaceNameTakenError{Message: errorResponse.Description}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Description}
 	}
@@ -118,6 +118,23 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when the instance files cannot be retrieved", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 190001,
+							"description": "File error: Request failed for app: some-app as the instance is not found.",
+							"error_code": "CF-FileError"
+						}`
+					})
+
+					It("returns a FileError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.FileError{
+							Message: "File error: Request failed for app: some-app as the instance is not found.",
+						}))
+					})
+				})
+
 				Context("when creating a relation that is invalid", func() {
 					BeforeEach(func() {
 						response = `{
@@ -135,6 +152,72 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when the organization name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 30002,
+							"description": "The organization name is taken: some-org",
+							"error_code": "CF-OrganizationNameTaken"
+						}`
+					})
+
+					It("returns an OrganizationNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.OrganizationNameTakenError{
+							Message: "The organization name is taken: some-org",
+						}))
+					})
+				})
+
+				Context("when the space name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 40002,
+							"description": "The app space name is taken: some-space",
+							"error_code": "CF-SpaceNameTaken"
+						}`
+					})
+
+					It("returns a SpaceNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.SpaceNameTakenError{
+							Message: "The app space name is taken: some-space",
+						}))
+					})
+				})
+
+				Context("when the app name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 100002,
+							"description": "The app name is taken: some-app",
+							"error_code": "CF-AppNameTaken"
+						}`
+					})
+
+					It("returns a NameNotUniqueInSpaceError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.NameNotUniqueInSpaceError{}))
+					})
+				})
+
+				Context("when a quota is exceeded", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 100005,
+							"description": "You have exceeded your organization's memory limit: app requested more memory than available",
+							"error_code": "CF-AppMemoryQuotaExceeded"
+						}`
+					})
+
+					It("returns a QuotaExceededError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.QuotaExceededError{
+							Message: "You have exceeded your organization's memory limit: app requested more memory than available",
+						}))
+					})
+				})
+
 				Context("getting stats for a stopped app", func() {
 					BeforeEach(func() {
 						response = `{
@@ -0,0 +1,127 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// maxEventsPerPage is the largest page size the Cloud Controller accepts.
+const maxEventsPerPage = 100
+
+// EventType is the type of a Cloud Controller Event.
+type EventType string
+
+const (
+	// EventTypeApplicationCrash is recorded when an application instance
+	// crashes.
+	EventTypeApplicationCrash EventType = "app.crash"
+	// EventTypeApplicationUpdate is recorded when an application is updated.
+	EventTypeApplicationUpdate EventType = "audit.app.update"
+	// EventTypeApplicationDeleteRequest is recorded when an application is
+	// deleted.
+	EventTypeApplicationDeleteRequest EventType = "audit.app.delete-request"
+)
+
+// Event represents a Cloud Controller Event.
+type Event struct {
+	GUID      string
+	Type      EventType
+	ActorName string
+	ActeeGUID string
+	Timestamp time.Time
+	Metadata  map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type      string                 `json:"type"`
+			ActorName string                 `json:"actor_name"`
+			Actee     string                 `json:"actee"`
+			Timestamp time.Time              `json:"timestamp"`
+			Metadata  map[string]interface{} `json:"metadata"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = EventType(ccEvent.Entity.Type)
+	event.ActorName = ccEvent.Entity.ActorName
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.Timestamp = ccEvent.Entity.Timestamp
+	event.Metadata = ccEvent.Entity.Metadata
+	return nil
+}
+
+// GetEvents returns up to limit Events matching the provided queries, newest
+// first. limit must be positive; only as many pages as are needed to reach it
+// are fetched.
+func (client *Client) GetEvents(limit int, queries []Query) ([]Event, Warnings, error) {
+	query := FormatQueryParameters(queries)
+	query.Set("order-direction", "desc")
+	perPage := limit
+	if perPage > maxEventsPerPage {
+		perPage = maxEventsPerPage
+	}
+	query.Set("results-per-page", strconv.Itoa(perPage))
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		events      []Event
+		allWarnings Warnings
+	)
+	for {
+		wrapper, warnings, err := client.getPage(request, Event{})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		resources, err := wrapper.Resources()
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, item := range resources {
+			event, ok := item.(Event)
+			if !ok {
+				return nil, allWarnings, ccerror.UnknownObjectInListError{
+					Expected:   Event{},
+					Unexpected: item,
+				}
+			}
+			events = append(events, event)
+			if len(events) == limit {
+				return events, allWarnings, nil
+			}
+		}
+
+		if wrapper.NextURL == "" {
+			return events, allWarnings, nil
+		}
+
+		request, err = client.newHTTPRequest(requestOptions{
+			URI:    wrapper.NextURL,
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEvents", func() {
+		var queries []Query
+
+		BeforeEach(func() {
+			queries = []Query{{
+				Filter:   ActeeFilter,
+				Operator: EqualOperator,
+				Value:    "some-app-guid",
+			}}
+		})
+
+		Context("when the events exist", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/events?order-direction=desc&page=2&q=actee:some-app-guid&results-per-page=3",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-1"
+							},
+							"entity": {
+								"type": "app.crash",
+								"actor_name": "some-app",
+								"actee": "some-app-guid",
+								"timestamp": "2017-08-02T10:00:00Z",
+								"metadata": {
+									"index": 1,
+									"exit_description": "out of memory"
+								}
+							}
+						},
+						{
+							"metadata": {
+								"guid": "event-guid-2"
+							},
+							"entity": {
+								"type": "audit.app.update",
+								"actor_name": "admin",
+								"actee": "some-app-guid",
+								"timestamp": "2017-08-02T09:00:00Z"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": "/v2/events?order-direction=desc&page=3&q=actee:some-app-guid&results-per-page=3",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-3"
+							},
+							"entity": {
+								"type": "audit.app.delete-request",
+								"actor_name": "admin",
+								"actee": "some-app-guid",
+								"timestamp": "2017-08-02T08:00:00Z"
+							}
+						},
+						{
+							"metadata": {
+								"guid": "event-guid-4"
+							},
+							"entity": {
+								"type": "audit.app.update",
+								"actor_name": "admin",
+								"actee": "some-app-guid",
+								"timestamp": "2017-08-02T07:00:00Z"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "order-direction=desc&q=actee:some-app-guid&results-per-page=3"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "order-direction=desc&page=2&q=actee:some-app-guid&results-per-page=3"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns up to limit events, newest first, without fetching more pages", func() {
+				events, warnings, err := client.GetEvents(3, queries)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:      "event-guid-1",
+						Type:      EventTypeApplicationCrash,
+						ActorName: "some-app",
+						ActeeGUID: "some-app-guid",
+						Timestamp: time.Date(2017, 8, 2, 10, 0, 0, 0, time.UTC),
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_description": "out of memory",
+						},
+					},
+					{
+						GUID:      "event-guid-2",
+						Type:      EventTypeApplicationUpdate,
+						ActorName: "admin",
+						ActeeGUID: "some-app-guid",
+						Timestamp: time.Date(2017, 8, 2, 9, 0, 0, 0, time.UTC),
+					},
+					{
+						GUID:      "event-guid-3",
+						Type:      EventTypeApplicationDeleteRequest,
+						ActorName: "admin",
+						ActeeGUID: "some-app-guid",
+						Timestamp: time.Date(2017, 8, 2, 8, 0, 0, 0, time.UTC),
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetEvents(10, queries)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
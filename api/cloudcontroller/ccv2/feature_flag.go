@@ -0,0 +1,69 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// FeatureFlag represents a Cloud Controller Feature Flag.
+type FeatureFlag struct {
+	// Name is the name of the feature flag.
+	Name string `json:"name"`
+
+	// Enabled is true when the feature is turned on.
+	Enabled bool `json:"enabled"`
+
+	// ErrorMessage is the custom message returned when a user attempts to use
+	// the feature while it is disabled.
+	ErrorMessage string `json:"error_message"`
+}
+
+// GetFeatureFlags returns all the Feature Flags.
+func (client *Client) GetFeatureFlags() ([]FeatureFlag, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetFeatureFlagsRequest,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var featureFlags []FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlags,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlags, response.Warnings, err
+}
+
+// UpdateFeatureFlag turns the Feature Flag with the provided name on or off.
+func (client *Client) UpdateFeatureFlag(name string, enabled bool) (FeatureFlag, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Enabled bool `json:"enabled"`
+	}{
+		Enabled: enabled,
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutFeatureFlagRequest,
+		URIParams:   Params{"name": name},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	var featureFlag FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlag,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlag, response.Warnings, err
+}
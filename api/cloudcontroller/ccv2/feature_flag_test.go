@@ -0,0 +1,132 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Feature Flag", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetFeatureFlags", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				response := `[
+					{
+						"name": "user_org_creation",
+						"enabled": false,
+						"error_message": null,
+						"url": "/v2/config/feature_flags/user_org_creation"
+					},
+					{
+						"name": "app_bits_upload",
+						"enabled": true,
+						"error_message": "app bits upload is disabled",
+						"url": "/v2/config/feature_flags/app_bits_upload"
+					}
+				]`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the feature flags and warnings", func() {
+				featureFlags, warnings, err := client.GetFeatureFlags()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(featureFlags).To(Equal([]FeatureFlag{
+					{Name: "user_org_creation", Enabled: false},
+					{Name: "app_bits_upload", Enabled: true, ErrorMessage: "app bits upload is disabled"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetFeatureFlags()
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateFeatureFlag", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"name": "user_org_creation",
+					"enabled": true,
+					"error_message": null,
+					"url": "/v2/config/feature_flags/user_org_creation"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/user_org_creation"),
+						VerifyJSON(`{"enabled": true}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated feature flag and warnings", func() {
+				featureFlag, warnings, err := client.UpdateFeatureFlag("user_org_creation", true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(featureFlag).To(Equal(FeatureFlag{Name: "user_org_creation", Enabled: true}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 330000,
+					"description": "The feature flag could not be found: bogus",
+					"error_code": "CF-FeatureFlagNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/bogus"),
+						VerifyJSON(`{"enabled": false}`),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateFeatureFlag("bogus", false)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The feature flag could not be found: bogus",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
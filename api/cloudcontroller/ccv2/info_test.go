@@ -58,6 +58,9 @@ var _ = Describe("Info", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(info.APIVersion).To(Equal("2.59.0"))
+			Expect(info.AppSSHEndpoint).To(MatchRegexp("ssh.%s", serverAPIURL))
+			Expect(info.AppSSHHostKeyFingerprint).To(Equal("a6:d1:08:0b:b0:cb:9b:5f:c4:ba:44:2a:97:26:19:8a"))
+			Expect(info.AppSSHOAuthClient).To(Equal("ssh-proxy"))
 			Expect(info.AuthorizationEndpoint).To(MatchRegexp("https://login.%s", serverAPIURL))
 			Expect(info.DopplerEndpoint).To(MatchRegexp("wss://doppler.%s", serverAPIURL))
 			Expect(info.MinCLIVersion).To(Equal("6.22.1"))
@@ -15,6 +15,9 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteAppInstanceRequest               = "DeleteAppInstance"
+	DeleteAppRequest                       = "DeleteApp"
+	DeleteAppRouteRequest                  = "DeleteAppRoute"
 	DeleteOrganizationRequest              = "DeleteOrganization"
 	DeleteRouteRequest                     = "DeleteRoute"
 	DeleteRunningSecurityGroupSpaceRequest = "DeleteRunningSecurityGroupSpace"
@@ -22,15 +25,23 @@ const (
 	DeleteServiceBindingRequest            = "DeleteServiceBinding"
 	DeleteSpaceRequest                     = "DeleteSpaceRequest"
 	DeleteStagingSecurityGroupSpaceRequest = "DeleteStagingSecurityGroupSpace"
+	GetAppEnvRequest                       = "GetAppEnv"
+	GetAppInstanceFilesRequest             = "GetAppInstanceFiles"
 	GetAppInstancesRequest                 = "GetAppInstances"
 	GetAppRequest                          = "GetApp"
 	GetAppRoutesRequest                    = "GetAppRoutes"
+	GetAppServiceBindingsRequest           = "GetAppServiceBindings"
 	GetAppsRequest                         = "GetApps"
 	GetAppStatsRequest                     = "GetAppStats"
+	GetBuildpacksRequest                   = "GetBuildpacks"
+	GetEnvironmentVariableGroupRequest     = "GetEnvironmentVariableGroup"
+	GetEventsRequest                       = "GetEvents"
+	GetFeatureFlagsRequest                 = "GetFeatureFlags"
 	GetInfoRequest                         = "GetInfo"
 	GetJobRequest                          = "GetJob"
 	GetOrganizationPrivateDomainsRequest   = "GetOrganizationPrivateDomains"
 	GetOrganizationQuotaDefinitionRequest  = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest = "GetOrganizationQuotaDefinitions"
 	GetOrganizationRequest                 = "GetOrganization"
 	GetOrganizationsRequest                = "GetOrganizations"
 	GetPrivateDomainRequest                = "GetPrivateDomain"
@@ -43,27 +54,50 @@ const (
 	GetSecurityGroupStagingSpacesRequest   = "GetSecurityGroupStagingSpaces"
 	GetServiceBindingsRequest              = "GetServiceBindings"
 	GetServiceInstancesRequest             = "GetServiceInstances"
+	GetServiceKeyRequest                   = "GetServiceKey"
+	GetServiceKeysRequest                  = "GetServiceKeys"
+	GetServicePlanRequest                  = "GetServicePlan"
+	GetServicePlansRequest                 = "GetServicePlans"
+	GetServicesRequest                     = "GetServices"
 	GetSharedDomainRequest                 = "GetSharedDomain"
 	GetSharedDomainsRequest                = "GetSharedDomains"
+	GetSpaceAuditorsRequest                = "GetSpaceAuditors"
+	GetSpaceDevelopersRequest              = "GetSpaceDevelopers"
+	GetSpaceManagersRequest                = "GetSpaceManagers"
 	GetSpaceQuotaDefinitionRequest         = "GetSpaceQuotaDefinition"
 	GetSpaceRoutesRequest                  = "GetSpaceRoutes"
 	GetSpaceRunningSecurityGroupsRequest   = "GetSpaceRunningSecurityGroups"
 	GetSpaceServiceInstancesRequest        = "GetSpaceServiceInstances"
+	GetSpaceServicesRequest                = "GetSpaceServices"
 	GetSpacesRequest                       = "GetSpaces"
 	GetSpaceStagingSecurityGroupsRequest   = "GetSpaceStagingSecurityGroups"
 	GetStackRequest                        = "GetStack"
 	GetStacksRequest                       = "GetStacks"
 	GetUsersRequest                        = "GetUsers"
+	PostAppCopyBitsRequest                 = "PostAppCopyBits"
 	PostAppRequest                         = "PostApp"
 	PostAppRestageRequest                  = "PostAppRestage"
+	PostOrganizationRequest                = "PostOrganization"
+	PostOrganizationQuotaDefinitionRequest = "PostOrganizationQuotaDefinition"
 	PostRouteRequest                       = "PostRoute"
 	PostServiceBindingRequest              = "PostServiceBinding"
+	PostServiceKeyRequest                  = "PostServiceKey"
+	PostSpaceRequest                       = "PostSpace"
 	PostUserRequest                        = "PostUser"
 	PutAppBitsRequest                      = "PutAppBits"
 	PutAppRequest                          = "PutApp"
+	PutAppRouteRequest                     = "PutAppRoute"
 	PutBindRouteAppRequest                 = "PutBindRouteApp"
+	PutBuildpackRequest                    = "PutBuildpack"
+	PutEnvironmentVariableGroupRequest     = "PutEnvironmentVariableGroup"
+	PutFeatureFlagRequest                  = "PutFeatureFlag"
+	PutOrganizationRequest                 = "PutOrganization"
+	PutOrganizationManagerRequest          = "PutOrganizationManager"
+	PutOrganizationUserRequest             = "PutOrganizationUser"
 	PutResourceMatch                       = "PutResourceMatch"
 	PutRunningSecurityGroupSpaceRequest    = "PutRunningSecurityGroupSpace"
+	PutSpaceDeveloperRequest               = "PutSpaceDeveloper"
+	PutSpaceManagerRequest                 = "PutSpaceManager"
 	PutStagingSecurityGroupSpaceRequest    = "PutStagingSecurityGroupSpace"
 )
 
@@ -72,20 +106,41 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
+	{Path: "/v2/apps/:app_guid/copy_bits", Method: http.MethodPost, Name: PostAppCopyBitsRequest},
+	{Path: "/v2/apps/:app_guid/env", Method: http.MethodGet, Name: GetAppEnvRequest},
 	{Path: "/v2/apps/:app_guid/instances", Method: http.MethodGet, Name: GetAppInstancesRequest},
+	{Path: "/v2/apps/:app_guid/instances/:index", Method: http.MethodDelete, Name: DeleteAppInstanceRequest},
+	{Path: "/v2/apps/:app_guid/instances/:index/files/:path", Method: http.MethodGet, Name: GetAppInstanceFilesRequest},
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
+	{Path: "/v2/apps/:app_guid/routes/:route_guid", Method: http.MethodDelete, Name: DeleteAppRouteRequest},
+	{Path: "/v2/apps/:app_guid/routes/:route_guid", Method: http.MethodPut, Name: PutAppRouteRequest},
+	{Path: "/v2/apps/:app_guid/service_bindings", Method: http.MethodGet, Name: GetAppServiceBindingsRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodPut, Name: PutBuildpackRequest},
+	{Path: "/v2/config/environment_variable_groups/:group", Method: http.MethodGet, Name: GetEnvironmentVariableGroupRequest},
+	{Path: "/v2/config/environment_variable_groups/:group", Method: http.MethodPut, Name: PutEnvironmentVariableGroupRequest},
+	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetFeatureFlagsRequest},
+	{Path: "/v2/config/feature_flags/:name", Method: http.MethodPut, Name: PutFeatureFlagRequest},
+	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/managers/:manager_guid", Method: http.MethodPut, Name: PutOrganizationManagerRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/users/:user_guid", Method: http.MethodPut, Name: PutOrganizationUserRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodPost, Name: PostOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatch},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
@@ -106,12 +161,25 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
+	{Path: "/v2/service_keys", Method: http.MethodPost, Name: PostServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodGet, Name: GetServiceKeyRequest},
+	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
+	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
+	{Path: "/v2/services", Method: http.MethodGet, Name: GetServicesRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
+	{Path: "/v2/spaces/:guid/auditors", Method: http.MethodGet, Name: GetSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:guid/developers", Method: http.MethodGet, Name: GetSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:guid/managers", Method: http.MethodGet, Name: GetSpaceManagersRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
+	{Path: "/v2/spaces/:guid/services", Method: http.MethodGet, Name: GetSpaceServicesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid/developers/:developer_guid", Method: http.MethodPut, Name: PutSpaceDeveloperRequest},
+	{Path: "/v2/spaces/:space_guid/managers/:manager_guid", Method: http.MethodPut, Name: PutSpaceManagerRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
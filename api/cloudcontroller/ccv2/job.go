@@ -6,11 +6,11 @@ import (
 	"io"
 	"mime/multipart"
 	"net/url"
-	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/util/poll"
 )
 
 //go:generate counterfeiter . Reader
@@ -102,42 +102,43 @@ func (client *Client) GetJob(jobGUID string) (Job, Warnings, error) {
 
 // PollJob will keep polling the given job until the job has terminated, an
 // error is encountered, or config.OverallPollingTimeout is reached. In the
-// last case, a JobTimeoutError is returned.
+// last case, a JobTimeoutError is returned. The wait between polls starts at
+// config.JobPollingInterval and backs off with jitter for long running jobs.
 func (client *Client) PollJob(job Job) (Warnings, error) {
 	originalJobGUID := job.GUID
 
-	var (
-		err         error
-		warnings    Warnings
-		allWarnings Warnings
-	)
+	var allWarnings Warnings
 
-	startTime := time.Now()
-	for time.Now().Sub(startTime) < client.jobPollingTimeout {
+	poller := poll.New(client.jobPollingInterval, client.jobPollingTimeout)
+	err := poller.Poll(func() (bool, error) {
+		var (
+			warnings Warnings
+			err      error
+		)
 		job, warnings, err = client.GetJob(job.GUID)
 		allWarnings = append(allWarnings, Warnings(warnings)...)
 		if err != nil {
-			return allWarnings, err
+			return false, err
 		}
 
 		if job.Failed() {
-			return allWarnings, ccerror.JobFailedError{
+			return false, ccerror.JobFailedError{
 				JobGUID: originalJobGUID,
 				Message: job.ErrorDetails.Description,
 			}
 		}
 
-		if job.Finished() {
-			return allWarnings, nil
-		}
+		return job.Finished(), nil
+	})
 
-		time.Sleep(client.jobPollingInterval)
+	if _, ok := err.(poll.TimeoutError); ok {
+		return allWarnings, ccerror.JobTimeoutError{
+			JobGUID: originalJobGUID,
+			Timeout: client.jobPollingTimeout,
+		}
 	}
 
-	return allWarnings, ccerror.JobTimeoutError{
-		JobGUID: originalJobGUID,
-		Timeout: client.jobPollingTimeout,
-	}
+	return allWarnings, err
 }
 
 // UploadApplicationPackage uploads the newResources and a list of existing
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -40,6 +41,38 @@ func (org *Organization) UnmarshalJSON(data []byte) error {
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid.go.template delete_organization.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid_test.go.template delete_organization_test.go
 
+// CreateOrganization creates an organization with the given name. The
+// organization quota is only sent if quotaGUID is not nil; otherwise the
+// Cloud Controller assigns the default quota.
+func (client *Client) CreateOrganization(orgName string, quotaGUID *string) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name                string  `json:"name"`
+		QuotaDefinitionGUID *string `json:"quota_definition_guid,omitempty"`
+	}{
+		Name:                orgName,
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
 // GetOrganization returns an Organization associated with the provided guid.
 func (client *Client) GetOrganization(guid string) (Organization, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -86,3 +119,37 @@ func (client *Client) GetOrganizations(queries []Query) ([]Organization, Warning
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganizationManager assigns the org manager role to the user with the
+// given GUID. The user must already be a member of the organization.
+func (client *Client) UpdateOrganizationManager(orgGUID string, userGUID string) (Warnings, error) {
+	return client.putRelationship(internal.PutOrganizationManagerRequest, Params{
+		"organization_guid": orgGUID,
+		"manager_guid":      userGUID,
+	})
+}
+
+// UpdateOrganizationUser makes the user with the given GUID a member of the
+// organization.
+func (client *Client) UpdateOrganizationUser(orgGUID string, userGUID string) (Warnings, error) {
+	return client.putRelationship(internal.PutOrganizationUserRequest, Params{
+		"organization_guid": orgGUID,
+		"user_guid":         userGUID,
+	})
+}
+
+// putRelationship associates two resources with the given PUT request, which
+// has no body.
+func (client *Client) putRelationship(requestName string, uriParams Params) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
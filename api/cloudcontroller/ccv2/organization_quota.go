@@ -1,9 +1,11 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -11,26 +13,102 @@ import (
 type OrganizationQuota struct {
 	GUID string
 	Name string
+
+	// MemoryLimit is the total memory, in megabytes, that started app
+	// instances in the organization may use.
+	MemoryLimit int
+
+	// InstanceMemoryLimit is the memory, in megabytes, that a single app
+	// instance may use, or UnlimitedQuota.
+	InstanceMemoryLimit int
+
+	// TotalRoutes is the number of routes allowed in the organization, or
+	// UnlimitedQuota.
+	TotalRoutes int
+
+	// TotalServices is the number of service instances allowed in the
+	// organization, or UnlimitedQuota.
+	TotalServices int
+
+	// NonBasicServicesAllowed is true when service instances of paid plans may
+	// be created in the organization.
+	NonBasicServicesAllowed bool
+}
+
+// MarshalJSON converts an organization quota into a Cloud Controller quota
+// definition.
+func (orgQuota OrganizationQuota) MarshalJSON() ([]byte, error) {
+	ccOrgQuota := struct {
+		Name                    string `json:"name"`
+		MemoryLimit             int    `json:"memory_limit"`
+		InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+		TotalRoutes             int    `json:"total_routes"`
+		TotalServices           int    `json:"total_services"`
+		NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
+	}{
+		Name:                    orgQuota.Name,
+		MemoryLimit:             orgQuota.MemoryLimit,
+		InstanceMemoryLimit:     orgQuota.InstanceMemoryLimit,
+		TotalRoutes:             orgQuota.TotalRoutes,
+		TotalServices:           orgQuota.TotalServices,
+		NonBasicServicesAllowed: orgQuota.NonBasicServicesAllowed,
+	}
+
+	return json.Marshal(ccOrgQuota)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
-func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
+func (orgQuota *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string `json:"name"`
+			MemoryLimit             int    `json:"memory_limit"`
+			InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+			TotalRoutes             int    `json:"total_routes"`
+			TotalServices           int    `json:"total_services"`
+			NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
 		return err
 	}
 
-	application.GUID = ccOrgQuota.Metadata.GUID
-	application.Name = ccOrgQuota.Entity.Name
+	orgQuota.GUID = ccOrgQuota.Metadata.GUID
+	orgQuota.Name = ccOrgQuota.Entity.Name
+	orgQuota.MemoryLimit = ccOrgQuota.Entity.MemoryLimit
+	orgQuota.InstanceMemoryLimit = ccOrgQuota.Entity.InstanceMemoryLimit
+	orgQuota.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	orgQuota.TotalServices = ccOrgQuota.Entity.TotalServices
+	orgQuota.NonBasicServicesAllowed = ccOrgQuota.Entity.NonBasicServicesAllowed
 
 	return nil
 }
 
+// CreateOrganizationQuota creates an organization quota (quota definition).
+func (client *Client) CreateOrganizationQuota(orgQuota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	body, err := json.Marshal(orgQuota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var createdOrgQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		Result: &createdOrgQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdOrgQuota, response.Warnings, err
+}
+
 // GetOrganizaitonQuota gets an organization quota (quota definition) from the API.
 func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -49,3 +127,56 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// GetOrganizationQuotas returns the organization quotas (quota definitions)
+// that match the provided queries.
+func (client *Client) GetOrganizationQuotas(queries []Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullOrgQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if orgQuota, ok := item.(OrganizationQuota); ok {
+			fullOrgQuotasList = append(fullOrgQuotasList, orgQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullOrgQuotasList, warnings, err
+}
+
+// UpdateOrganizationQuota assigns the organization quota with the given GUID
+// to the organization.
+func (client *Client) UpdateOrganizationQuota(orgGUID string, orgQuotaGUID string) (Warnings, error) {
+	body, err := json.Marshal(struct {
+		QuotaDefinitionGUID string `json:"quota_definition_guid"`
+	}{
+		QuotaDefinitionGUID: orgQuotaGUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -26,7 +26,12 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"non_basic_services_allowed": true,
+					"total_services": -1,
+					"total_routes": 1000,
+					"memory_limit": 10240,
+					"instance_memory_limit": -1
 				}
 			}`
 				server.AppendHandlers(
@@ -42,8 +47,13 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:                    "some-org-quota-guid",
+					Name:                    "some-org-quota",
+					MemoryLimit:             10240,
+					InstanceMemoryLimit:     UnlimitedQuota,
+					TotalRoutes:             1000,
+					TotalServices:           UnlimitedQuota,
+					NonBasicServicesAllowed: true,
 				}))
 			})
 		})
@@ -73,4 +83,147 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when the quotas span multiple pages", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/quota_definitions?q=name:some-org-quota&page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-org-quota-guid-1"},
+							"entity": {"name": "some-org-quota-1", "memory_limit": 1024, "instance_memory_limit": -1}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "some-org-quota-guid-2"},
+							"entity": {"name": "some-org-quota-2", "memory_limit": 2048, "instance_memory_limit": 512}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the quotas and warnings", func() {
+				orgQuotas, warnings, err := client.GetOrganizationQuotas([]Query{{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Value:    "some-org-quota",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(orgQuotas).To(Equal([]OrganizationQuota{
+					{GUID: "some-org-quota-guid-1", Name: "some-org-quota-1", MemoryLimit: 1024, InstanceMemoryLimit: UnlimitedQuota},
+					{GUID: "some-org-quota-guid-2", Name: "some-org-quota-2", MemoryLimit: 2048, InstanceMemoryLimit: 512},
+				}))
+			})
+		})
+	})
+
+	Describe("CreateOrganizationQuota", func() {
+		Context("when the quota is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {"guid": "some-org-quota-guid"},
+					"entity": {
+						"name": "some-org-quota",
+						"non_basic_services_allowed": false,
+						"total_services": 10,
+						"total_routes": -1,
+						"memory_limit": 2048,
+						"instance_memory_limit": 512
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/quota_definitions"),
+						VerifyJSON(`{
+							"name": "some-org-quota",
+							"non_basic_services_allowed": false,
+							"total_services": 10,
+							"total_routes": -1,
+							"memory_limit": 2048,
+							"instance_memory_limit": 512
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the created quota and warnings", func() {
+				orgQuota, warnings, err := client.CreateOrganizationQuota(OrganizationQuota{
+					Name:                "some-org-quota",
+					MemoryLimit:         2048,
+					InstanceMemoryLimit: 512,
+					TotalRoutes:         UnlimitedQuota,
+					TotalServices:       10,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(orgQuota).To(Equal(OrganizationQuota{
+					GUID:                "some-org-quota-guid",
+					Name:                "some-org-quota",
+					MemoryLimit:         2048,
+					InstanceMemoryLimit: 512,
+					TotalRoutes:         UnlimitedQuota,
+					TotalServices:       10,
+				}))
+			})
+		})
+
+		Context("when the Cloud Controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "Quota Definition is invalid: name is taken",
+					"error_code": "CF-QuotaDefinitionInvalid",
+					"code": 240003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/quota_definitions"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateOrganizationQuota(OrganizationQuota{Name: "some-org-quota"})
+				Expect(err).To(MatchError(ccerror.BadRequestError{
+					Message: "Quota Definition is invalid: name is taken",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+					VerifyJSON(`{"quota_definition_guid": "some-org-quota-guid"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("assigns the quota to the organization", func() {
+			warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-org-quota-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
 })
@@ -212,4 +212,144 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		var (
+			quotaGUID *string
+
+			org        Organization
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			org, warnings, executeErr = client.CreateOrganization("some-org", quotaGUID)
+		})
+
+		Context("when the organization is created", func() {
+			var expectedBody string
+
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						func(w http.ResponseWriter, r *http.Request) {
+							VerifyJSON(expectedBody)(w, r)
+						},
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			Context("when a quota is provided", func() {
+				BeforeEach(func() {
+					quota := "some-quota-guid"
+					quotaGUID = &quota
+					expectedBody = `{"name": "some-org", "quota_definition_guid": "some-quota-guid"}`
+				})
+
+				It("creates the org with the quota and returns all warnings", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(org).To(Equal(Organization{
+						GUID:                "some-org-guid",
+						Name:                "some-org",
+						QuotaDefinitionGUID: "some-quota-guid",
+					}))
+					Expect(warnings).To(ConsistOf("warning-1"))
+				})
+			})
+
+			Context("when the quota is nil", func() {
+				BeforeEach(func() {
+					quotaGUID = nil
+					expectedBody = `{"name": "some-org"}`
+				})
+
+				It("omits the quota from the request", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(server.ReceivedRequests()).To(HaveLen(2))
+				})
+			})
+		})
+
+		Context("when the organization name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30002,
+					"description": "The organization name is taken: some-org",
+					"error_code": "CF-OrganizationNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns an OrganizationNameTakenError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.OrganizationNameTakenError{
+					Message: "The organization name is taken: some-org",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationUser", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/users/some-user-guid"),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				))
+		})
+
+		It("adds the user to the org and returns all warnings", func() {
+			warnings, err := client.UpdateOrganizationUser("some-org-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("UpdateOrganizationManager", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/managers/some-user-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("makes the user a manager of the org and returns all warnings", func() {
+				warnings, err := client.UpdateOrganizationManager("some-org-guid", "some-user-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/managers/some-user-guid"),
+						RespondWith(http.StatusForbidden, `{"code": 10003, "description": "You are not authorized to perform the requested action", "error_code": "CF-NotAuthorized"}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.UpdateOrganizationManager("some-org-guid", "some-user-guid")
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -2,21 +2,30 @@ package ccv2
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// DefaultPageConcurrency is the number of pages fetched at the same time when
+// Config.PageConcurrency is not set.
+const DefaultPageConcurrency = 4
+
+// page is the result of fetching a single page of resources.
+type page struct {
+	resources []interface{}
+	warnings  Warnings
+	err       error
+}
+
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
 	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
-		}
-
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
+		wrapper, warnings, err := client.getPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
 		if err != nil {
 			return fullWarningsList, err
 		}
@@ -37,6 +46,12 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 			break
 		}
 
+		if client.pageConcurrency > 1 && wrapper.TotalPages > 2 {
+			warnings, err := client.paginateConcurrently(wrapper, obj, appendToExternalList)
+			fullWarningsList = append(fullWarningsList, warnings...)
+			return fullWarningsList, err
+		}
+
 		request, err = client.newHTTPRequest(requestOptions{
 			URI:    wrapper.NextURL,
 			Method: http.MethodGet,
@@ -48,3 +63,88 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 
 	return fullWarningsList, nil
 }
+
+// paginateConcurrently fetches the pages following firstPage using up to
+// pageConcurrency workers. The page numbers are derived from the first page's
+// next_url and total_pages, and the resources and warnings are aggregated in
+// page order.
+func (client Client) paginateConcurrently(firstPage *PaginatedResources, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	nextURL, err := url.Parse(firstPage.NextURL)
+	if err != nil {
+		return nil, err
+	}
+	query := nextURL.Query()
+	firstPageNumber, err := strconv.Atoi(query.Get("page"))
+	if err != nil {
+		firstPageNumber = 2
+	}
+
+	pageNumbers := make(chan int)
+	pages := make([]page, firstPage.TotalPages-firstPageNumber+1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < client.pageConcurrency && i < len(pages); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pageNumber := range pageNumbers {
+				pages[pageNumber-firstPageNumber] = client.getPageNumber(*nextURL, pageNumber, obj)
+			}
+		}()
+	}
+
+	for pageNumber := firstPageNumber; pageNumber <= firstPage.TotalPages; pageNumber++ {
+		pageNumbers <- pageNumber
+	}
+	close(pageNumbers)
+	wg.Wait()
+
+	allWarnings := Warnings{}
+	for _, result := range pages {
+		allWarnings = append(allWarnings, result.warnings...)
+		if result.err != nil {
+			return allWarnings, result.err
+		}
+
+		for _, item := range result.resources {
+			err = appendToExternalList(item)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (client Client) getPageNumber(pageURL url.URL, pageNumber int, obj interface{}) page {
+	query := pageURL.Query()
+	query.Set("page", strconv.Itoa(pageNumber))
+	pageURL.RawQuery = encodeQueryParameters(query)
+
+	request, err := client.newHTTPRequest(requestOptions{
+		URI:    pageURL.String(),
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return page{err: err}
+	}
+
+	wrapper, warnings, err := client.getPage(request, obj)
+	if err != nil {
+		return page{warnings: warnings, err: err}
+	}
+
+	resources, err := wrapper.Resources()
+	return page{resources: resources, warnings: warnings, err: err}
+}
+
+func (client Client) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
@@ -0,0 +1,169 @@
+package ccv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	var (
+		client *Client
+
+		totalPages    int
+		failingPage   int
+		inFlight      int32
+		maxInFlight   int32
+		requestedURLs chan string
+	)
+
+	stacksPage := func(pageNumber int) string {
+		nextURL := "null"
+		if pageNumber < totalPages {
+			nextURL = fmt.Sprintf(`"/v2/stacks?q=name%%20IN%%20a,b&page=%d"`, pageNumber+1)
+		}
+
+		return fmt.Sprintf(`{
+			"total_pages": %d,
+			"next_url": %s,
+			"resources": [
+				{
+					"metadata": { "guid": "stack-guid-%d-a" },
+					"entity": { "name": "stack-%d-a" }
+				},
+				{
+					"metadata": { "guid": "stack-guid-%d-b" },
+					"entity": { "name": "stack-%d-b" }
+				}
+			]
+		}`, totalPages, nextURL, pageNumber, pageNumber, pageNumber, pageNumber)
+	}
+
+	BeforeEach(func() {
+		totalPages = 6
+		failingPage = 0
+		inFlight = 0
+		maxInFlight = 0
+		requestedURLs = make(chan string, 100)
+
+		server.RouteToHandler(http.MethodGet, "/v2/stacks", func(w http.ResponseWriter, req *http.Request) {
+			requestedURLs <- req.URL.String()
+
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+					break
+				}
+			}
+
+			pageNumber := 1
+			if page := req.URL.Query().Get("page"); page != "" {
+				pageNumber, _ = strconv.Atoi(page)
+				// Give the other workers a chance to start their requests.
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			w.Header().Set("X-Cf-Warnings", fmt.Sprintf("warning-%d", pageNumber))
+			if pageNumber == failingPage {
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(w, `{"code": 10001, "description": "boom", "error_code": "CF-Boom"}`)
+				return
+			}
+			fmt.Fprint(w, stacksPage(pageNumber))
+		})
+	})
+
+	expectedStacks := func() []Stack {
+		stacks := []Stack{}
+		for page := 1; page <= totalPages; page++ {
+			stacks = append(stacks,
+				Stack{GUID: fmt.Sprintf("stack-guid-%d-a", page), Name: fmt.Sprintf("stack-%d-a", page)},
+				Stack{GUID: fmt.Sprintf("stack-guid-%d-b", page), Name: fmt.Sprintf("stack-%d-b", page)},
+			)
+		}
+		return stacks
+	}
+
+	expectedWarnings := func() []string {
+		warnings := []string{}
+		for page := 1; page <= totalPages; page++ {
+			warnings = append(warnings, fmt.Sprintf("warning-%d", page))
+		}
+		return warnings
+	}
+
+	Context("when the page concurrency is not configured", func() {
+		BeforeEach(func() {
+			client = NewTestClient()
+		})
+
+		It("fetches the remaining pages concurrently, up to the default, and preserves their order", func() {
+			stacks, warnings, err := client.GetStacks(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(Equal(expectedStacks()))
+			Expect(warnings).To(Equal(Warnings(expectedWarnings())))
+
+			Expect(maxInFlight).To(BeNumerically(">", 1))
+			Expect(maxInFlight).To(BeNumerically("<=", DefaultPageConcurrency))
+		})
+
+		It("keeps the query of the next_url when requesting the other pages", func() {
+			_, _, err := client.GetStacks(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			close(requestedURLs)
+			var urls []string
+			for requestedURL := range requestedURLs {
+				urls = append(urls, requestedURL)
+			}
+			Expect(urls).To(HaveLen(totalPages))
+			Expect(urls).To(ContainElement("/v2/stacks?page=4&q=name%20IN%20a%2Cb"))
+		})
+
+		Context("when a page fails", func() {
+			BeforeEach(func() {
+				failingPage = 4
+			})
+
+			It("returns the error and the warnings of the pages up to the failure", func() {
+				_, warnings, err := client.GetStacks(nil)
+				Expect(err).To(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+			})
+		})
+
+		Context("when there are only two pages", func() {
+			BeforeEach(func() {
+				totalPages = 2
+			})
+
+			It("fetches the second page from the next_url", func() {
+				stacks, _, err := client.GetStacks(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stacks).To(Equal(expectedStacks()))
+			})
+		})
+	})
+
+	Context("when the page concurrency is 1", func() {
+		BeforeEach(func() {
+			client = NewTestClient(Config{PageConcurrency: 1})
+		})
+
+		It("fetches one page at a time", func() {
+			stacks, warnings, err := client.GetStacks(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(Equal(expectedStacks()))
+			Expect(warnings).To(Equal(Warnings(expectedWarnings())))
+			Expect(maxInFlight).To(BeEquivalentTo(1))
+		})
+	})
+})
@@ -18,6 +18,7 @@ func NewPaginatedResources(exampleResource interface{}) *PaginatedResources {
 type PaginatedResources struct {
 	NextURL        string          `json:"next_url"`
 	ResourcesBytes json.RawMessage `json:"resources"`
+	TotalPages     int             `json:"total_pages"`
 	resourceType   reflect.Type
 }
 
@@ -3,6 +3,7 @@ package ccv2
 import (
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // QueryFilter is the type of filter a Query uses.
@@ -12,6 +13,8 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter QueryFilter = "actee"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
@@ -20,20 +23,33 @@ const (
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter QueryFilter = "route_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter QueryFilter = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter QueryFilter = "service_instance_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
 	SpaceGUIDFilter QueryFilter = "space_guid"
+	// GUIDFilter is the name of the 'guid' filter.
+	GUIDFilter QueryFilter = "guid"
 
 	// NameFilter is the name of the 'name' filter.
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// PathFilter is the name of the 'path' filter.
+	PathFilter QueryFilter = "path"
+	// PortFilter is the name of the 'port' filter.
+	PortFilter QueryFilter = "port"
+	// TypeFilter is the name of the 'type' filter.
+	TypeFilter QueryFilter = "type"
 )
 
 const (
 	// EqualOperator is the query equal operator.
 	EqualOperator QueryOperator = ":"
+	// InOperator is the query 'IN' operator. The Value of a Query using it is
+	// a comma separated list.
+	InOperator QueryOperator = " IN "
 )
 
 // Query is a type of filter that can be passed to specific request to narrow
@@ -58,3 +74,11 @@ func FormatQueryParameters(queries []Query) url.Values {
 
 	return params
 }
+
+// encodeQueryParameters percent-encodes the query parameters, including the
+// ':' separating a filter from its value. Unlike url.Values.Encode, spaces
+// are encoded as %20 instead of '+'; literal '+' characters are already
+// encoded as %2B so the replacement is unambiguous.
+func encodeQueryParameters(params url.Values) string {
+	return strings.Replace(params.Encode(), "+", "%20", -1)
+}
@@ -25,6 +25,9 @@ type requestOptions struct {
 
 	// URI is the URI of the request.
 	URI string
+	// URL is the full URL of a request to an endpoint other than the Cloud
+	// Controller, such as the routing API. It takes precedence over URI.
+	URL string
 	// Method is the HTTP method of the request.
 	Method string
 
@@ -37,7 +40,13 @@ type requestOptions struct {
 func (client Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontroller.Request, error) {
 	var request *http.Request
 	var err error
-	if passedRequest.URI != "" {
+	if passedRequest.URL != "" {
+		request, err = http.NewRequest(
+			passedRequest.Method,
+			passedRequest.URL,
+			passedRequest.Body,
+		)
+	} else if passedRequest.URI != "" {
 		request, err = http.NewRequest(
 			passedRequest.Method,
 			fmt.Sprintf("%s%s", client.API(), passedRequest.URI),
@@ -50,7 +59,7 @@ func (client Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontrol
 			passedRequest.Body,
 		)
 		if err == nil {
-			request.URL.RawQuery = passedRequest.Query.Encode()
+			request.URL.RawQuery = encodeQueryParameters(passedRequest.Query)
 		}
 	}
 	if err != nil {
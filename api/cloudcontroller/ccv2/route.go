@@ -185,6 +185,44 @@ func (client *Client) GetRoutes(queryParams []Query) ([]Route, Warnings, error)
 	return fullRoutesList, warnings, err
 }
 
+// UpdateApplicationRoute maps the route with the provided Route GUID to the
+// application with the provided Application GUID.
+func (client *Client) UpdateApplicationRoute(appGUID string, routeGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppRouteRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteApplicationRoute unmaps the route with the provided Route GUID from
+// the application with the provided Application GUID.
+func (client *Client) DeleteApplicationRoute(appGUID string, routeGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRouteRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // DeleteRoute deletes the Route associated with the provided Route GUID.
 func (client *Client) DeleteRoute(routeGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -760,6 +760,91 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("UpdateApplicationRoute", func() {
+		Context("when the mapping succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid/routes/some-route-guid"),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("maps the route and returns all warnings", func() {
+				warnings, err := client.UpdateApplicationRoute("some-app-guid", "some-route-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the route mapping is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 210006,
+					"description": "The route mapping is taken: some-app-guid, some-route-guid",
+					"error_code": "CF-RouteMappingTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid/routes/some-route-guid"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a RouteMappingTakenError and all warnings", func() {
+				warnings, err := client.UpdateApplicationRoute("some-app-guid", "some-route-guid")
+				Expect(err).To(MatchError(ccerror.RouteMappingTakenError{
+					Message: "The route mapping is taken: some-app-guid, some-route-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationRoute", func() {
+		Context("when the unmapping succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid/routes/some-route-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("unmaps the route and returns all warnings", func() {
+				warnings, err := client.DeleteApplicationRoute("some-app-guid", "some-route-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid/routes/some-route-guid"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, err := client.DeleteApplicationRoute("some-app-guid", "some-route-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+			})
+		})
+	})
+
 	Describe("DeleteRoute", func() {
 		Context("when the route exists", func() {
 			BeforeEach(func() {
@@ -0,0 +1,39 @@
+package ccv2
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// RouterGroup represents a routing API router group.
+type RouterGroup struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+
+	// ReservablePorts is a comma separated list of ports and port ranges, for
+	// example "1024-1033,2000".
+	ReservablePorts string `json:"reservable_ports"`
+}
+
+// GetRouterGroups returns the router groups from the routing API advertised by
+// the targeted Cloud Controller.
+func (client *Client) GetRouterGroups() ([]RouterGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    fmt.Sprintf("%s/v1/router_groups", client.RoutingEndpoint()),
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var routerGroups []RouterGroup
+	response := cloudcontroller.Response{
+		Result: &routerGroups,
+	}
+	err = client.connection.Make(request, &response)
+
+	return routerGroups, response.Warnings, err
+}
@@ -0,0 +1,78 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Router Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetRouterGroups", func() {
+		Context("when the routing API returns router groups", func() {
+			BeforeEach(func() {
+				response := `[
+					{
+						"guid": "tcp-router-group-guid",
+						"name": "default-tcp",
+						"type": "tcp",
+						"reservable_ports": "1024-1033,2000"
+					},
+					{
+						"guid": "http-router-group-guid",
+						"name": "default-http",
+						"type": "http"
+					}
+				]`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the router groups", func() {
+				routerGroups, _, err := client.GetRouterGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroups).To(Equal([]RouterGroup{
+					{
+						GUID:            "tcp-router-group-guid",
+						Name:            "default-tcp",
+						Type:            "tcp",
+						ReservablePorts: "1024-1033,2000",
+					},
+					{
+						GUID: "http-router-group-guid",
+						Name: "default-http",
+						Type: "http",
+					},
+				}))
+			})
+		})
+
+		Context("when the routing API returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusInternalServerError, `{"name": "UnknownError", "message": "oops"}`),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, _, err := client.GetRouterGroups()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
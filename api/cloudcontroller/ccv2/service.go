@@ -0,0 +1,90 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Service represents a Cloud Controller Service.
+type Service struct {
+	GUID              string
+	Label             string
+	Description       string
+	ServiceBrokerGUID string
+
+	// Active is true if new Service Instances of the Service can be created.
+	Active bool
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service response.
+func (service *Service) UnmarshalJSON(data []byte) error {
+	var ccService struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Label             string `json:"label"`
+			Description       string `json:"description"`
+			ServiceBrokerGUID string `json:"service_broker_guid"`
+			Active            bool   `json:"active"`
+		}
+	}
+	err := json.Unmarshal(data, &ccService)
+	if err != nil {
+		return err
+	}
+
+	service.GUID = ccService.Metadata.GUID
+	service.Label = ccService.Entity.Label
+	service.Description = ccService.Entity.Description
+	service.ServiceBrokerGUID = ccService.Entity.ServiceBrokerGUID
+	service.Active = ccService.Entity.Active
+	return nil
+}
+
+// GetServices returns back a list of Services based off of the provided
+// queries.
+func (client *Client) GetServices(queries []Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicesRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.getServices(request)
+}
+
+// GetSpaceServices returns back a list of the Services visible in the
+// provided space, based off of the provided queries.
+func (client *Client) GetSpaceServices(spaceGUID string, queries []Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceServicesRequest,
+		URIParams:   Params{"guid": spaceGUID},
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.getServices(request)
+}
+
+func (client *Client) getServices(request *cloudcontroller.Request) ([]Service, Warnings, error) {
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
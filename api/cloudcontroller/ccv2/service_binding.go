@@ -11,13 +11,21 @@ import (
 
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
-	GUID string
+	GUID                string
+	AppGUID             string
+	ServiceInstanceGUID string
+	Credentials         map[string]interface{}
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
 func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
+		Entity   struct {
+			AppGUID             string                 `json:"app_guid"`
+			ServiceInstanceGUID string                 `json:"service_instance_guid"`
+			Credentials         map[string]interface{} `json:"credentials"`
+		}
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
 	if err != nil {
@@ -25,6 +33,9 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
+	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
+	serviceBinding.Credentials = ccServiceBinding.Entity.Credentials
 	return nil
 }
 
@@ -97,6 +108,34 @@ func (client *Client) GetServiceBindings(queries []Query) ([]ServiceBinding, War
 	return fullBindingsList, warnings, err
 }
 
+// GetApplicationServiceBindings returns a list of Service Bindings associated
+// with the provided Application GUID, and filtered by the provided queries.
+func (client *Client) GetApplicationServiceBindings(appGUID string, queries []Query) ([]ServiceBinding, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppServiceBindingsRequest,
+		URIParams:   Params{"app_guid": appGUID},
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBindingsList []ServiceBinding
+	warnings, err := client.paginate(request, ServiceBinding{}, func(item interface{}) error {
+		if binding, ok := item.(ServiceBinding); ok {
+			fullBindingsList = append(fullBindingsList, binding)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceBinding{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBindingsList, warnings, err
+}
+
 // DeleteServiceBinding will destroy the requested Service Binding.
 func (client *Client) DeleteServiceBinding(serviceBindingGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -82,6 +82,74 @@ var _ = Describe("Service Binding", func() {
 		})
 	})
 
+	Describe("GetApplicationServiceBindings", func() {
+		Context("when the app has service bindings", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "service-binding-guid-1"
+							},
+							"entity": {
+								"app_guid": "some-app-guid",
+								"service_instance_guid": "service-instance-guid-1",
+								"credentials": {
+									"password": "some-password"
+								}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/service_bindings"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service bindings and warnings", func() {
+				serviceBindings, warnings, err := client.GetApplicationServiceBindings("some-app-guid", nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceBindings).To(ConsistOf([]ServiceBinding{
+					{
+						GUID:                "service-binding-guid-1",
+						AppGUID:             "some-app-guid",
+						ServiceInstanceGUID: "service-instance-guid-1",
+						Credentials:         map[string]interface{}{"password": "some-password"},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/service_bindings"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a not found error and warnings", func() {
+				_, warnings, err := client.GetApplicationServiceBindings("some-app-guid", nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceBindings", func() {
 		BeforeEach(func() {
 			response1 := `{
@@ -20,9 +20,10 @@ const (
 
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
-	GUID string
-	Name string
-	Type ServiceInstanceType
+	GUID            string
+	Name            string
+	Type            ServiceInstanceType
+	ServicePlanGUID string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -30,8 +31,9 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name string
-			Type string
+			Name            string
+			Type            string
+			ServicePlanGUID string `json:"service_plan_guid"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -42,6 +44,7 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
 	serviceInstance.Name = ccServiceInstance.Entity.Name
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
+	serviceInstance.ServicePlanGUID = ccServiceInstance.Entity.ServicePlanGUID
 	return nil
 }
 
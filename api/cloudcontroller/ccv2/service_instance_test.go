@@ -52,8 +52,9 @@ var _ = Describe("Service Instance", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(app).To(Equal(Application{
-						GUID: "some-app-guid",
-						Name: "some-app-name",
+						GUID:      "some-app-guid",
+						Name:      "some-app-name",
+						SpaceGUID: "some-space-guid",
 					}))
 					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				})
@@ -216,7 +217,8 @@ var _ = Describe("Service Instance", func() {
 							},
 							"entity": {
 								"name": "some-service-name-1",
-								"type": "managed_service_instance"
+								"type": "managed_service_instance",
+								"service_plan_guid": "some-service-plan-guid-1"
 							}
 						},
 						{
@@ -280,7 +282,7 @@ var _ = Describe("Service Instance", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(serviceInstances).To(ConsistOf([]ServiceInstance{
-						{Name: "some-service-name-1", GUID: "some-service-guid-1", Type: ManagedService},
+						{Name: "some-service-name-1", GUID: "some-service-guid-1", Type: ManagedService, ServicePlanGUID: "some-service-plan-guid-1"},
 						{Name: "some-service-name-2", GUID: "some-service-guid-2", Type: UserProvidedService},
 						{Name: "some-service-name-3", GUID: "some-service-guid-3", Type: ManagedService},
 						{Name: "some-service-name-4", GUID: "some-service-guid-4", Type: UserProvidedService},
@@ -0,0 +1,154 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// LastOperationState is the state of a service broker's asynchronous
+// operation.
+type LastOperationState string
+
+const (
+	// LastOperationInProgress is the state of an operation the broker has not
+	// finished yet.
+	LastOperationInProgress LastOperationState = "in progress"
+
+	// LastOperationSucceeded is the state of an operation the broker finished
+	// successfully.
+	LastOperationSucceeded LastOperationState = "succeeded"
+
+	// LastOperationFailed is the state of an operation the broker could not
+	// finish.
+	LastOperationFailed LastOperationState = "failed"
+)
+
+// LastOperation is the most recent operation a service broker performed on a
+// resource.
+type LastOperation struct {
+	Type        string             `json:"type"`
+	State       LastOperationState `json:"state"`
+	Description string             `json:"description"`
+}
+
+// ServiceKey represents a Cloud Controller Service Key.
+type ServiceKey struct {
+	GUID                string
+	Name                string
+	ServiceInstanceGUID string
+	Credentials         map[string]interface{}
+	LastOperation       LastOperation
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Key response.
+func (serviceKey *ServiceKey) UnmarshalJSON(data []byte) error {
+	var ccServiceKey struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name                string                 `json:"name"`
+			ServiceInstanceGUID string                 `json:"service_instance_guid"`
+			Credentials         map[string]interface{} `json:"credentials"`
+			LastOperation       LastOperation          `json:"last_operation"`
+		}
+	}
+	err := json.Unmarshal(data, &ccServiceKey)
+	if err != nil {
+		return err
+	}
+
+	serviceKey.GUID = ccServiceKey.Metadata.GUID
+	serviceKey.Name = ccServiceKey.Entity.Name
+	serviceKey.ServiceInstanceGUID = ccServiceKey.Entity.ServiceInstanceGUID
+	serviceKey.Credentials = ccServiceKey.Entity.Credentials
+	serviceKey.LastOperation = ccServiceKey.Entity.LastOperation
+	return nil
+}
+
+// serviceKeyRequestBody represents the body of the service key create
+// request.
+type serviceKeyRequestBody struct {
+	ServiceInstanceGUID string                 `json:"service_instance_guid"`
+	Name                string                 `json:"name"`
+	Parameters          map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// CreateServiceKey creates a service key for the provided service instance.
+// The parameters are passed through to the service broker.
+func (client *Client) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ServiceKey, Warnings, error) {
+	requestBody := serviceKeyRequestBody{
+		ServiceInstanceGUID: serviceInstanceGUID,
+		Name:                keyName,
+		Parameters:          parameters,
+	}
+
+	bodyBytes, err := json.Marshal(requestBody)
+	if err != nil {
+		return ServiceKey{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceKeyRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServiceKey{}, nil, err
+	}
+
+	var serviceKey ServiceKey
+	response := cloudcontroller.Response{
+		Result: &serviceKey,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceKey, response.Warnings, err
+}
+
+// GetServiceKey returns back a Service Key.
+func (client *Client) GetServiceKey(guid string) (ServiceKey, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeyRequest,
+		URIParams:   Params{"service_key_guid": guid},
+	})
+	if err != nil {
+		return ServiceKey{}, nil, err
+	}
+
+	var serviceKey ServiceKey
+	response := cloudcontroller.Response{
+		Result: &serviceKey,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceKey, response.Warnings, err
+}
+
+// GetServiceKeys returns back a list of Service Keys based off of the
+// provided queries.
+func (client *Client) GetServiceKeys(queries []Query) ([]ServiceKey, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeysRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullKeysList []ServiceKey
+	warnings, err := client.paginate(request, ServiceKey{}, func(item interface{}) error {
+		if key, ok := item.(ServiceKey); ok {
+			fullKeysList = append(fullKeysList, key)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceKey{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullKeysList, warnings, err
+}
@@ -0,0 +1,241 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Key", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateServiceKey", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"metadata": {
+							"guid": "some-service-key-guid"
+						},
+						"entity": {
+							"name": "some-key-name",
+							"service_instance_guid": "some-service-instance-guid",
+							"credentials": {
+								"username": "some-user"
+							},
+							"last_operation": {
+								"type": "create",
+								"state": "in progress",
+								"description": "creating key"
+							}
+						}
+					}`
+				requestBody := map[string]interface{}{
+					"service_instance_guid": "some-service-instance-guid",
+					"name":                  "some-key-name",
+					"parameters": map[string]interface{}{
+						"the-service-broker": "wants this object",
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created key and warnings", func() {
+				parameters := map[string]interface{}{
+					"the-service-broker": "wants this object",
+				}
+				serviceKey, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key-name", parameters)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceKey).To(Equal(ServiceKey{
+					GUID:                "some-service-key-guid",
+					Name:                "some-key-name",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials:         map[string]interface{}{"username": "some-user"},
+					LastOperation: LastOperation{
+						Type:        "create",
+						State:       LastOperationInProgress,
+						Description: "creating key",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when no parameters are provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						VerifyJSON(`{"service_instance_guid": "some-service-instance-guid", "name": "some-key-name"}`),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-service-key-guid"}}`),
+					),
+				)
+			})
+
+			It("omits the parameters from the request", func() {
+				_, _, err := client.CreateServiceKey("some-service-instance-guid", "some-key-name", nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"description": "The service key name is taken: some-key-name",
+						"error_code": "CF-ServiceKeyNameTaken",
+						"code": 360001
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key-name", nil)
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The service key name is taken: some-key-name"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceKey", func() {
+		Context("when the key exists", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"metadata": {
+							"guid": "some-service-key-guid"
+						},
+						"entity": {
+							"name": "some-key-name",
+							"service_instance_guid": "some-service-instance-guid",
+							"credentials": {
+								"username": "some-user"
+							}
+						}
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the key and warnings", func() {
+				serviceKey, warnings, err := client.GetServiceKey("some-service-key-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceKey).To(Equal(ServiceKey{
+					GUID:                "some-service-key-guid",
+					Name:                "some-key-name",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials:         map[string]interface{}{"username": "some-user"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"description": "The service key could not be found: some-service-key-guid",
+						"error_code": "CF-ServiceKeyNotFound",
+						"code": 360003
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServiceKey("some-service-key-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service key could not be found: some-service-key-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceKeys", func() {
+		Context("when there are service keys", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/service_keys?q=service_instance_guid:some-service-instance-guid&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-key-guid-1"
+							},
+							"entity": {
+								"name": "some-key-name-1",
+								"service_instance_guid": "some-service-instance-guid"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-key-guid-2"
+							},
+							"entity": {
+								"name": "some-key-name-2",
+								"service_instance_guid": "some-service-instance-guid"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the keys and all warnings", func() {
+				serviceKeys, warnings, err := client.GetServiceKeys([]Query{{
+					Filter:   ServiceInstanceGUIDFilter,
+					Operator: EqualOperator,
+					Value:    "some-service-instance-guid",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceKeys).To(Equal([]ServiceKey{
+					{GUID: "some-service-key-guid-1", Name: "some-key-name-1", ServiceInstanceGUID: "some-service-instance-guid"},
+					{GUID: "some-service-key-guid-2", Name: "some-key-name-2", ServiceInstanceGUID: "some-service-instance-guid"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			})
+		})
+	})
+})
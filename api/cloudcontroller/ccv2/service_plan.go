@@ -0,0 +1,95 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServicePlan represents a Cloud Controller Service Plan.
+type ServicePlan struct {
+	GUID        string
+	Name        string
+	Description string
+	ServiceGUID string
+
+	// Free is true if the plan is free of charge.
+	Free bool
+
+	// Public is true if the plan is available to all organizations.
+	Public bool
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
+	var ccServicePlan struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			ServiceGUID string `json:"service_guid"`
+			Free        bool   `json:"free"`
+			Public      bool   `json:"public"`
+		}
+	}
+	err := json.Unmarshal(data, &ccServicePlan)
+	if err != nil {
+		return err
+	}
+
+	servicePlan.GUID = ccServicePlan.Metadata.GUID
+	servicePlan.Name = ccServicePlan.Entity.Name
+	servicePlan.Description = ccServicePlan.Entity.Description
+	servicePlan.ServiceGUID = ccServicePlan.Entity.ServiceGUID
+	servicePlan.Free = ccServicePlan.Entity.Free
+	servicePlan.Public = ccServicePlan.Entity.Public
+	return nil
+}
+
+// GetServicePlan returns the Service Plan with the provided GUID.
+func (client *Client) GetServicePlan(servicePlanGUID string) (ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanRequest,
+		URIParams:   Params{"service_plan_guid": servicePlanGUID},
+	})
+	if err != nil {
+		return ServicePlan{}, nil, err
+	}
+
+	var servicePlan ServicePlan
+	response := cloudcontroller.Response{
+		Result: &servicePlan,
+	}
+
+	err = client.connection.Make(request, &response)
+	return servicePlan, response.Warnings, err
+}
+
+// GetServicePlans returns back a list of Service Plans based off of the
+// provided queries.
+func (client *Client) GetServicePlans(queries []Query) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicePlansList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if plan, ok := item.(ServicePlan); ok {
+			fullServicePlansList = append(fullServicePlansList, plan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicePlansList, warnings, err
+}
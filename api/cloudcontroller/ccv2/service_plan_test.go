@@ -0,0 +1,140 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlan", func() {
+		Context("when the service plan exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-plan-guid"
+					},
+					"entity": {
+						"name": "some-service-plan"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plan and warnings", func() {
+				servicePlan, warnings, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(servicePlan).To(Equal(ServicePlan{
+					GUID: "some-service-plan-guid",
+					Name: "some-service-plan",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service plan does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 110003,
+					"description": "The service plan could not be found: some-service-plan-guid",
+					"error_code": "CF-ServicePlanNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a not found error and warnings", func() {
+				_, warnings, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service plan could not be found: some-service-plan-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServicePlans", func() {
+		Context("when service plans exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-plan-guid-1"
+							},
+							"entity": {
+								"name": "some-service-plan-1",
+								"description": "some description",
+								"service_guid": "some-service-guid",
+								"free": true,
+								"public": true
+							}
+						},
+						{
+							"metadata": {
+								"guid": "some-service-plan-guid-2"
+							},
+							"entity": {
+								"name": "some-service-plan-2",
+								"service_guid": "some-service-guid",
+								"free": false,
+								"public": false
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plans and warnings", func() {
+				servicePlans, warnings, err := client.GetServicePlans([]Query{{
+					Filter:   ServiceGUIDFilter,
+					Operator: EqualOperator,
+					Value:    "some-service-guid",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(servicePlans).To(Equal([]ServicePlan{
+					{
+						GUID:        "some-service-plan-guid-1",
+						Name:        "some-service-plan-1",
+						Description: "some description",
+						ServiceGUID: "some-service-guid",
+						Free:        true,
+						Public:      true,
+					},
+					{
+						GUID:        "some-service-plan-guid-2",
+						Name:        "some-service-plan-2",
+						ServiceGUID: "some-service-guid",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServices", func() {
+		Context("when services exist", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/services?q=label:some-label&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-guid-1"
+							},
+							"entity": {
+								"label": "some-label",
+								"description": "some description",
+								"service_broker_guid": "some-broker-guid",
+								"active": true
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-guid-2"
+							},
+							"entity": {
+								"label": "some-label",
+								"description": "some other description",
+								"service_broker_guid": "some-broker-guid",
+								"active": false
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-label"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-label&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the services and all warnings", func() {
+				services, warnings, err := client.GetServices([]Query{{
+					Filter:   "label",
+					Operator: EqualOperator,
+					Value:    "some-label",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(services).To(Equal([]Service{
+					{
+						GUID:              "some-service-guid-1",
+						Label:             "some-label",
+						Description:       "some description",
+						ServiceBrokerGUID: "some-broker-guid",
+						Active:            true,
+					},
+					{
+						GUID:              "some-service-guid-2",
+						Label:             "some-label",
+						Description:       "some other description",
+						ServiceBrokerGUID: "some-broker-guid",
+						Active:            false,
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServices(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceServices", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-guid"
+						},
+						"entity": {
+							"label": "some-label",
+							"active": true
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/services"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the services visible in the space and warnings", func() {
+			services, warnings, err := client.GetSpaceServices("some-space-guid", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal([]Service{
+				{GUID: "some-service-guid", Label: "some-label", Active: true},
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+})
@@ -1,8 +1,10 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -42,6 +44,39 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid.go.template delete_space.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid_test.go.template delete_space_test.go
 
+// CreateSpace creates a space with the given name in the organization. The
+// space quota is only sent if quotaGUID is not nil.
+func (client *Client) CreateSpace(spaceName string, orgGUID string, quotaGUID *string) (Space, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name                     string  `json:"name"`
+		OrganizationGUID         string  `json:"organization_guid"`
+		SpaceQuotaDefinitionGUID *string `json:"space_quota_definition_guid,omitempty"`
+	}{
+		Name:                     spaceName,
+		OrganizationGUID:         orgGUID,
+		SpaceQuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
+
 // GetSpaces returns a list of Spaces based off of the provided queries.
 func (client *Client) GetSpaces(queries []Query) ([]Space, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -121,3 +156,21 @@ func (client *Client) GetRunningSpacesBySecurityGroup(securityGroupGUID string)
 
 	return fullSpacesList, warnings, err
 }
+
+// UpdateSpaceDeveloper assigns the space developer role to the user with the
+// given GUID.
+func (client *Client) UpdateSpaceDeveloper(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.putRelationship(internal.PutSpaceDeveloperRequest, Params{
+		"space_guid":     spaceGUID,
+		"developer_guid": userGUID,
+	})
+}
+
+// UpdateSpaceManager assigns the space manager role to the user with the given
+// GUID.
+func (client *Client) UpdateSpaceManager(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.putRelationship(internal.PutSpaceManagerRequest, Params{
+		"space_guid":   spaceGUID,
+		"manager_guid": userGUID,
+	})
+}
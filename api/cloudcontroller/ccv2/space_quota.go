@@ -7,9 +7,22 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
+// UnlimitedQuota is the value the Cloud Controller uses for a quota limit
+// that is not enforced.
+const UnlimitedQuota = -1
+
+// SpaceQuota represents a Cloud Controller Space Quota.
 type SpaceQuota struct {
 	GUID string
 	Name string
+
+	// MemoryLimit is the total memory, in megabytes, that started app
+	// instances in the space may use.
+	MemoryLimit int
+
+	// AppInstanceLimit is the total number of started app instances allowed in
+	// the space, or UnlimitedQuota.
+	AppInstanceLimit int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +30,9 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			MemoryLimit      int    `json:"memory_limit"`
+			AppInstanceLimit int    `json:"app_instance_limit"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,6 +41,8 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.MemoryLimit = ccSpaceQuota.Entity.MemoryLimit
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
 	return nil
 }
 
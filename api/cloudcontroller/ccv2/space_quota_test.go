@@ -27,7 +27,9 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 1024,
+						"app_instance_limit": -1
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +45,10 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:             "space-quota",
+					GUID:             "space-quota-guid",
+					MemoryLimit:      1024,
+					AppInstanceLimit: UnlimitedQuota,
 				}))
 			})
 		})
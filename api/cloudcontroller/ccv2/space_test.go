@@ -437,4 +437,128 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpace", func() {
+		var (
+			quotaGUID *string
+
+			space      Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			space, warnings, executeErr = client.CreateSpace("some-space", "some-org-guid", quotaGUID)
+		})
+
+		Context("when the space is created", func() {
+			var expectedBody string
+
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "some-org-guid",
+						"space_quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						func(w http.ResponseWriter, r *http.Request) {
+							VerifyJSON(expectedBody)(w, r)
+						},
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			Context("when a quota is provided", func() {
+				BeforeEach(func() {
+					quota := "some-quota-guid"
+					quotaGUID = &quota
+					expectedBody = `{"name": "some-space", "organization_guid": "some-org-guid", "space_quota_definition_guid": "some-quota-guid"}`
+				})
+
+				It("creates the space with the quota and returns all warnings", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(space).To(Equal(Space{
+						GUID:                     "some-space-guid",
+						Name:                     "some-space",
+						OrganizationGUID:         "some-org-guid",
+						SpaceQuotaDefinitionGUID: "some-quota-guid",
+					}))
+					Expect(warnings).To(ConsistOf("warning-1"))
+				})
+			})
+
+			Context("when the quota is nil", func() {
+				BeforeEach(func() {
+					quotaGUID = nil
+					expectedBody = `{"name": "some-space", "organization_guid": "some-org-guid"}`
+				})
+
+				It("omits the quota from the request", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(server.ReceivedRequests()).To(HaveLen(2))
+				})
+			})
+		})
+
+		Context("when the space name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns a SpaceNameTakenError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.SpaceNameTakenError{
+					Message: "The app space name is taken: some-space",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceDeveloper", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/developers/some-user-guid"),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				))
+		})
+
+		It("makes the user a developer of the space and returns all warnings", func() {
+			warnings, err := client.UpdateSpaceDeveloper("some-space-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("UpdateSpaceManager", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/managers/some-user-guid"),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				))
+		})
+
+		It("makes the user a manager of the space and returns all warnings", func() {
+			warnings, err := client.UpdateSpaceManager("some-space-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
 })
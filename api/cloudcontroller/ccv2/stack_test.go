@@ -172,6 +172,73 @@ var _ = Describe("Stack", func() {
 			})
 		})
 
+		Context("when filtering with the IN operator", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/stacks", "q=guid IN some-guid-1,some-guid-2"),
+						RespondWith(http.StatusOK, `{"next_url": null, "resources": []}`, nil),
+					))
+			})
+
+			It("joins the filter, operator and values", func() {
+				_, _, err := client.GetStacks([]Query{{
+					Filter:   GUIDFilter,
+					Operator: InOperator,
+					Value:    "some-guid-1,some-guid-2",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the filter value contains special characters", func() {
+			var expectedRawQuery string
+
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-stack-guid"
+							},
+							"entity": {
+								"name": "some-stack-name",
+								"description": "some stack description"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						func(_ http.ResponseWriter, req *http.Request) {
+							Expect(req.URL.RawQuery).To(Equal(expectedRawQuery))
+						},
+						RespondWith(http.StatusOK, response, nil),
+					))
+			})
+
+			It("percent-encodes spaces and the field separator", func() {
+				expectedRawQuery = "q=name%3Acflinuxfs3%20test"
+				_, _, err := client.GetStacks([]Query{{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Value:    "cflinuxfs3 test",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("percent-encodes commas, semicolons and plus signs", func() {
+				expectedRawQuery = "q=name%3Acflinuxfs3%2Ctest%3Bstack%2B1"
+				_, _, err := client.GetStacks([]Query{{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Value:    "cflinuxfs3,test;stack+1",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("when an error is encountered", func() {
 			BeforeEach(func() {
 				response := `{
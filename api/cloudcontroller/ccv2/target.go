@@ -15,6 +15,10 @@ type TargetSettings struct {
 	// Controller.
 	DialTimeout time.Duration
 
+	// RequestTimeout is the maximum time each request to the Cloud Controller
+	// may take. Zero means no timeout.
+	RequestTimeout time.Duration
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -36,6 +40,7 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 
 	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
 		DialTimeout:       settings.DialTimeout,
+		RequestTimeout:    settings.RequestTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
 	})
 
@@ -48,6 +53,9 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 		return warnings, err
 	}
 
+	client.appSSHEndpoint = info.AppSSHEndpoint
+	client.appSSHHostKeyFingerprint = info.AppSSHHostKeyFingerprint
+	client.appSSHOAuthClient = info.AppSSHOAuthClient
 	client.authorizationEndpoint = info.AuthorizationEndpoint
 	client.cloudControllerAPIVersion = info.APIVersion
 	client.dopplerEndpoint = info.DopplerEndpoint
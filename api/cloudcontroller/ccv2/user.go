@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
 // User represents a Cloud Controller User.
 type User struct {
-	GUID string
+	GUID     string
+	Username string
 }
 
 // userRequestBody represents the body of the request.
@@ -22,12 +24,16 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccUser); err != nil {
 		return err
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -61,3 +67,46 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// GetSpaceAuditors returns the users with the auditor role in the provided
+// space.
+func (client *Client) GetSpaceAuditors(spaceGUID string) ([]User, Warnings, error) {
+	return client.getSpaceUsers(internal.GetSpaceAuditorsRequest, spaceGUID)
+}
+
+// GetSpaceDevelopers returns the users with the developer role in the
+// provided space.
+func (client *Client) GetSpaceDevelopers(spaceGUID string) ([]User, Warnings, error) {
+	return client.getSpaceUsers(internal.GetSpaceDevelopersRequest, spaceGUID)
+}
+
+// GetSpaceManagers returns the users with the manager role in the provided
+// space.
+func (client *Client) GetSpaceManagers(spaceGUID string) ([]User, Warnings, error) {
+	return client.getSpaceUsers(internal.GetSpaceManagersRequest, spaceGUID)
+}
+
+func (client *Client) getSpaceUsers(requestName string, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   map[string]string{"guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
 )
@@ -87,4 +88,70 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	DescribeTable("getting space users by role",
+		func(path string, getUsers func(*Client, string) ([]User, Warnings, error)) {
+			response1 := `{
+				"next_url": "` + path + `?page=2",
+				"resources": [
+					{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "user-1"}},
+					{"metadata": {"guid": "user-guid-2"}, "entity": {}}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{"metadata": {"guid": "user-guid-3"}, "entity": {}}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, path),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, path, "page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+				),
+			)
+
+			users, warnings, err := getUsers(client, "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(Equal([]User{
+				{GUID: "user-guid-1", Username: "user-1"},
+				{GUID: "user-guid-2"},
+				{GUID: "user-guid-3"},
+			}))
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		},
+
+		Entry("GetSpaceAuditors", "/v2/spaces/some-space-guid/auditors", (*Client).GetSpaceAuditors),
+		Entry("GetSpaceDevelopers", "/v2/spaces/some-space-guid/developers", (*Client).GetSpaceDevelopers),
+		Entry("GetSpaceManagers", "/v2/spaces/some-space-guid/managers", (*Client).GetSpaceManagers),
+	)
+
+	Describe("GetSpaceDevelopers", func() {
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40004,
+					"description": "The app space could not be found: some-space-guid",
+					"error_code": "CF-SpaceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns the errors and all warnings", func() {
+				_, warnings, err := client.GetSpaceDevelopers("some-space-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app space could not be found: some-space-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
 })
@@ -17,6 +17,7 @@ type Application struct {
 	GUID          string
 	State         string
 	Buildpacks    []string
+	Metadata      Metadata
 }
 
 func (a Application) MarshalJSON() ([]byte, error) {
@@ -63,6 +64,7 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 				Buildpacks []string `json:"buildpacks"`
 			} `json:"data"`
 		} `json:"lifecycle,omitempty"`
+		Metadata Metadata `json:"metadata"`
 	}
 
 	if err := json.Unmarshal(data, &ccApp); err != nil {
@@ -73,6 +75,7 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 	a.GUID = ccApp.GUID
 	a.State = ccApp.State
 	a.Buildpacks = ccApp.Lifecycle.Data.Buildpacks
+	a.Metadata = ccApp.Metadata
 
 	return nil
 }
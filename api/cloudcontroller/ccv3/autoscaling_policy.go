@@ -0,0 +1,57 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// GetApplicationAutoscalingPolicy returns the raw scaling policy of the
+// application from the autoscaler linked from /v3. An
+// AutoscalerNotFoundError is returned if there is no such link.
+func (client *Client) GetApplicationAutoscalingPolicy(appGUID string) (json.RawMessage, Warnings, error) {
+	if !client.router.HasResource(internal.AppAutoscalerResource) {
+		return nil, nil, ccerror.AutoscalerNotFoundError{}
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationAutoscalingPolicyRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	return json.RawMessage(response.RawResponse), response.Warnings, nil
+}
+
+// UpdateApplicationAutoscalingPolicy replaces the scaling policy of the
+// application on the autoscaler linked from /v3. An AutoscalerNotFoundError
+// is returned if there is no such link.
+func (client *Client) UpdateApplicationAutoscalingPolicy(appGUID string, policy json.RawMessage) (Warnings, error) {
+	if !client.router.HasResource(internal.AppAutoscalerResource) {
+		return nil, ccerror.AutoscalerNotFoundError{}
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutApplicationAutoscalingPolicyRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Body:        bytes.NewReader(policy),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
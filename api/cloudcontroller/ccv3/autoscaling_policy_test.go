@@ -0,0 +1,136 @@
+package ccv3_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Autoscaling Policy", func() {
+	var client *Client
+
+	// newAutoscalerTestClient targets a Cloud Controller whose /v3 links
+	// include an application autoscaler.
+	newAutoscalerTestClient := func() *Client {
+		rootResponse := strings.Replace(`{
+			"links": {
+				"cloud_controller_v3": {
+					"href": "SERVER_URL/v3",
+					"meta": {
+						"version": "3.0.0-alpha.5"
+					}
+				}
+			}
+		}`, "SERVER_URL", server.URL(), -1)
+		v3Response := strings.Replace(`{
+			"links": {
+				"apps": {
+					"href": "SERVER_URL/v3/apps"
+				},
+				"app_autoscaler": {
+					"href": "SERVER_URL/autoscaler"
+				}
+			}
+		}`, "SERVER_URL", server.URL(), -1)
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/"),
+				RespondWith(http.StatusOK, rootResponse),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/v3"),
+				RespondWith(http.StatusOK, v3Response),
+			),
+		)
+
+		autoscalerClient := NewClient(Config{AppName: "CF CLI API V3 Test", AppVersion: "Unknown"})
+		_, err := autoscalerClient.TargetCF(TargetSettings{
+			SkipSSLValidation: true,
+			URL:               server.URL(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return autoscalerClient
+	}
+
+	Context("when the Cloud Controller links to an autoscaler", func() {
+		BeforeEach(func() {
+			client = newAutoscalerTestClient()
+		})
+
+		Describe("GetApplicationAutoscalingPolicy", func() {
+			Context("when the autoscaler returns the policy", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/autoscaler/v1/apps/some-app-guid/policy"),
+							RespondWith(http.StatusOK, `{"instance_min_count":1,"instance_max_count":4}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("returns the raw policy and warnings", func() {
+					policy, warnings, err := client.GetApplicationAutoscalingPolicy("some-app-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(policy).To(MatchJSON(`{"instance_min_count":1,"instance_max_count":4}`))
+					Expect(warnings).To(ConsistOf("this is a warning"))
+				})
+			})
+
+			Context("when the autoscaler returns an error", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/autoscaler/v1/apps/some-app-guid/policy"),
+							RespondWith(http.StatusTeapot, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("returns the error and warnings", func() {
+					_, warnings, err := client.GetApplicationAutoscalingPolicy("some-app-guid")
+					Expect(err).To(HaveOccurred())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+				})
+			})
+		})
+
+		Describe("UpdateApplicationAutoscalingPolicy", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/autoscaler/v1/apps/some-app-guid/policy"),
+						VerifyJSON(`{"instance_min_count":2,"instance_max_count":5}`),
+						RespondWith(http.StatusOK, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends the policy and returns warnings", func() {
+				warnings, err := client.UpdateApplicationAutoscalingPolicy("some-app-guid", json.RawMessage(`{"instance_min_count":2,"instance_max_count":5}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Context("when the Cloud Controller does not link to an autoscaler", func() {
+		BeforeEach(func() {
+			client = NewTestClient()
+		})
+
+		It("returns an AutoscalerNotFoundError", func() {
+			_, _, err := client.GetApplicationAutoscalingPolicy("some-app-guid")
+			Expect(err).To(MatchError(ccerror.AutoscalerNotFoundError{}))
+
+			_, err = client.UpdateApplicationAutoscalingPolicy("some-app-guid", json.RawMessage(`{}`))
+			Expect(err).To(MatchError(ccerror.AutoscalerNotFoundError{}))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+})
@@ -100,6 +100,18 @@ func SetupV3Response() {
 			"builds": {
 				"href": "SERVER_URL/v3/builds"
 			},
+			"deployments": {
+				"href": "SERVER_URL/v3/deployments"
+			},
+			"droplets": {
+				"href": "SERVER_URL/v3/droplets"
+			},
+			"feature_flags": {
+				"href": "SERVER_URL/v3/feature_flags"
+			},
+			"service_instances": {
+				"href": "SERVER_URL/v3/service_instances"
+			},
 			"organizations": {
 				"href": "SERVER_URL/v3/organizations"
 			},
@@ -0,0 +1,168 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// DeploymentStatusReason is the reason a deployment is in its current state.
+type DeploymentStatusReason string
+
+const (
+	DeploymentStatusReasonDeploying DeploymentStatusReason = "DEPLOYING"
+	DeploymentStatusReasonCanceling DeploymentStatusReason = "CANCELING"
+	DeploymentStatusReasonDeployed  DeploymentStatusReason = "DEPLOYED"
+	DeploymentStatusReasonCanceled  DeploymentStatusReason = "CANCELED"
+)
+
+// Deployment represents a Cloud Controller V3 rolling deployment of an
+// application's droplet.
+type Deployment struct {
+	GUID          string
+	DropletGUID   string
+	RevisionGUID  string
+	StatusReason  DeploymentStatusReason
+	NewProcesses  []Process
+	Relationships Relationships
+}
+
+// MarshalJSON converts a Deployment into a Cloud Controller Deployment.
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	var ccDeployment struct {
+		Droplet *struct {
+			GUID string `json:"guid"`
+		} `json:"droplet,omitempty"`
+		Revision *struct {
+			GUID string `json:"guid"`
+		} `json:"revision,omitempty"`
+		Relationships Relationships `json:"relationships,omitempty"`
+	}
+
+	if d.DropletGUID != "" {
+		ccDeployment.Droplet = &struct {
+			GUID string `json:"guid"`
+		}{GUID: d.DropletGUID}
+	}
+	if d.RevisionGUID != "" {
+		ccDeployment.Revision = &struct {
+			GUID string `json:"guid"`
+		}{GUID: d.RevisionGUID}
+	}
+	ccDeployment.Relationships = d.Relationships
+
+	return json.Marshal(ccDeployment)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		GUID    string `json:"guid"`
+		Droplet struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Revision struct {
+			GUID string `json:"guid"`
+		} `json:"revision"`
+		Status struct {
+			Reason DeploymentStatusReason `json:"reason"`
+		} `json:"status"`
+		NewProcesses  []Process     `json:"new_processes"`
+		Relationships Relationships `json:"relationships"`
+	}
+	if err := json.Unmarshal(data, &ccDeployment); err != nil {
+		return err
+	}
+
+	d.GUID = ccDeployment.GUID
+	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.RevisionGUID = ccDeployment.Revision.GUID
+	d.StatusReason = ccDeployment.Status.Reason
+	d.NewProcesses = ccDeployment.NewProcesses
+	d.Relationships = ccDeployment.Relationships
+
+	return nil
+}
+
+// CreateApplicationDeployment starts a rolling deployment of the given droplet
+// for the application with the given GUID.
+func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (Deployment, Warnings, error) {
+	return client.createDeployment(Deployment{
+		DropletGUID: dropletGUID,
+		Relationships: Relationships{
+			ApplicationRelationship: Relationship{GUID: appGUID},
+		},
+	})
+}
+
+// CreateApplicationDeploymentByRevision starts a rolling deployment of the
+// given revision for the application with the given GUID.
+func (client *Client) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (Deployment, Warnings, error) {
+	return client.createDeployment(Deployment{
+		RevisionGUID: revisionGUID,
+		Relationships: Relationships{
+			ApplicationRelationship: Relationship{GUID: appGUID},
+		},
+	})
+}
+
+func (client *Client) createDeployment(deployment Deployment) (Deployment, Warnings, error) {
+	bodyBytes, err := json.Marshal(deployment)
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (client *Client) GetDeployment(deploymentGUID string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   internal.Params{"guid": deploymentGUID},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// CancelDeployment cancels the deployment with the given GUID, rolling the
+// application back to its previous droplet.
+func (client *Client) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentCancelRequest,
+		URIParams:   internal.Params{"guid": deploymentGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
@@ -0,0 +1,279 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		Context("when the deployment is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"status": {
+						"value": "DEPLOYING",
+						"reason": "DEPLOYING"
+					},
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"droplet": map[string]interface{}{
+						"guid": "some-droplet-guid",
+					},
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				deployment, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:         "some-deployment-guid",
+					DropletGUID:  "some-droplet-guid",
+					StatusReason: DeploymentStatusReasonDeploying,
+					Relationships: Relationships{
+						ApplicationRelationship: Relationship{GUID: "some-app-guid"},
+					},
+				}))
+			})
+		})
+
+		Context("when cc returns back an error or warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "Cannot create deployment from a STOPPED app.",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Cannot create deployment from a STOPPED app."}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationDeploymentByRevision", func() {
+		Context("when the deployment is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"status": {
+						"value": "DEPLOYING",
+						"reason": "DEPLOYING"
+					},
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"revision": {
+						"guid": "some-revision-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"revision": map[string]interface{}{
+						"guid": "some-revision-guid",
+					},
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				deployment, warnings, err := client.CreateApplicationDeploymentByRevision("some-app-guid", "some-revision-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:         "some-deployment-guid",
+					DropletGUID:  "some-droplet-guid",
+					RevisionGUID: "some-revision-guid",
+					StatusReason: DeploymentStatusReasonDeploying,
+					Relationships: Relationships{
+						ApplicationRelationship: Relationship{GUID: "some-app-guid"},
+					},
+				}))
+			})
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		Context("when the deployment exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"status": {
+						"value": "DEPLOYING",
+						"reason": "DEPLOYING"
+					},
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"new_processes": [
+						{
+							"guid": "some-process-guid",
+							"type": "web"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				deployment, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:         "some-deployment-guid",
+					DropletGUID:  "some-droplet-guid",
+					StatusReason: DeploymentStatusReasonDeploying,
+					NewProcesses: []Process{
+						{GUID: "some-process-guid", Type: "web"},
+					},
+				}))
+			})
+		})
+
+		Context("when the deployment does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Deployment not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Deployment not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		Context("when the deployment is canceled successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the warnings", func() {
+				warnings, err := client.CancelDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when cc returns back an error or warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "Cannot cancel a DEPLOYED deployment",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.CancelDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Cannot cancel a DEPLOYED deployment"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -31,3 +31,22 @@ func (client *Client) GetApplicationCurrentDroplet(appGUID string) (Droplet, War
 
 	return responseDroplet, response.Warnings, err
 }
+
+// GetDroplet returns the droplet with the given GUID.
+func (client *Client) GetDroplet(dropletGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletRequest,
+		URIParams:   internal.Params{"guid": dropletGUID},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
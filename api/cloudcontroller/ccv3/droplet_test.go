@@ -78,4 +78,54 @@ var _ = Describe("Droplet", func() {
 			})
 		})
 	})
+
+	Describe("GetDroplet", func() {
+		Context("when the droplet exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-droplet-guid",
+					"stack": "some-stack"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the droplet and all warnings", func() {
+				droplet, warnings, err := client.GetDroplet("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", Stack: "some-stack"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the droplet does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				_, warnings, err := client.GetDroplet("some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Droplet not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -0,0 +1,31 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// FeatureFlag represents a Cloud Controller V3 feature flag.
+type FeatureFlag struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// GetFeatureFlag returns the feature flag with the given name.
+func (client *Client) GetFeatureFlag(flagName string) (FeatureFlag, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetFeatureFlagRequest,
+		URIParams:   internal.Params{"name": flagName},
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	var responseFlag FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &responseFlag,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseFlag, response.Warnings, err
+}
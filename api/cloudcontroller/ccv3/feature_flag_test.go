@@ -0,0 +1,42 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Feature Flag", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetFeatureFlag", func() {
+		BeforeEach(func() {
+			response := `{
+				"name": "service_instance_sharing",
+				"enabled": true,
+				"updated_at": "2018-03-08T23:11:56Z",
+				"custom_error_message": null
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/feature_flags/service_instance_sharing"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the feature flag and warnings", func() {
+			flag, warnings, err := client.GetFeatureFlag("service_instance_sharing")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(flag).To(Equal(FeatureFlag{Name: "service_instance_sharing", Enabled: true}))
+		})
+	})
+})
@@ -13,12 +13,19 @@ import "net/http"
 const (
 	DeleteIsolationSegmentRelationshipOrganizationRequest = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                         = "DeleteIsolationSegment"
+	DeleteServiceInstanceRelationshipsSharedSpaceRequest  = "DeleteServiceInstanceRelationshipsSharedSpace"
 	GetAppDropletCurrent                                  = "GetAppDropletCurrent"
 	GetAppProcessesRequest                                = "GetAppProcesses"
 	GetAppTasksRequest                                    = "GetAppTasks"
+	GetApplicationAutoscalingPolicyRequest                = "GetApplicationAutoscalingPolicy"
+	GetApplicationFeatureRequest                          = "GetApplicationFeature"
 	GetApplicationProcessByTypeRequest                    = "GetApplicationProcessByType"
+	GetApplicationRevisionsRequest                        = "GetApplicationRevisions"
 	GetAppsRequest                                        = "GetApps"
 	GetBuildRequest                                       = "GetBuild"
+	GetDeploymentRequest                                  = "GetDeployment"
+	GetDropletRequest                                     = "GetDroplet"
+	GetFeatureFlagRequest                                 = "GetFeatureFlag"
 	GetProcessInstancesRequest                            = "GetProcessInstances"
 	GetIsolationSegmentOrganizationsRequest               = "GetIsolationSegmentRelationshipOrganizations"
 	GetIsolationSegmentRequest                            = "GetIsolationSegment"
@@ -26,6 +33,7 @@ const (
 	GetOrganizationDefaultIsolationSegmentRequest         = "GetOrganizationDefaultIsolationSegment"
 	GetOrgsRequest                                        = "GetOrgs"
 	GetPackageRequest                                     = "GetPackage"
+	GetServiceInstanceRelationshipsSharedSpacesRequest    = "GetServiceInstanceRelationshipsSharedSpaces"
 	GetSpaceRelationshipIsolationSegmentRequest           = "GetSpaceRelationshipIsolationSegmentRequest"
 	PatchApplicationRequest                               = "PatchApplicationRequest"
 	PatchApplicationCurrentDropletRequest                 = "PatchApplicationCurrentDroplet"
@@ -33,23 +41,33 @@ const (
 	PatchOrganizationDefaultIsolationSegmentRequest       = "PatchOrganizationDefaultIsolationSegmentRequest"
 	PatchSpaceRelationshipIsolationSegmentRequest         = "PatchSpaceRelationshipIsolationSegmentRequest"
 	PostAppTasksRequest                                   = "PostAppTasks"
+	PostApplicationProcessActionScaleRequest              = "PostApplicationProcessActionScale"
 	PostApplicationRequest                                = "PostApplicationRequest"
 	PostApplicationStartRequest                           = "PostApplicationStart"
 	PostApplicationStopRequest                            = "PostApplicationStop"
 	PostBuildRequest                                      = "PostBuild"
+	PostDeploymentCancelRequest                           = "PostDeploymentCancel"
+	PostDeploymentRequest                                 = "PostDeployment"
 	PostIsolationSegmentRelationshipOrganizationsRequest  = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                          = "PostIsolationSegments"
 	PostPackageRequest                                    = "PostPackageRequest"
+	PostServiceInstanceRelationshipsSharedSpacesRequest   = "PostServiceInstanceRelationshipsSharedSpaces"
+	PutApplicationAutoscalingPolicyRequest                = "PutApplicationAutoscalingPolicy"
 	PutTaskCancelRequest                                  = "PutTaskCancelRequest"
 )
 
 const (
+	AppAutoscalerResource     = "app_autoscaler"
 	AppsResource              = "apps"
 	BuildsResource            = "builds"
+	DeploymentsResource       = "deployments"
+	DropletsResource          = "droplets"
+	FeatureFlagsResource      = "feature_flags"
 	IsolationSegmentsResource = "isolation_segments"
 	OrgsResource              = "organizations"
 	PackagesResource          = "packages"
 	ProcessesResource         = "processes"
+	ServiceInstancesResource  = "service_instances"
 	SpaceResource             = "spaces"
 	TasksResource             = "tasks"
 )
@@ -61,21 +79,27 @@ var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetOrgsRequest, Resource: OrgsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostDeploymentRequest, Resource: DeploymentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
 	{Path: "/:guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetDeploymentRequest, Resource: DeploymentsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetDropletRequest, Resource: DropletsResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
 	{Path: "/:guid", Method: http.MethodPatch, Name: PatchApplicationProcessHealthCheckRequest, Resource: ProcessesResource},
 	{Path: "/:guid", Method: http.MethodPatch, Name: PatchApplicationRequest, Resource: AppsResource},
+	{Path: "/:guid/actions/cancel", Method: http.MethodPost, Name: PostDeploymentCancelRequest, Resource: DeploymentsResource},
 	{Path: "/:guid/actions/start", Method: http.MethodPost, Name: PostApplicationStartRequest, Resource: AppsResource},
 	{Path: "/:guid/actions/stop", Method: http.MethodPost, Name: PostApplicationStopRequest, Resource: AppsResource},
 	{Path: "/:guid/cancel", Method: http.MethodPut, Name: PutTaskCancelRequest, Resource: TasksResource},
 	{Path: "/:guid/droplets/current", Method: http.MethodGet, Name: GetAppDropletCurrent, Resource: AppsResource},
+	{Path: "/:guid/features/:name", Method: http.MethodGet, Name: GetApplicationFeatureRequest, Resource: AppsResource},
 	{Path: "/:guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
 	{Path: "/:guid/processes/:type", Method: http.MethodGet, Name: GetApplicationProcessByTypeRequest, Resource: AppsResource},
+	{Path: "/:guid/processes/:type/actions/scale", Method: http.MethodPost, Name: PostApplicationProcessActionScaleRequest, Resource: AppsResource},
 	{Path: "/:guid/relationships/current_droplet", Method: http.MethodPatch, Name: PatchApplicationCurrentDropletRequest, Resource: AppsResource},
 	{Path: "/:guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
 	{Path: "/:guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
@@ -83,7 +107,14 @@ var APIRoutes = []Route{
 	{Path: "/:guid/relationships/isolation_segment", Method: http.MethodPatch, Name: PatchSpaceRelationshipIsolationSegmentRequest, Resource: SpaceResource},
 	{Path: "/:guid/relationships/organizations", Method: http.MethodPost, Name: PostIsolationSegmentRelationshipOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid/relationships/organizations/:org_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:guid/relationships/shared_spaces", Method: http.MethodGet, Name: GetServiceInstanceRelationshipsSharedSpacesRequest, Resource: ServiceInstancesResource},
+	{Path: "/:guid/relationships/shared_spaces", Method: http.MethodPost, Name: PostServiceInstanceRelationshipsSharedSpacesRequest, Resource: ServiceInstancesResource},
+	{Path: "/:guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRelationshipsSharedSpaceRequest, Resource: ServiceInstancesResource},
+	{Path: "/:guid/revisions", Method: http.MethodGet, Name: GetApplicationRevisionsRequest, Resource: AppsResource},
 	{Path: "/:guid/stats", Method: http.MethodGet, Name: GetProcessInstancesRequest, Resource: ProcessesResource},
 	{Path: "/:guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:guid/tasks", Method: http.MethodPost, Name: PostAppTasksRequest, Resource: AppsResource},
+	{Path: "/:name", Method: http.MethodGet, Name: GetFeatureFlagRequest, Resource: FeatureFlagsResource},
+	{Path: "/v1/apps/:guid/policy", Method: http.MethodGet, Name: GetApplicationAutoscalingPolicyRequest, Resource: AppAutoscalerResource},
+	{Path: "/v1/apps/:guid/policy", Method: http.MethodPut, Name: PutApplicationAutoscalingPolicyRequest, Resource: AppAutoscalerResource},
 }
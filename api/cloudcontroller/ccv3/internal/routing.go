@@ -137,6 +137,12 @@ func (router Router) CreateRequest(name string, params Params, body io.Reader) (
 	return http.NewRequest(route.Method, url, body)
 }
 
+// HasResource returns true if the router knows the root of the resource.
+func (router Router) HasResource(name string) bool {
+	_, ok := router.resources[name]
+	return ok
+}
+
 func (Router) urlFrom(resource string, uri string) (string, error) {
 	u, err := url.Parse(resource)
 	if err != nil {
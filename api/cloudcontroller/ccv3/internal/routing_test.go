@@ -122,5 +122,18 @@ var _ = Describe("Routing", func() {
 				})
 			})
 		})
+
+		Describe("HasResource", func() {
+			BeforeEach(func() {
+				resources = map[string]string{
+					"exists": "https://foo.bar.baz/this/is",
+				}
+			})
+
+			It("returns whether the resource root is known", func() {
+				Expect(router.HasResource("exists")).To(BeTrue())
+				Expect(router.HasResource("fake-resource")).To(BeFalse())
+			})
+		})
 	})
 })
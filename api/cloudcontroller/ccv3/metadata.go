@@ -0,0 +1,50 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Metadata represents the labels and annotations of a Cloud Controller V3
+// resource.
+type Metadata struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// UpdateApplicationLabels sets the labels of the application with the given
+// GUID. Labels with a nil value are removed from the application; labels not
+// in the map are left unchanged.
+func (client *Client) UpdateApplicationLabels(appGUID string, labels map[string]*string) (Application, Warnings, error) {
+	var body struct {
+		Metadata struct {
+			Labels map[string]*string `json:"labels"`
+		} `json:"metadata"`
+	}
+	body.Metadata.Labels = labels
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationRequest,
+		URIParams:   map[string]string{"guid": appGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	var responseApp Application
+	response := cloudcontroller.Response{
+		Result: &responseApp,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseApp, response.Warnings, err
+}
@@ -0,0 +1,113 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Metadata", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("UpdateApplicationLabels", func() {
+		var (
+			labels map[string]*string
+
+			app        Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			value := "production"
+			labels = map[string]*string{
+				"env":                 &value,
+				"example.com/old-key": nil,
+			}
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, executeErr = client.UpdateApplicationLabels("some-app-guid", labels)
+		})
+
+		Context("when the labels are successfully updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-app-guid",
+					"name": "some-app-name",
+					"metadata": {
+						"labels": {
+							"env": "production"
+						},
+						"annotations": {
+							"contact": "someone@example.com"
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"env":                 "production",
+							"example.com/old-key": nil,
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated app with its metadata and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(app).To(Equal(Application{
+					Name: "some-app-name",
+					GUID: "some-app-guid",
+					Metadata: Metadata{
+						Labels:      map[string]string{"env": "production"},
+						Annotations: map[string]string{"contact": "someone@example.com"},
+					},
+				}))
+			})
+		})
+
+		Context("when cc returns back an error or warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "Metadata key error: label key cannot be empty string",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Metadata key error: label key cannot be empty string"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -12,7 +12,9 @@ import (
 type Process struct {
 	GUID        string             `json:"guid"`
 	Type        string             `json:"type"`
+	Instances   int                `json:"instances"`
 	MemoryInMB  int                `json:"memory_in_mb"`
+	DiskInMB    int                `json:"disk_in_mb"`
 	HealthCheck ProcessHealthCheck `json:"health_check"`
 }
 
@@ -89,6 +91,43 @@ func (client *Client) GetApplicationProcessByType(appGUID string, processType st
 	return process, response.Warnings, err
 }
 
+// ProcessScale is the set of properties to change when scaling a process. Nil
+// properties are left unchanged.
+type ProcessScale struct {
+	Instances  *int `json:"instances,omitempty"`
+	MemoryInMB *int `json:"memory_in_mb,omitempty"`
+	DiskInMB   *int `json:"disk_in_mb,omitempty"`
+}
+
+// ScaleApplicationProcess scales the process of the given type for the
+// application with the given GUID and returns the scaled process.
+func (client *Client) ScaleApplicationProcess(appGUID string, processType string, scale ProcessScale) (Process, Warnings, error) {
+	body, err := json.Marshal(scale)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostApplicationProcessActionScaleRequest,
+		Body:        bytes.NewReader(body),
+		URIParams: internal.Params{
+			"guid": appGUID,
+			"type": processType,
+		},
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	var process Process
+	response := cloudcontroller.Response{
+		Result: &process,
+	}
+
+	err = client.connection.Make(request, &response)
+	return process, response.Warnings, err
+}
+
 // PatchApplicationProcessHealthCheck updates application health check type
 func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (Warnings, error) {
 	body, err := json.Marshal(Process{
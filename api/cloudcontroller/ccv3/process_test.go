@@ -407,4 +407,95 @@ var _ = Describe("Process", func() {
 			})
 		})
 	})
+
+	Describe("ScaleApplicationProcess", func() {
+		var (
+			scale    ProcessScale
+			process  Process
+			warnings []string
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			process, warnings, err = client.ScaleApplicationProcess("some-app-guid", "worker", scale)
+		})
+
+		Context("when only the instances are provided", func() {
+			BeforeEach(func() {
+				instances := 3
+				scale = ProcessScale{Instances: &instances}
+
+				response := `{
+					"guid": "process-1-guid",
+					"type": "worker",
+					"instances": 3,
+					"memory_in_mb": 32,
+					"disk_in_mb": 1024
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/processes/worker/actions/scale"),
+						VerifyJSON(`{"instances": 3}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("omits memory and disk and returns the scaled process", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(process).To(Equal(Process{
+					GUID:       "process-1-guid",
+					Type:       "worker",
+					Instances:  3,
+					MemoryInMB: 32,
+					DiskInMB:   1024,
+				}))
+			})
+		})
+
+		Context("when all properties are provided", func() {
+			BeforeEach(func() {
+				instances, memory, disk := 0, 64, 512
+				scale = ProcessScale{Instances: &instances, MemoryInMB: &memory, DiskInMB: &disk}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/processes/worker/actions/scale"),
+						VerifyJSON(`{"instances": 0, "memory_in_mb": 64, "disk_in_mb": 512}`),
+						RespondWith(http.StatusAccepted, `{"guid": "process-1-guid"}`),
+					),
+				)
+			})
+
+			It("sends every property, including zero values", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/processes/worker/actions/scale"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Process not found"}))
+			})
+		})
+	})
 })
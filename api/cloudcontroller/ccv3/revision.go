@@ -0,0 +1,105 @@
+package ccv3
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Revision represents a Cloud Controller V3 application revision, which is
+// recorded each time the application's droplet, environment variables or
+// start commands change.
+type Revision struct {
+	GUID        string
+	Version     int
+	DropletGUID string
+	Description string
+	CreatedAt   string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller V3 revision response.
+func (revision *Revision) UnmarshalJSON(data []byte) error {
+	var ccRevision struct {
+		GUID    string `json:"guid"`
+		Version int    `json:"version"`
+		Droplet struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Description string `json:"description"`
+		CreatedAt   string `json:"created_at"`
+	}
+	if err := json.Unmarshal(data, &ccRevision); err != nil {
+		return err
+	}
+
+	revision.GUID = ccRevision.GUID
+	revision.Version = ccRevision.Version
+	revision.DropletGUID = ccRevision.Droplet.GUID
+	revision.Description = ccRevision.Description
+	revision.CreatedAt = ccRevision.CreatedAt
+
+	return nil
+}
+
+// ApplicationFeature represents an optional feature of a Cloud Controller V3
+// application, such as revisions or SSH.
+type ApplicationFeature struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// GetApplicationFeature returns the named feature of the application with the
+// provided GUID.
+func (client *Client) GetApplicationFeature(appGUID string, featureName string) (ApplicationFeature, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationFeatureRequest,
+		URIParams: internal.Params{
+			"guid": appGUID,
+			"name": featureName,
+		},
+	})
+	if err != nil {
+		return ApplicationFeature{}, nil, err
+	}
+
+	var feature ApplicationFeature
+	response := cloudcontroller.Response{
+		Result: &feature,
+	}
+
+	err = client.connection.Make(request, &response)
+	return feature, response.Warnings, err
+}
+
+// GetApplicationRevisions returns the revisions of the application with the
+// provided GUID. Results can be filtered by providing URL queries.
+func (client *Client) GetApplicationRevisions(appGUID string, query url.Values) ([]Revision, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationRevisionsRequest,
+		URIParams: internal.Params{
+			"guid": appGUID,
+		},
+		Query: query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRevisionsList []Revision
+	warnings, err := client.paginate(request, Revision{}, func(item interface{}) error {
+		if revision, ok := item.(Revision); ok {
+			fullRevisionsList = append(fullRevisionsList, revision)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Revision{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRevisionsList, warnings, err
+}
@@ -0,0 +1,134 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Revision", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationRevisions", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/apps/some-app-guid/revisions?versions=1,2&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "revision-1-guid",
+							"version": 1,
+							"droplet": {"guid": "droplet-1-guid"},
+							"description": "Initial revision.",
+							"created_at": "2018-03-08T23:11:56Z"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "revision-2-guid",
+							"version": 2,
+							"droplet": {"guid": "droplet-2-guid"},
+							"description": "New droplet deployed.",
+							"created_at": "2018-03-09T23:11:56Z"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=1,2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=1,2&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the revisions and all warnings", func() {
+				revisions, warnings, err := client.GetApplicationRevisions("some-app-guid", url.Values{"versions": []string{"1,2"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(revisions).To(Equal([]Revision{
+					{
+						GUID:        "revision-1-guid",
+						Version:     1,
+						DropletGUID: "droplet-1-guid",
+						Description: "Initial revision.",
+						CreatedAt:   "2018-03-08T23:11:56Z",
+					},
+					{
+						GUID:        "revision-2-guid",
+						Version:     2,
+						DropletGUID: "droplet-2-guid",
+						Description: "New droplet deployed.",
+						CreatedAt:   "2018-03-09T23:11:56Z",
+					},
+				}))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				_, warnings, err := client.GetApplicationRevisions("some-app-guid", nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetApplicationFeature", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/features/revisions"),
+					RespondWith(http.StatusOK, `{"name": "revisions", "description": "Enable versioning of an application", "enabled": true}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the feature and warnings", func() {
+			feature, warnings, err := client.GetApplicationFeature("some-app-guid", "revisions")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(feature).To(Equal(ApplicationFeature{Name: "revisions", Enabled: true}))
+		})
+	})
+})
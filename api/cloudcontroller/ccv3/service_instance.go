@@ -0,0 +1,72 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// GetServiceInstanceSharedSpaces returns the spaces the service instance with
+// the given GUID is shared with.
+func (client *Client) GetServiceInstanceSharedSpaces(serviceInstanceGUID string) (RelationshipList, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceRelationshipsSharedSpacesRequest,
+		URIParams:   internal.Params{"guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	var relationships RelationshipList
+	response := cloudcontroller.Response{
+		Result: &relationships,
+	}
+	err = client.connection.Make(request, &response)
+
+	return relationships, response.Warnings, err
+}
+
+// ShareServiceInstanceToSpaces shares the service instance with the given
+// GUID with the given spaces and returns all the spaces it is shared with.
+func (client *Client) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (RelationshipList, Warnings, error) {
+	body, err := json.Marshal(RelationshipList{GUIDs: spaceGUIDs})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstanceRelationshipsSharedSpacesRequest,
+		URIParams:   internal.Params{"guid": serviceInstanceGUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	var relationships RelationshipList
+	response := cloudcontroller.Response{
+		Result: &relationships,
+	}
+	err = client.connection.Make(request, &response)
+
+	return relationships, response.Warnings, err
+}
+
+// UnshareServiceInstanceFromSpace stops sharing the service instance with the
+// given GUID with the given space.
+func (client *Client) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRelationshipsSharedSpaceRequest,
+		URIParams:   internal.Params{"guid": serviceInstanceGUID, "space_guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
@@ -0,0 +1,116 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Instance", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceInstanceSharedSpaces", func() {
+		BeforeEach(func() {
+			response := `{
+				"data": [
+					{"guid": "space-guid-1"},
+					{"guid": "space-guid-2"}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/service_instances/some-instance-guid/relationships/shared_spaces"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the shared spaces and warnings", func() {
+			relationships, warnings, err := client.GetServiceInstanceSharedSpaces("some-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(relationships).To(Equal(RelationshipList{GUIDs: []string{"space-guid-1", "space-guid-2"}}))
+		})
+	})
+
+	Describe("ShareServiceInstanceToSpaces", func() {
+		Context("when the share is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{"guid": "space-guid-1"},
+						{"guid": "space-guid-2"}
+					]
+				}`
+				requestBody := map[string][]map[string]string{
+					"data": {{"guid": "space-guid-2"}},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-instance-guid/relationships/shared_spaces"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all shared spaces and warnings", func() {
+				relationships, warnings, err := client.ShareServiceInstanceToSpaces("some-instance-guid", []string{"space-guid-2"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationships).To(Equal(RelationshipList{GUIDs: []string{"space-guid-1", "space-guid-2"}}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "A service instance called some-instance already exists in space-2.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-instance-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.ShareServiceInstanceToSpaces("some-instance-guid", []string{"space-guid-2"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "A service instance called some-instance already exists in space-2."}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromSpace", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/service_instances/some-instance-guid/relationships/shared_spaces/space-guid-2"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("unshares the instance and returns warnings", func() {
+			warnings, err := client.UnshareServiceInstanceFromSpace("some-instance-guid", "space-guid-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+})
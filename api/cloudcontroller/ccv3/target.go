@@ -14,6 +14,10 @@ type TargetSettings struct {
 	// Controller.
 	DialTimeout time.Duration
 
+	// RequestTimeout is the maximum time each request to the Cloud Controller
+	// may take. Zero means no timeout.
+	RequestTimeout time.Duration
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -34,6 +38,7 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 
 	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
 		DialTimeout:       settings.DialTimeout,
+		RequestTimeout:    settings.RequestTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
 	})
 
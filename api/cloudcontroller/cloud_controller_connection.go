@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util"
 )
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -24,7 +25,10 @@ type CloudControllerConnection struct {
 
 // Config is for configuring a CloudControllerConnection.
 type Config struct {
-	DialTimeout       time.Duration
+	DialTimeout time.Duration
+	// RequestTimeout is the maximum time a request, including reading the
+	// response body, may take. Zero means no timeout.
+	RequestTimeout    time.Duration
 	SkipSSLValidation bool
 }
 
@@ -35,15 +39,18 @@ func NewConnection(config Config) *CloudControllerConnection {
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: config.SkipSSLValidation,
 		},
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
+		Proxy: util.UnixSocketProxy(http.ProxyFromEnvironment),
+		DialContext: util.UnixSocketDialContext((&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   config.DialTimeout,
-		}).DialContext,
+		}).DialContext),
 	}
 
 	return &CloudControllerConnection{
-		HTTPClient: &http.Client{Transport: tr},
+		HTTPClient: &http.Client{
+			Transport: tr,
+			Timeout:   config.RequestTimeout,
+		},
 	}
 }
 
@@ -54,6 +61,11 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 	// error and we don't repopulate it in populateResponse.
 	passedResponse.reset()
 
+	err := util.UnixSocketRequest(request.Request)
+	if err != nil {
+		return ccerror.RequestError{Err: err}
+	}
+
 	response, err := connection.HTTPClient.Do(request.Request)
 	if err != nil {
 		return connection.processRequestErrors(request.Request, err)
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"runtime"
 	"strings"
+	"time"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -164,6 +165,31 @@ var _ = Describe("Cloud Controller Connection", func() {
 				})
 			})
 
+			Context("when the server does not respond within the request timeout", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							func(http.ResponseWriter, *http.Request) {
+								time.Sleep(500 * time.Millisecond)
+							},
+						),
+					)
+
+					connection = NewConnection(Config{SkipSSLValidation: true, RequestTimeout: 50 * time.Millisecond})
+				})
+
+				It("returns a RequestError", func() {
+					req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+					request := &Request{Request: req}
+
+					var response Response
+					err = connection.Make(request, &response)
+					Expect(err).To(BeAssignableToTypeOf(ccerror.RequestError{}))
+				})
+			})
+
 			Context("when the server does not have a verified certificate", func() {
 				Context("skipSSLValidation is false", func() {
 					BeforeEach(func() {
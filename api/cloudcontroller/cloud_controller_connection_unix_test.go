@@ -0,0 +1,65 @@
+// +build !windows
+
+package cloudcontroller_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cloud Controller Connection over a unix socket", func() {
+	var (
+		connection  *CloudControllerConnection
+		socketDir   string
+		socketPath  string
+		unixServer  *httptest.Server
+		requestPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		socketDir, err = ioutil.TempDir("", "cloudcontroller-unix")
+		Expect(err).ToNot(HaveOccurred())
+		socketPath = filepath.Join(socketDir, "cc.sock")
+
+		listener, err := net.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		unixServer = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestPath = r.URL.Path
+			w.Header().Set("X-Cf-Warnings", "unix-warning")
+			_, _ = w.Write([]byte(`{"val1":"2.100.0"}`))
+		}))
+		unixServer.Listener = listener
+		unixServer.Start()
+
+		connection = NewConnection(Config{})
+	})
+
+	AfterEach(func() {
+		unixServer.Close()
+		os.RemoveAll(socketDir)
+	})
+
+	It("sends requests for unix URLs over the socket", func() {
+		req, err := http.NewRequest(http.MethodGet, "unix://"+socketPath+"/v2/info", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		var body DummyResponse
+		response := Response{Result: &body}
+		err = connection.Make(&Request{Request: req}, &response)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(requestPath).To(Equal("/v2/info"))
+		Expect(body.Val1).To(Equal("2.100.0"))
+		Expect(response.Warnings).To(ConsistOf("unix-warning"))
+	})
+})
@@ -1,6 +1,8 @@
 package wrapper
 
 import (
+	"sync"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -29,6 +31,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenLock guards the cache, so that requests made in parallel that find
+	// the token expired only refresh it once.
+	tokenLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -59,17 +65,20 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		refreshed, err := t.refreshAccessToken(accessToken)
 		if err != nil {
 			return err
 		}
-
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
+		// Tokens from a client credentials grant come without a refresh token,
+		// so there is nothing to refresh them with.
+		if !refreshed {
+			return requestErr
+		}
 
 		if request.Body != nil {
 			err = request.ResetBody()
@@ -80,9 +89,41 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", t.accessToken())
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
 	return requestErr
 }
+
+func (t *UAAAuthentication) accessToken() string {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	return t.cache.AccessToken()
+}
+
+// refreshAccessToken refreshes the access token, unless another request has
+// already replaced expiredToken. It returns false when there is no refresh
+// token to refresh it with.
+func (t *UAAAuthentication) refreshAccessToken(expiredToken string) (bool, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if t.cache.AccessToken() != expiredToken {
+		return true, nil
+	}
+
+	if t.cache.RefreshToken() == "" {
+		return false, nil
+	}
+
+	token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return false, err
+	}
+
+	t.cache.SetAccessToken(token.AuthorizationToken())
+	t.cache.SetRefreshToken(token.RefreshToken)
+	return true, nil
+}
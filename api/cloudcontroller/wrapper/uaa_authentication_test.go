@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -106,6 +107,49 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when requests made in parallel find the token expired", func() {
+			const parallelRequests = 5
+
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("expired-token")
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				var sentExpired sync.WaitGroup
+				sentExpired.Add(parallelRequests)
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "expired-token" {
+						sentExpired.Done()
+						sentExpired.Wait()
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(uaa.RefreshToken{AccessToken: "new-token", Type: "bearer"}, nil)
+			})
+
+			It("refreshes the token once and resends every request with the new token", func() {
+				var done sync.WaitGroup
+				for i := 0; i < parallelRequests; i++ {
+					done.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer done.Done()
+
+						parallelRequest := &cloudcontroller.Request{
+							Request: &http.Request{Header: http.Header{}},
+						}
+						Expect(wrapper.Make(parallelRequest, nil)).To(Succeed())
+						Expect(parallelRequest.Header.Get("Authorization")).To(Equal("bearer new-token"))
+					}()
+				}
+				done.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2 * parallelRequests))
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var (
 				expectedBody string
@@ -138,6 +182,7 @@ var _ = Describe("UAA Authentication", func() {
 				}
 
 				inMemoryCache.SetAccessToken("what")
+				inMemoryCache.SetRefreshToken("some-refresh-token")
 
 				fakeClient.RefreshAccessTokenReturns(
 					uaa.RefreshToken{
@@ -171,6 +216,18 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 
+			Context("when there is no refresh token", func() {
+				BeforeEach(func() {
+					inMemoryCache.SetRefreshToken("")
+				})
+
+				It("returns the invalid token error without refreshing", func() {
+					Expect(executeErr).To(MatchError(ccerror.InvalidAuthTokenError{}))
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when a PipeSeekError is returned from ResetBody", func() {
 				BeforeEach(func() {
 					body, writer := cloudcontroller.NewPipeBomb()
@@ -43,3 +43,59 @@ func (client Client) Authenticate(username string, password string) (string, str
 	err = client.connection.Make(request, &response)
 	return responseBody.AccessToken, responseBody.RefreshToken, err
 }
+
+// AuthenticateWithClientCredentials performs a client credentials grant for
+// the provided client and returns an access token. Client credentials grants
+// do not issue refresh tokens.
+func (client Client) AuthenticateWithClientCredentials(clientID string, clientSecret string) (string, error) {
+	requestBody := url.Values{}
+	requestBody.Set("grant_type", "client_credentials")
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return "", err
+	}
+	request.SetBasicAuth(clientID, clientSecret)
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, err
+}
+
+// AuthenticateWithPasscode sends a one time SSO passcode to UAA then returns
+// an access token and a refresh token.
+func (client Client) AuthenticateWithPasscode(passcode string) (string, string, error) {
+	requestBody := url.Values{}
+	requestBody.Set("passcode", passcode)
+	requestBody.Set("grant_type", "password")
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	request.SetBasicAuth(client.id, client.secret)
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, responseBody.RefreshToken, err
+}
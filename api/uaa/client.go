@@ -37,6 +37,10 @@ type Config struct {
 	// infinite.
 	DialTimeout time.Duration
 
+	// RequestTimeout is the maximum time each request to UAA may take. Zero
+	// means no timeout.
+	RequestTimeout time.Duration
+
 	// ClientID is the UAA client ID the client will use.
 	ClientID string
 
@@ -66,13 +70,16 @@ func NewClient(config Config) *Client {
 		runtime.GOOS,
 	)
 
+	connection := NewConnection(config.SkipSSLValidation, config.DialTimeout)
+	connection.HTTPClient.Timeout = config.RequestTimeout
+
 	client := Client{
 		URL:    config.URL,
 		id:     config.ClientID,
 		secret: config.ClientSecret,
 
 		router:     rata.NewRequestGenerator(config.URL, internal.Routes),
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+		connection: connection,
 		userAgent:  userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
@@ -88,3 +88,12 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// SSHPasscodeNotFoundError is returned when UAA does not redirect with an SSH
+// authorization code.
+type SSHPasscodeNotFoundError struct {
+}
+
+func (SSHPasscodeNotFoundError) Error() string {
+	return "Unable to acquire a one time SSH passcode from the authorization server."
+}
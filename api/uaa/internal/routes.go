@@ -7,6 +7,8 @@ import (
 )
 
 const (
+	GetSSHPasscodeRequest = "GetSSHPasscode"
+	GetUsersRequest       = "GetUsers"
 	PostUserRequest       = "PostUser"
 	PostOAuthTokenRequest = "PostOAuthToken"
 )
@@ -14,6 +16,8 @@ const (
 // Routes is a list of routes used by the rata library to construct request
 // URLs.
 var Routes = rata.Routes{
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest},
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest},
+	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest},
 }
@@ -0,0 +1,46 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// GetSSHPasscode returns a one time passcode that the SSH proxy exchanges for
+// access to an application instance. The passcode is issued to the given SSH
+// OAuth client for the user whose access token authorizes the request.
+func (client Client) GetSSHPasscode(sshOAuthClient string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetSSHPasscodeRequest,
+		Query: url.Values{
+			"client_id":     {sshOAuthClient},
+			"response_type": {"code"},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	response := Response{}
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return "", err
+	}
+
+	if response.HTTPResponse.StatusCode != http.StatusFound {
+		return "", SSHPasscodeNotFoundError{}
+	}
+
+	location, err := url.Parse(response.HTTPResponse.Header.Get("Location"))
+	if err != nil {
+		return "", SSHPasscodeNotFoundError{}
+	}
+
+	code := location.Query().Get("code")
+	if code == "" {
+		return "", SSHPasscodeNotFoundError{}
+	}
+
+	return code, nil
+}
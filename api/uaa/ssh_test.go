@@ -0,0 +1,86 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SSH", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("GetSSHPasscode", func() {
+		Context("when UAA redirects with a code", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize", "client_id=ssh-proxy&response_type=code"),
+						RespondWith(http.StatusFound, nil, http.Header{
+							"Location": {"https://uaa.example.com/login?code=some-passcode"},
+						}),
+					))
+			})
+
+			It("returns the code without following the redirect", func() {
+				code, err := client.GetSSHPasscode("ssh-proxy")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(code).To(Equal("some-passcode"))
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when UAA redirects without a code", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusFound, nil, http.Header{
+							"Location": {"https://uaa.example.com/login"},
+						}),
+					))
+			})
+
+			It("returns an SSHPasscodeNotFoundError", func() {
+				_, err := client.GetSSHPasscode("ssh-proxy")
+				Expect(err).To(MatchError(SSHPasscodeNotFoundError{}))
+			})
+		})
+
+		Context("when UAA does not redirect", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusOK, `{}`),
+					))
+			})
+
+			It("returns an SSHPasscodeNotFoundError", func() {
+				_, err := client.GetSSHPasscode("ssh-proxy")
+				Expect(err).To(MatchError(SSHPasscodeNotFoundError{}))
+			})
+		})
+
+		Context("when UAA returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusUnauthorized, `{"error":"invalid_token","error_description":"Invalid access token"}`),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetSSHPasscode("ssh-proxy")
+				Expect(err).To(MatchError(InvalidAuthTokenError{Message: "Invalid access token"}))
+			})
+		})
+	})
+})
@@ -31,7 +31,14 @@ func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *UAAConnec
 	}
 
 	return &UAAConnection{
-		HTTPClient: &http.Client{Transport: tr},
+		HTTPClient: &http.Client{
+			Transport: tr,
+			// Redirects are returned to the caller rather than followed, so
+			// that authorization codes can be read from the Location header.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	}
 }
 
@@ -3,14 +3,18 @@ package uaa
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
 // User represents an UAA user account.
 type User struct {
-	ID string
+	ID       string
+	Username string
 }
 
 // newUserRequestBody represents the body of the request.
@@ -83,3 +87,53 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// getUsersResponse represents the HTTP JSON response of a user search.
+type getUsersResponse struct {
+	Resources []struct {
+		ID       string `json:"id"`
+		Username string `json:"userName"`
+	} `json:"resources"`
+}
+
+// GetUsers returns the UAA user accounts with the provided IDs. IDs that do
+// not match an account are omitted from the result.
+func (client *Client) GetUsers(userIDs []string) ([]User, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	filters := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		filters = append(filters, fmt.Sprintf(`id eq "%s"`, id))
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetUsersRequest,
+		Query: url.Values{
+			"attributes": {"id,userName"},
+			"count":      {fmt.Sprint(len(userIDs))},
+			"filter":     {strings.Join(filters, " or ")},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usersResponse getUsersResponse
+	response := Response{
+		Result: &usersResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]User, 0, len(usersResponse.Resources))
+	for _, resource := range usersResponse.Resources {
+		users = append(users, User{ID: resource.ID, Username: resource.Username})
+	}
+
+	return users, nil
+}
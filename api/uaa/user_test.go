@@ -92,4 +92,64 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetUsers", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{"id": "user-guid-1", "userName": "user-1"},
+						{"id": "user-guid-2", "userName": "user-2"}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users", "attributes=id%2CuserName&count=3&filter=id+eq+%22user-guid-1%22+or+id+eq+%22user-guid-2%22+or+id+eq+%22user-guid-3%22"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the users that were found", func() {
+				users, err := client.GetUsers([]string{"user-guid-1", "user-guid-2", "user-guid-3"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(Equal([]User{
+					{ID: "user-guid-1", Username: "user-1"},
+					{ID: "user-guid-2", Username: "user-2"},
+				}))
+			})
+		})
+
+		Context("when no IDs are provided", func() {
+			It("does not make a request", func() {
+				users, err := client.GetUsers(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(BeEmpty())
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetUsers([]string{"user-guid-1"})
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"sort"
 	"time"
 
@@ -151,8 +152,24 @@ func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 }
 
 func redactHeaders(key string, value string) string {
+	redactedValue := "[PRIVATE DATA HIDDEN]"
 	if key == "Authorization" {
-		return "[PRIVATE DATA HIDDEN]"
+		return redactedValue
+	}
+
+	// Authorization codes, such as SSH passcodes, are returned in the query
+	// of the redirect location.
+	if key == "Location" {
+		location, err := url.Parse(value)
+		if err != nil {
+			return redactedValue
+		}
+		query := location.Query()
+		if query.Get("code") != "" {
+			query.Set("code", redactedValue)
+			location.RawQuery = query.Encode()
+			return location.String()
+		}
 	}
 	return value
 }
@@ -219,6 +219,35 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		Context("when the response redirects with an authorization code", func() {
+			BeforeEach(func() {
+				response = &uaa.Response{
+					HTTPResponse: &http.Response{
+						Proto:  "HTTP/1.1",
+						Status: "302 Found",
+						Header: http.Header{
+							"Location": {"https://uaa.example.com/login?code=should-not-be-shown"},
+						},
+					},
+				}
+			})
+
+			It("redacts the code from the location header", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				var locations []string
+				for i := 0; i < fakeOutput.DisplayHeaderCallCount(); i++ {
+					name, value := fakeOutput.DisplayHeaderArgsForCall(i)
+					if name == "Location" {
+						locations = append(locations, value)
+					}
+				}
+				Expect(locations).To(HaveLen(1))
+				Expect(locations[0]).NotTo(ContainSubstring("should-not-be-shown"))
+				Expect(locations[0]).To(ContainSubstring("code=%5BPRIVATE+DATA+HIDDEN%5D"))
+			})
+		})
+
 		Context("when the request is unsuccessful", func() {
 			var expectedErr error
 
@@ -72,7 +72,9 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 	request.Header.Set("Authorization", t.cache.AccessToken())
 
 	err = t.connection.Make(request, passedResponse)
-	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
+	// Tokens from a client credentials grant come without a refresh token, so
+	// there is nothing to refresh them with.
+	if _, ok := err.(uaa.InvalidAuthTokenError); ok && t.cache.RefreshToken() != "" {
 		var token uaa.RefreshToken
 		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
 		if err != nil {
@@ -100,5 +102,6 @@ func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
-			strings.Contains(stringBody, "grant_type=password"))
+			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
@@ -124,6 +124,7 @@ var _ = Describe("UAA Authentication", func() {
 				)
 
 				inMemoryCache.SetAccessToken("what")
+				inMemoryCache.SetRefreshToken("some-refresh-token")
 
 				err = wrapper.Make(request, nil)
 				Expect(err).ToNot(HaveOccurred())
@@ -145,6 +146,27 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token is invalid and there is no refresh token", func() {
+			var err error
+
+			BeforeEach(func() {
+				request = &http.Request{
+					Header: http.Header{},
+				}
+				fakeConnection.MakeReturns(uaa.InvalidAuthTokenError{})
+
+				inMemoryCache.SetAccessToken("some-client-credentials-token")
+
+				err = wrapper.Make(request, nil)
+			})
+
+			It("returns the invalid token error without refreshing", func() {
+				Expect(err).To(MatchError(uaa.InvalidAuthTokenError{}))
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
@@ -196,5 +218,31 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 			})
 		})
+
+		Context("when requesting a client credentials token", func() {
+			var originalAuthHeader string
+			BeforeEach(func() {
+				body := strings.NewReader(url.Values{
+					"grant_type": {"client_credentials"},
+				}.Encode())
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+				Expect(err).NotTo(HaveOccurred())
+				request.SetBasicAuth("some-client", "some-secret")
+				originalAuthHeader = request.Header.Get("Authorization")
+
+				inMemoryCache.SetAccessToken("some-access-token")
+
+				err = wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not change the 'Authorization' header", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+			})
+		})
 	})
 })
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
 
@@ -24,6 +25,8 @@ type Repository interface {
 	Read(name string) (app models.Application, apiErr error)
 	ReadFromSpace(name string, spaceGUID string) (app models.Application, apiErr error)
 	Update(appGUID string, params models.AppParams) (updatedApp models.Application, apiErr error)
+	DryRunUpdate(params models.AppParams) (body map[string]interface{}, apiErr error)
+	MergeEnv(appGUID string, vars map[string]interface{}) (updatedApp models.Application, apiErr error)
 	Delete(appGUID string) (apiErr error)
 	ReadEnv(guid string) (*models.Environment, error)
 	CreateRestageRequest(guid string) (apiErr error)
@@ -32,15 +35,30 @@ type Repository interface {
 type CloudControllerRepository struct {
 	config  coreconfig.Reader
 	gateway net.Gateway
+
+	// DeletePollingInterval is how long Delete waits between polls of the
+	// deletion job.
+	DeletePollingInterval time.Duration
+
+	// DeleteTimeout is how long Delete waits for the deletion job to finish.
+	// A zero value waits indefinitely.
+	DeleteTimeout time.Duration
 }
 
 func NewCloudControllerRepository(config coreconfig.Reader, gateway net.Gateway) (repo CloudControllerRepository) {
 	repo.config = config
 	repo.gateway = gateway
+	repo.DeletePollingInterval = gateway.PollingThrottle
+	repo.DeleteTimeout = gateway.AsyncTimeout()
 	return
 }
 
 func (repo CloudControllerRepository) Create(params models.AppParams) (models.Application, error) {
+	err := validateAppPorts(params.AppPorts)
+	if err != nil {
+		return models.Application{}, err
+	}
+
 	appResource := resources.NewApplicationEntityFromAppParams(params)
 	data, err := json.Marshal(appResource)
 	if err != nil {
@@ -92,6 +110,11 @@ func (repo CloudControllerRepository) ReadFromSpace(name string, spaceGUID strin
 }
 
 func (repo CloudControllerRepository) Update(appGUID string, params models.AppParams) (updatedApp models.Application, apiErr error) {
+	apiErr = validateAppPorts(params.AppPorts)
+	if apiErr != nil {
+		return
+	}
+
 	appResource := resources.NewApplicationEntityFromAppParams(params)
 	data, err := json.Marshal(appResource)
 	if err != nil {
@@ -109,9 +132,94 @@ func (repo CloudControllerRepository) Update(appGUID string, params models.AppPa
 	return
 }
 
+// DryRunUpdate returns the request body Update would send for params without
+// making any requests. Only the fields that are set in params are included.
+func (repo CloudControllerRepository) DryRunUpdate(params models.AppParams) (map[string]interface{}, error) {
+	err := validateAppPorts(params.AppPorts)
+	if err != nil {
+		return nil, err
+	}
+
+	appResource := resources.NewApplicationEntityFromAppParams(params)
+	data, err := json.Marshal(appResource)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", T("Failed to marshal JSON"), err.Error())
+	}
+
+	body := map[string]interface{}{}
+	err = json.Unmarshal(data, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// validateAppPorts returns an error if any of the ports is outside of
+// 1-65535 or is listed more than once. A nil ports is valid; the ports are
+// then left out of the request.
+func validateAppPorts(ports *[]int) error {
+	if ports == nil {
+		return nil
+	}
+
+	seen := map[int]bool{}
+	for _, port := range *ports {
+		if port < 1 || port > 65535 {
+			return errors.New(T("Invalid app port {{.Port}}: ports must be between 1 and 65535", map[string]interface{}{"Port": port}))
+		}
+		if seen[port] {
+			return errors.New(T("Invalid app ports: port {{.Port}} is listed more than once", map[string]interface{}{"Port": port}))
+		}
+		seen[port] = true
+	}
+
+	return nil
+}
+
+// MergeEnv reads the app's current environment_json, merges vars into it and
+// updates the app with the union. A nil value removes the key.
+func (repo CloudControllerRepository) MergeEnv(appGUID string, vars map[string]interface{}) (models.Application, error) {
+	app, err := repo.GetApp(appGUID)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	envVars := map[string]interface{}{}
+	for key, value := range app.EnvironmentVars {
+		envVars[key] = value
+	}
+
+	for key, value := range vars {
+		if value == nil {
+			delete(envVars, key)
+		} else {
+			envVars[key] = value
+		}
+	}
+
+	return repo.Update(appGUID, models.AppParams{EnvironmentVars: &envVars})
+}
+
+// Delete deletes the app asynchronously and polls the resulting job until it
+// finishes, fails or DeleteTimeout elapses. When the gateway has polling
+// disabled the app is deleted with a single request, as other resources are.
 func (repo CloudControllerRepository) Delete(appGUID string) (apiErr error) {
 	path := fmt.Sprintf("/v2/apps/%s?recursive=true", appGUID)
-	return repo.gateway.DeleteResource(repo.config.APIEndpoint(), path)
+	if !repo.gateway.PollingEnabled {
+		return repo.gateway.DeleteResource(repo.config.APIEndpoint(), path)
+	}
+
+	request, err := repo.gateway.NewRequest("DELETE", repo.config.APIEndpoint()+path, repo.config.AccessToken(), nil)
+	if err != nil {
+		return err
+	}
+
+	gateway := repo.gateway
+	gateway.PollingThrottle = repo.DeletePollingInterval
+
+	_, err = gateway.PerformPollingRequestForJSONResponse(repo.config.APIEndpoint(), request, &net.AsyncResource{}, repo.DeleteTimeout)
+	return err
 }
 
 func (repo CloudControllerRepository) ReadEnv(guid string) (*models.Environment, error) {
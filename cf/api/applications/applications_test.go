@@ -344,6 +344,28 @@ var _ = Describe("ApplicationsRepository", func() {
 			Expect(updatedApp.GUID).To(Equal("my-cool-app-guid"))
 		})
 
+		It("sets the disk quota", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:  "PUT",
+				Path:    "/v2/apps/app1-guid",
+				Matcher: testnet.RequestBodyMatcher(`{"disk_quota":2048}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated, Body: `{
+					"metadata": {"guid": "app1-guid"},
+					"entity": {"name": "My App", "disk_quota": 2048}
+				}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			diskQuota := int64(2048)
+			updatedApp, apiErr := repo.Update("app1-guid", models.AppParams{DiskQuota: &diskQuota})
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(updatedApp.DiskQuota).To(Equal(int64(2048)))
+		})
+
 		It("sets environment variables", func() {
 			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
 				Method:   "PUT",
@@ -383,6 +405,184 @@ var _ = Describe("ApplicationsRepository", func() {
 			Expect(handler).To(HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
 		})
+
+		Context("when the app ports are invalid", func() {
+			It("rejects ports outside of 1-65535 without making a request", func() {
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{})
+				defer ts.Close()
+
+				ports := []int{8080, 65536}
+				_, apiErr := repo.Update("app1-guid", models.AppParams{AppPorts: &ports})
+
+				Expect(apiErr).To(HaveOccurred())
+				Expect(apiErr.Error()).To(ContainSubstring("Invalid app port 65536"))
+				Expect(handler.CallCount).To(BeZero())
+			})
+
+			It("rejects duplicate ports without making a request", func() {
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{})
+				defer ts.Close()
+
+				ports := []int{8080, 8080}
+				_, apiErr := repo.Create(models.AppParams{AppPorts: &ports})
+
+				Expect(apiErr).To(HaveOccurred())
+				Expect(apiErr.Error()).To(ContainSubstring("port 8080 is listed more than once"))
+				Expect(handler.CallCount).To(BeZero())
+			})
+
+			It("rejects port 0", func() {
+				ts, _, repo := createAppRepo([]testnet.TestRequest{})
+				defer ts.Close()
+
+				ports := []int{0}
+				_, apiErr := repo.DryRunUpdate(models.AppParams{AppPorts: &ports})
+				Expect(apiErr).To(HaveOccurred())
+			})
+		})
+
+		Describe("DryRunUpdate", func() {
+			It("returns only the fields that are set without making any requests", func() {
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{})
+				defer ts.Close()
+
+				name := "new-name"
+				instances := 0
+				diskQuota := int64(2048)
+				envParams := map[string]interface{}{"FOO": "bar"}
+				body, apiErr := repo.DryRunUpdate(models.AppParams{
+					Name:            &name,
+					InstanceCount:   &instances,
+					DiskQuota:       &diskQuota,
+					EnvironmentVars: &envParams,
+				})
+
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(Equal(map[string]interface{}{
+					"name":             "new-name",
+					"instances":        float64(0),
+					"disk_quota":       float64(2048),
+					"environment_json": map[string]interface{}{"FOO": "bar"},
+				}))
+				Expect(handler.CallCount).To(BeZero())
+			})
+
+			It("includes the app ports when they are set", func() {
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{})
+				defer ts.Close()
+
+				ports := []int{8080, 9090}
+				body, apiErr := repo.DryRunUpdate(models.AppParams{AppPorts: &ports})
+
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(Equal(map[string]interface{}{
+					"ports": []interface{}{float64(8080), float64(9090)},
+				}))
+				Expect(handler.CallCount).To(BeZero())
+			})
+
+			It("returns an empty body when no fields are set", func() {
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{})
+				defer ts.Close()
+
+				body, apiErr := repo.DryRunUpdate(models.AppParams{})
+
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(BeEmpty())
+				Expect(handler.CallCount).To(BeZero())
+			})
+		})
+	})
+
+	Describe("merging environment variables", func() {
+		It("merges the new variables with the existing ones", func() {
+			getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps/app1-guid",
+				Response: appModelResponse,
+			})
+			putRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/apps/app1-guid",
+				Matcher:  testnet.RequestBodyMatcher(`{"environment_json":{"baz":"boom","foo":"new-bar","qux":"quux"}}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{getRequest, putRequest})
+			defer ts.Close()
+
+			_, apiErr := repo.MergeEnv("app1-guid", map[string]interface{}{"foo": "new-bar", "qux": "quux"})
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+
+		It("removes variables with a nil value", func() {
+			getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps/app1-guid",
+				Response: appModelResponse,
+			})
+			putRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/apps/app1-guid",
+				Matcher:  testnet.RequestBodyMatcher(`{"environment_json":{"baz":"boom"}}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{getRequest, putRequest})
+			defer ts.Close()
+
+			_, apiErr := repo.MergeEnv("app1-guid", map[string]interface{}{"foo": nil})
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+
+		Context("when the app has no environment variables", func() {
+			It("sets the new variables", func() {
+				getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/apps/app1-guid",
+					Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+						"metadata": {"guid": "app1-guid"},
+						"entity": {"name": "My App", "environment_json": {}}
+					}`},
+				})
+				putRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "PUT",
+					Path:     "/v2/apps/app1-guid",
+					Matcher:  testnet.RequestBodyMatcher(`{"environment_json":{"DATABASE_URL":"mysql://example.com/my-db"}}`),
+					Response: testnet.TestResponse{Status: http.StatusCreated},
+				})
+
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{getRequest, putRequest})
+				defer ts.Close()
+
+				_, apiErr := repo.MergeEnv("app1-guid", map[string]interface{}{"DATABASE_URL": "mysql://example.com/my-db"})
+
+				Expect(handler).To(HaveAllRequestsCalled())
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when reading the app fails", func() {
+			It("returns the error without updating the app", func() {
+				getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "GET",
+					Path:     "/v2/apps/app1-guid",
+					Response: testnet.TestResponse{Status: http.StatusNotFound},
+				})
+
+				ts, handler, repo := createAppRepo([]testnet.TestRequest{getRequest})
+				defer ts.Close()
+
+				_, apiErr := repo.MergeEnv("app1-guid", map[string]interface{}{"foo": "bar"})
+
+				Expect(handler).To(HaveAllRequestsCalled())
+				Expect(apiErr).To(HaveOccurred())
+			})
+		})
 	})
 
 	It("deletes applications", func() {
@@ -399,6 +599,103 @@ var _ = Describe("ApplicationsRepository", func() {
 		Expect(handler).To(HaveAllRequestsCalled())
 		Expect(apiErr).NotTo(HaveOccurred())
 	})
+
+	Describe("deleting applications asynchronously", func() {
+		var (
+			ts      *httptest.Server
+			handler *testnet.TestHandler
+			repo    CloudControllerRepository
+		)
+
+		deleteAppJobRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "DELETE",
+			Path:   "/v2/apps/my-cool-app-guid?recursive=true&async=true",
+			Response: testnet.TestResponse{
+				Status: http.StatusAccepted,
+				Body: `{
+					"metadata": {
+						"guid": "my-job-guid",
+						"url": "/v2/jobs/my-job-guid"
+					}
+				}`,
+			},
+		})
+
+		jobRequest := func(status string, description string) testnet.TestRequest {
+			return apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/jobs/my-job-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{
+						"entity": {
+							"status": "` + status + `",
+							"error_details": {
+								"description": "` + description + `"
+							}
+						}
+					}`,
+				},
+			})
+		}
+
+		setupRepo := func(requests ...testnet.TestRequest) {
+			ts, handler = testnet.NewServer(requests)
+			configRepo := testconfig.NewRepositoryWithDefaults()
+			configRepo.SetAPIEndpoint(ts.URL)
+			gateway := net.NewCloudControllerGateway(configRepo, time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+			repo = NewCloudControllerRepository(configRepo, gateway)
+			repo.DeletePollingInterval = 0
+		}
+
+		AfterEach(func() {
+			ts.Close()
+		})
+
+		It("polls the job until it finishes", func() {
+			setupRepo(deleteAppJobRequest, jobRequest("running", ""), jobRequest("finished", ""))
+
+			apiErr := repo.Delete("my-cool-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+
+		It("returns the job's failure details when the job fails", func() {
+			setupRepo(deleteAppJobRequest, jobRequest("running", ""), jobRequest("failed", "some-failure-detail"))
+
+			apiErr := repo.Delete("my-cool-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).To(MatchError("some-failure-detail"))
+		})
+
+		It("does not poll the job when polling is disabled on the gateway", func() {
+			ts, handler = testnet.NewServer([]testnet.TestRequest{
+				apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "DELETE",
+					Path:     "/v2/apps/my-cool-app-guid?recursive=true",
+					Response: testnet.TestResponse{Status: http.StatusAccepted, Body: deleteAppJobRequest.Response.Body},
+				}),
+			})
+			configRepo := testconfig.NewRepositoryWithDefaults()
+			configRepo.SetAPIEndpoint(ts.URL)
+			gateway := net.NewCloudControllerGateway(configRepo, time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+			gateway.PollingEnabled = false
+			repo = NewCloudControllerRepository(configRepo, gateway)
+
+			apiErr := repo.Delete("my-cool-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+
+		It("returns an AsyncTimeoutError when the job does not finish before the timeout", func() {
+			setupRepo(deleteAppJobRequest)
+			repo.DeleteTimeout = time.Nanosecond
+
+			apiErr := repo.Delete("my-cool-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.AsyncTimeoutError{}))
+		})
+	})
 })
 
 var appModelResponse = testnet.TestResponse{
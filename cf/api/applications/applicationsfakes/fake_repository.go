@@ -56,6 +56,25 @@ type FakeRepository struct {
 		result1 models.Application
 		result2 error
 	}
+	DryRunUpdateStub        func(params models.AppParams) (body map[string]interface{}, apiErr error)
+	dryRunUpdateMutex       sync.RWMutex
+	dryRunUpdateArgsForCall []struct {
+		params models.AppParams
+	}
+	dryRunUpdateReturns struct {
+		result1 map[string]interface{}
+		result2 error
+	}
+	MergeEnvStub        func(appGUID string, vars map[string]interface{}) (updatedApp models.Application, apiErr error)
+	mergeEnvMutex       sync.RWMutex
+	mergeEnvArgsForCall []struct {
+		appGUID string
+		vars    map[string]interface{}
+	}
+	mergeEnvReturns struct {
+		result1 models.Application
+		result2 error
+	}
 	DeleteStub        func(appGUID string) (apiErr error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -257,6 +276,75 @@ func (fake *FakeRepository) UpdateReturns(result1 models.Application, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) DryRunUpdate(params models.AppParams) (body map[string]interface{}, apiErr error) {
+	fake.dryRunUpdateMutex.Lock()
+	fake.dryRunUpdateArgsForCall = append(fake.dryRunUpdateArgsForCall, struct {
+		params models.AppParams
+	}{params})
+	fake.recordInvocation("DryRunUpdate", []interface{}{params})
+	fake.dryRunUpdateMutex.Unlock()
+	if fake.DryRunUpdateStub != nil {
+		return fake.DryRunUpdateStub(params)
+	} else {
+		return fake.dryRunUpdateReturns.result1, fake.dryRunUpdateReturns.result2
+	}
+}
+
+func (fake *FakeRepository) DryRunUpdateCallCount() int {
+	fake.dryRunUpdateMutex.RLock()
+	defer fake.dryRunUpdateMutex.RUnlock()
+	return len(fake.dryRunUpdateArgsForCall)
+}
+
+func (fake *FakeRepository) DryRunUpdateArgsForCall(i int) models.AppParams {
+	fake.dryRunUpdateMutex.RLock()
+	defer fake.dryRunUpdateMutex.RUnlock()
+	return fake.dryRunUpdateArgsForCall[i].params
+}
+
+func (fake *FakeRepository) DryRunUpdateReturns(result1 map[string]interface{}, result2 error) {
+	fake.DryRunUpdateStub = nil
+	fake.dryRunUpdateReturns = struct {
+		result1 map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) MergeEnv(appGUID string, vars map[string]interface{}) (updatedApp models.Application, apiErr error) {
+	fake.mergeEnvMutex.Lock()
+	fake.mergeEnvArgsForCall = append(fake.mergeEnvArgsForCall, struct {
+		appGUID string
+		vars    map[string]interface{}
+	}{appGUID, vars})
+	fake.recordInvocation("MergeEnv", []interface{}{appGUID, vars})
+	fake.mergeEnvMutex.Unlock()
+	if fake.MergeEnvStub != nil {
+		return fake.MergeEnvStub(appGUID, vars)
+	} else {
+		return fake.mergeEnvReturns.result1, fake.mergeEnvReturns.result2
+	}
+}
+
+func (fake *FakeRepository) MergeEnvCallCount() int {
+	fake.mergeEnvMutex.RLock()
+	defer fake.mergeEnvMutex.RUnlock()
+	return len(fake.mergeEnvArgsForCall)
+}
+
+func (fake *FakeRepository) MergeEnvArgsForCall(i int) (string, map[string]interface{}) {
+	fake.mergeEnvMutex.RLock()
+	defer fake.mergeEnvMutex.RUnlock()
+	return fake.mergeEnvArgsForCall[i].appGUID, fake.mergeEnvArgsForCall[i].vars
+}
+
+func (fake *FakeRepository) MergeEnvReturns(result1 models.Application, result2 error) {
+	fake.MergeEnvStub = nil
+	fake.mergeEnvReturns = struct {
+		result1 models.Application
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRepository) Delete(appGUID string) (apiErr error) {
 	fake.deleteMutex.Lock()
 	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
@@ -370,6 +458,10 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.readFromSpaceMutex.RUnlock()
 	fake.updateMutex.RLock()
 	defer fake.updateMutex.RUnlock()
+	fake.dryRunUpdateMutex.RLock()
+	defer fake.dryRunUpdateMutex.RUnlock()
+	fake.mergeEnvMutex.RLock()
+	defer fake.mergeEnvMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.readEnvMutex.RLock()
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/util"
 )
 
 type RemoteInfoRepository struct {
@@ -19,7 +20,7 @@ func NewEndpointRepository(gateway net.Gateway) RemoteInfoRepository {
 }
 
 func (repo RemoteInfoRepository) GetCCInfo(endpoint string) (*coreconfig.CCInfo, string, error) {
-	if strings.HasPrefix(endpoint, "http") {
+	if strings.HasPrefix(endpoint, "http") || util.IsUnixSocketScheme(endpoint) {
 		serverResponse, err := repo.getCCAPIInfo(endpoint)
 		if err != nil {
 			return nil, "", err
@@ -189,6 +189,9 @@ func (resource ApplicationResource) ToFields() (app models.ApplicationFields) {
 	if entity.HealthCheckHTTPEndpoint != nil {
 		app.HealthCheckHTTPEndpoint = *entity.HealthCheckHTTPEndpoint
 	}
+	if entity.HealthCheckTimeout != nil {
+		app.HealthCheckTimeout = *entity.HealthCheckTimeout
+	}
 	if entity.Diego != nil {
 		app.Diego = *entity.Diego
 	}
@@ -198,6 +201,9 @@ func (resource ApplicationResource) ToFields() (app models.ApplicationFields) {
 	if entity.PackageUpdatedAt != nil {
 		app.PackageUpdatedAt = entity.PackageUpdatedAt
 	}
+	if entity.AppPorts != nil {
+		app.AppPorts = *entity.AppPorts
+	}
 
 	return
 }
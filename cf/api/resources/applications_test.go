@@ -36,6 +36,64 @@ var _ = Describe("Application resources", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*applicationModel.PackageUpdatedAt).To(Equal(timestamp))
 		})
+
+		It("parses the health check settings", func() {
+			err := json.Unmarshal([]byte(`
+			{
+				"metadata": {
+					"guid":"application-1-guid"
+				},
+				"entity": {
+					"health_check_type": "process",
+					"health_check_timeout": 120
+				}
+			}`), &resource)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			applicationModel := resource.ToModel()
+			Expect(applicationModel.HealthCheckType).To(Equal("process"))
+			Expect(applicationModel.HealthCheckTimeout).To(Equal(120))
+		})
+
+		It("parses the app ports", func() {
+			err := json.Unmarshal([]byte(`
+			{
+				"metadata": {
+					"guid":"application-1-guid"
+				},
+				"entity": {
+					"ports": [8080, 9090]
+				}
+			}`), &resource)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			applicationModel := resource.ToModel()
+			Expect(applicationModel.AppPorts).To(Equal([]int{8080, 9090}))
+		})
+
+		It("omits an unset health check timeout when converted back to params", func() {
+			err := json.Unmarshal([]byte(`
+			{
+				"metadata": {
+					"guid":"application-1-guid"
+				},
+				"entity": {
+					"health_check_type": "process"
+				}
+			}`), &resource)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			entity := resources.NewApplicationEntityFromAppParams(resource.ToModel().ToParams())
+			Expect(*entity.HealthCheckType).To(Equal("process"))
+			Expect(entity.HealthCheckTimeout).To(BeNil())
+
+			body, err := json.Marshal(entity)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).NotTo(ContainSubstring("health_check_timeout"))
+		})
 	})
 
 	Describe("NewApplicationEntityFromAppParams", func() {
@@ -47,6 +47,11 @@ func Main(traceEnv string, args []string) {
 	newArgs, isVerbose := handleVerbose(args)
 	args = newArgs
 
+	args, requestTimeout := handleRequestTimeout(args)
+	args, noColor := handleNoColor(args)
+	args, profile := handleProfile(args)
+	args, output := handleOutput(args)
+
 	errFunc := func(err error) {
 		if err != nil {
 			ui := terminal.NewUI(
@@ -61,7 +66,7 @@ func Main(traceEnv string, args []string) {
 	}
 
 	// Only used to get Trace, so our errorHandler doesn't matter, since it's not used
-	configPath, err := confighelpers.DefaultFilePath()
+	configPath, err := confighelpers.ProfileFilePath(profile)
 	if err != nil {
 		errFunc(err)
 	}
@@ -73,8 +78,17 @@ func Main(traceEnv string, args []string) {
 	// Writer is assigned in writer_unix.go/writer_windows.go
 	traceLogger := trace.NewLogger(Writer, isVerbose, traceEnv, traceConfigVal)
 
-	deps := commandregistry.NewDependency(Writer, traceLogger, os.Getenv("CF_DIAL_TIMEOUT"))
+	deps := commandregistry.NewDependencyWithGlobalFlags(Writer, traceLogger, os.Getenv("CF_DIAL_TIMEOUT"), commandregistry.GlobalFlags{
+		RequestTimeout: net.ParseRequestTimeout(requestTimeout),
+		NoColor:        noColor,
+		Profile:        profile,
+	})
 	defer deps.Config.Close()
+	defer func() {
+		for _, gateway := range deps.Gateways {
+			gateway.CloseIdleConnections()
+		}
+	}()
 
 	warningProducers := []net.WarningProducer{}
 	for _, warningProducer := range deps.Gateways {
@@ -87,6 +101,13 @@ func Main(traceEnv string, args []string) {
 
 	//run core command
 	cmdName := args[1]
+	if output != "" {
+		deps.UI.Failed(T("The '{{.CommandName}}' command does not support --output.", map[string]interface{}{
+			"CommandName": cmdName,
+		}))
+		os.Exit(1)
+	}
+
 	cmd := cmdRegistry.FindCommand(cmdName)
 	if cmd != nil {
 		meta := cmd.MetaData()
@@ -222,3 +243,89 @@ func handleVerbose(args []string) ([]string, bool) {
 
 	return args, verbose
 }
+
+// globalFlagsWithValue are the global flags whose value is given as the next
+// argument.
+var globalFlagsWithValue = map[string]bool{
+	"--output":          true,
+	"--profile":         true,
+	"--request-timeout": true,
+}
+
+// nextGlobalFlag returns the index of the global flag after the one at i,
+// skipping the value of flags that take one.
+func nextGlobalFlag(args []string, i int) int {
+	if globalFlagsWithValue[args[i]] {
+		return i + 2
+	}
+	return i + 1
+}
+
+// handleNoColor removes --no-color from the global flags given before the
+// command name and reports whether it was given. Arguments after the command
+// name belong to the command and are left alone.
+func handleNoColor(args []string) ([]string, bool) {
+	for i := 1; i < len(args) && strings.HasPrefix(args[i], "-"); i = nextGlobalFlag(args, i) {
+		if args[i] == "--no-color" {
+			return append(args[:i], args[i+1:]...), true
+		}
+	}
+
+	return args, false
+}
+
+// handleOutput removes --output FORMAT (or --output=FORMAT) from the global
+// flags given before the command name and returns its value. Only commands
+// run through command.UI can produce structured output. Arguments after the
+// command name belong to the command and are left alone.
+func handleOutput(args []string) ([]string, string) {
+	for i := 1; i < len(args) && strings.HasPrefix(args[i], "-"); i = nextGlobalFlag(args, i) {
+		switch {
+		case args[i] == "--output" && i+1 < len(args):
+			output := args[i+1]
+			return append(args[:i], args[i+2:]...), output
+		case strings.HasPrefix(args[i], "--output="):
+			output := strings.TrimPrefix(args[i], "--output=")
+			return append(args[:i], args[i+1:]...), output
+		}
+	}
+
+	return args, ""
+}
+
+// handleProfile removes --profile NAME (or --profile=NAME) from the global
+// flags given before the command name and returns its value. Arguments after
+// the command name belong to the command and are left alone.
+func handleProfile(args []string) ([]string, string) {
+	for i := 1; i < len(args) && strings.HasPrefix(args[i], "-"); i = nextGlobalFlag(args, i) {
+		switch {
+		case args[i] == "--profile" && i+1 < len(args):
+			profile := args[i+1]
+			return append(args[:i], args[i+2:]...), profile
+		case strings.HasPrefix(args[i], "--profile="):
+			profile := strings.TrimPrefix(args[i], "--profile=")
+			return append(args[:i], args[i+1:]...), profile
+		}
+	}
+
+	return args, ""
+}
+
+// handleRequestTimeout removes --request-timeout SECONDS (or
+// --request-timeout=SECONDS) from the global flags given before the command
+// name and returns its value. Arguments after the command name belong to the
+// command and are left alone.
+func handleRequestTimeout(args []string) ([]string, string) {
+	for i := 1; i < len(args) && strings.HasPrefix(args[i], "-"); i = nextGlobalFlag(args, i) {
+		switch {
+		case args[i] == "--request-timeout" && i+1 < len(args):
+			timeout := args[i+1]
+			return append(args[:i], args[i+2:]...), timeout
+		case strings.HasPrefix(args[i], "--request-timeout="):
+			timeout := strings.TrimPrefix(args[i], "--request-timeout=")
+			return append(args[:i], args[i+1:]...), timeout
+		}
+	}
+
+	return args, ""
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"time"
 
 	"path/filepath"
@@ -68,7 +69,28 @@ type PluginModels struct {
 	OauthToken    *plugin_models.GetOauthToken_Model
 }
 
+// GlobalFlags are the global flags given before the command name. They
+// override the configuration for the current command only.
+type GlobalFlags struct {
+	// RequestTimeout is the maximum time each request to the Cloud Controller,
+	// UAA and routing API may take. Zero means no timeout.
+	RequestTimeout time.Duration
+
+	// NoColor disables colorized output.
+	NoColor bool
+
+	// Profile is the name of the config profile to use. When empty,
+	// $CF_PROFILE or the default profile is used.
+	Profile string
+}
+
 func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string) Dependency {
+	return NewDependencyWithGlobalFlags(writer, logger, envDialTimeout, GlobalFlags{})
+}
+
+// NewDependencyWithGlobalFlags is NewDependency with the global flags
+// applied.
+func NewDependencyWithGlobalFlags(writer io.Writer, logger trace.Printer, envDialTimeout string, globalFlags GlobalFlags) Dependency {
 	deps := Dependency{}
 	deps.TeePrinter = terminal.NewTeePrinter(writer)
 	deps.UI = terminal.NewUI(os.Stdin, writer, deps.TeePrinter, logger)
@@ -79,7 +101,7 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 		}
 	}
 
-	configPath, err := confighelpers.DefaultFilePath()
+	configPath, err := confighelpers.ProfileFilePath(globalFlags.Profile)
 	if err != nil {
 		errorHandler(err)
 	}
@@ -96,12 +118,38 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 	)
 
 	terminal.UserAskedForColors = deps.Config.ColorEnabled()
+	terminal.NoColorFlag = globalFlags.NoColor
 	terminal.InitColorSupport()
 
+	ccGateway := net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout)
+	ccGateway.RetryCount = net.DefaultRetryCount
+	ccGateway.RetryBackoff = net.DefaultRetryBackoff
+	ccGateway.MaxRetryAfter = net.DefaultMaxRetryAfter
+	ccGateway.RequestTimeout = globalFlags.RequestTimeout
+	if requestLog, _ := strconv.ParseBool(os.Getenv("CF_REQUEST_LOG")); requestLog {
+		ccGateway.RequestLogger = net.NewPrinterRequestLogger(trace.NewWriterPrinter(os.Stderr, true))
+	}
+
+	uaaGateway := net.NewUAAGateway(deps.Config, deps.UI, logger, envDialTimeout)
+	uaaGateway.RequestTimeout = globalFlags.RequestTimeout
+	routingAPIGateway := net.NewRoutingAPIGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout)
+	routingAPIGateway.RequestTimeout = globalFlags.RequestTimeout
+
+	if caCertFile := deps.Config.CACertFile(); caCertFile != "" {
+		caCertPool, err := net.LoadCACertPool(caCertFile)
+		if err != nil {
+			errorHandler(err)
+		} else {
+			ccGateway.SetCACertPool(caCertPool)
+			uaaGateway.SetCACertPool(caCertPool)
+			routingAPIGateway.SetCACertPool(caCertPool)
+		}
+	}
+
 	deps.Gateways = map[string]net.Gateway{
-		"cloud-controller": net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout),
-		"uaa":              net.NewUAAGateway(deps.Config, deps.UI, logger, envDialTimeout),
-		"routing-api":      net.NewRoutingAPIGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout),
+		"cloud-controller": ccGateway,
+		"uaa":              uaaGateway,
+		"routing-api":      routingAPIGateway,
 	}
 	deps.RepoLocator = api.NewRepositoryLocator(deps.Config, deps.Gateways, logger, envDialTimeout)
 
@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 func homeDir() (string, error) {
@@ -33,6 +35,26 @@ func DefaultFilePath() (string, error) {
 	return filepath.Join(homeDir, ".cf", "config.json"), nil
 }
 
+// ProfileFilePath returns the location of the config file for the profile
+// selected by the '--profile' flag value or $CF_PROFILE. The default profile
+// uses DefaultFilePath.
+func ProfileFilePath(flagProfile string) (string, error) {
+	profile, err := configv3.SelectProfile(flagProfile)
+	if err != nil {
+		return "", err
+	}
+	if profile == "" {
+		return DefaultFilePath()
+	}
+
+	homeDir, err := homeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".cf", fmt.Sprintf("config_%s.json", profile)), nil
+}
+
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
 // we can't cross compile using cgo and use user.Current()
 var userHomeDir = func() string {
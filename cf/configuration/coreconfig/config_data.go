@@ -29,11 +29,13 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
 	SpaceFields              models.SpaceFields
 	SSLDisabled              bool
+	CACertFile               string
 	AsyncTimeout             uint
 	Trace                    string
 	ColorEnabled             string
@@ -21,6 +21,7 @@ var _ = Describe("V3 Config files", func() {
 		"AccessToken": "the-access-token",
 		"UAAOAuthClient": "cf-oauth-client-id",
 		"UAAOAuthClientSecret": "cf-oauth-client-secret",
+		"UAAGrantType": "client_credentials",
 		"SSHOAuthClient": "ssh-oauth-client-id",
 		"RefreshToken": "the-refresh-token",
 		"OrganizationFields": {
@@ -42,6 +43,7 @@ var _ = Describe("V3 Config files", func() {
 			"AllowSSH": false
 		},
 		"SSLDisabled": true,
+		"CACertFile": "path/to/ca.pem",
 		"AsyncTimeout": 1000,
 		"Trace": "path/to/some/file",
 		"ColorEnabled": "true",
@@ -125,6 +127,7 @@ var _ = Describe("V3 Config files", func() {
 				RefreshToken:             "the-refresh-token",
 				UAAOAuthClient:           "cf-oauth-client-id",
 				UAAOAuthClientSecret:     "cf-oauth-client-secret",
+				UAAGrantType:             "client_credentials",
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
@@ -137,6 +140,7 @@ var _ = Describe("V3 Config files", func() {
 					Name: "the-space",
 				},
 				SSLDisabled:  true,
+				CACertFile:   "path/to/ca.pem",
 				Trace:        "path/to/some/file",
 				AsyncTimeout: 1000,
 				ColorEnabled: "true",
@@ -176,6 +180,7 @@ var _ = Describe("V3 Config files", func() {
 				RefreshToken:             "the-refresh-token",
 				UAAOAuthClient:           "cf-oauth-client-id",
 				UAAOAuthClientSecret:     "cf-oauth-client-secret",
+				UAAGrantType:             "client_credentials",
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
@@ -188,6 +193,7 @@ var _ = Describe("V3 Config files", func() {
 					Name: "the-space",
 				},
 				SSLDisabled:  true,
+				CACertFile:   "path/to/ca.pem",
 				Trace:        "path/to/some/file",
 				AsyncTimeout: 1000,
 				ColorEnabled: "true",
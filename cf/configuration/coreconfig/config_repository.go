@@ -66,6 +66,7 @@ type Reader interface {
 	AccessToken() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
+	UAAGrantType() string
 	SSHOAuthClient() string
 	RefreshToken() string
 
@@ -80,6 +81,7 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	CACertFile() string
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
 	MinCLIVersion() string
@@ -112,6 +114,7 @@ type ReadWriter interface {
 	SetAccessToken(string)
 	SetUAAOAuthClient(string)
 	SetUAAOAuthClientSecret(string)
+	SetUAAGrantType(string)
 	SetSSHOAuthClient(string)
 	SetRefreshToken(string)
 	SetOrganizationFields(models.OrganizationFields)
@@ -246,6 +249,16 @@ func (c *ConfigRepository) UAAOAuthClientSecret() (clientID string) {
 	return
 }
 
+// UAAGrantType is the grant type of the stored tokens. It is empty for the
+// password grant and "client_credentials" when the CLI authenticated as a
+// client, in which case there is no refresh token.
+func (c *ConfigRepository) UAAGrantType() (grantType string) {
+	c.read(func() {
+		grantType = c.data.UAAGrantType
+	})
+	return
+}
+
 func (c *ConfigRepository) SSHOAuthClient() (clientID string) {
 	c.read(func() {
 		clientID = c.data.SSHOAuthClient
@@ -323,6 +336,15 @@ func (c *ConfigRepository) IsSSLDisabled() (isSSLDisabled bool) {
 	return
 }
 
+// CACertFile is the path of a PEM file with additional CA certificates to
+// trust when connecting to the API.
+func (c *ConfigRepository) CACertFile() (caCertFile string) {
+	c.read(func() {
+		caCertFile = c.data.CACertFile
+	})
+	return
+}
+
 // SetCLIVersion should only be used in testing
 func (c *ConfigRepository) SetCLIVersion(v string) {
 	c.CFCLIVersion = v
@@ -427,6 +449,7 @@ func (c *ConfigRepository) ClearSession() {
 	c.write(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
+		c.data.UAAGrantType = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
 	})
@@ -498,6 +521,12 @@ func (c *ConfigRepository) SetUAAOAuthClientSecret(clientID string) {
 	})
 }
 
+func (c *ConfigRepository) SetUAAGrantType(grantType string) {
+	c.write(func() {
+		c.data.UAAGrantType = grantType
+	})
+}
+
 func (c *ConfigRepository) SetSSHOAuthClient(clientID string) {
 	c.write(func() {
 		c.data.SSHOAuthClient = clientID
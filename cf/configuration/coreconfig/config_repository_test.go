@@ -83,6 +83,9 @@ var _ = Describe("Configuration Repository", func() {
 		config.SetUAAOAuthClientSecret("cf-oauth-client-secret")
 		Expect(config.UAAOAuthClientSecret()).To(Equal("cf-oauth-client-secret"))
 
+		config.SetUAAGrantType("client_credentials")
+		Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+
 		config.SetSSHOAuthClient("oauth-client-id")
 		Expect(config.SSHOAuthClient()).To(Equal("oauth-client-id"))
 
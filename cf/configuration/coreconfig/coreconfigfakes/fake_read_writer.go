@@ -70,6 +70,12 @@ type FakeReadWriter struct {
 	uAAOAuthClientSecretReturns     struct {
 		result1 string
 	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
 	SSHOAuthClientStub        func() string
 	sSHOAuthClientMutex       sync.RWMutex
 	sSHOAuthClientArgsForCall []struct{}
@@ -158,6 +164,12 @@ type FakeReadWriter struct {
 	minCLIVersionReturns     struct {
 		result1 string
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
 	MinRecommendedCLIVersionStub        func() string
 	minRecommendedCLIVersionMutex       sync.RWMutex
 	minRecommendedCLIVersionArgsForCall []struct{}
@@ -258,6 +270,11 @@ type FakeReadWriter struct {
 	setUAAOAuthClientSecretArgsForCall []struct {
 		arg1 string
 	}
+	SetUAAGrantTypeStub        func(string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		arg1 string
+	}
 	SetSSHOAuthClientStub        func(string)
 	setSSHOAuthClientMutex       sync.RWMutex
 	setSSHOAuthClientArgsForCall []struct {
@@ -572,6 +589,31 @@ func (fake *FakeReadWriter) UAAOAuthClientSecretReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	} else {
+		return fake.uAAGrantTypeReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeReadWriter) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) SSHOAuthClient() string {
 	fake.sSHOAuthClientMutex.Lock()
 	fake.sSHOAuthClientArgsForCall = append(fake.sSHOAuthClientArgsForCall, struct{}{})
@@ -938,6 +980,31 @@ func (fake *FakeReadWriter) MinCLIVersionReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	} else {
+		return fake.cACertFileReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) MinRecommendedCLIVersion() string {
 	fake.minRecommendedCLIVersionMutex.Lock()
 	fake.minRecommendedCLIVersionArgsForCall = append(fake.minRecommendedCLIVersionArgsForCall, struct{}{})
@@ -1393,6 +1460,30 @@ func (fake *FakeReadWriter) SetUAAOAuthClientSecretArgsForCall(i int) string {
 	return fake.setUAAOAuthClientSecretArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetUAAGrantType(arg1 string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetSSHOAuthClient(arg1 string) {
 	fake.setSSHOAuthClientMutex.Lock()
 	fake.setSSHOAuthClientArgsForCall = append(fake.setSSHOAuthClientArgsForCall, struct {
@@ -1704,6 +1795,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
@@ -1732,6 +1825,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.isMinCLIVersionMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
 	fake.minRecommendedCLIVersionMutex.RLock()
 	defer fake.minRecommendedCLIVersionMutex.RUnlock()
 	fake.cLIVersionMutex.RLock()
@@ -1770,6 +1865,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.setUAAOAuthClientMutex.RUnlock()
 	fake.setUAAOAuthClientSecretMutex.RLock()
 	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.setSSHOAuthClientMutex.RLock()
 	defer fake.setSSHOAuthClientMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+type RateLimitError struct {
+	url        string
+	retryAfter time.Duration
+}
+
+func NewRateLimitError(url string, retryAfter time.Duration) error {
+	return &RateLimitError{url: url, retryAfter: retryAfter}
+}
+
+// RetryAfter is how long the server asked the client to wait before retrying.
+func (err *RateLimitError) RetryAfter() time.Duration {
+	return err.retryAfter
+}
+
+func (err *RateLimitError) Error() string {
+	return T("Error: request to '{{.ErrURL}}' was rate limited. Try again in {{.RetryAfter}}.",
+		map[string]interface{}{"ErrURL": err.url, "RetryAfter": err.retryAfter})
+}
@@ -0,0 +1,21 @@
+package errors
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+type RequestTimeoutError struct {
+	url     string
+	timeout time.Duration
+}
+
+func NewRequestTimeoutError(url string, timeout time.Duration) error {
+	return &RequestTimeoutError{url: url, timeout: timeout}
+}
+
+func (err *RequestTimeoutError) Error() string {
+	return T("Error: request to '{{.ErrURL}}' timed out after {{.Timeout}}",
+		map[string]interface{}{"ErrURL": err.url, "Timeout": err.timeout})
+}
@@ -0,0 +1,19 @@
+package errors
+
+import (
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+type RetriesExhaustedError struct {
+	HTTPError
+	Attempts int
+}
+
+func NewRetriesExhaustedError(err HTTPError, attempts int) error {
+	return &RetriesExhaustedError{HTTPError: err, Attempts: attempts}
+}
+
+func (err *RetriesExhaustedError) Error() string {
+	return T("{{.Err}} (gave up after {{.Attempts}} attempts)",
+		map[string]interface{}{"Err": err.HTTPError.Error(), "Attempts": err.Attempts})
+}
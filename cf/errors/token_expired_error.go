@@ -0,0 +1,24 @@
+package errors
+
+import (
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+// TokenExpiredError is returned when the access token has expired and could
+// not be refreshed, so the user has to log in again.
+type TokenExpiredError struct {
+	reason error
+}
+
+func NewTokenExpiredError(reason error) error {
+	return &TokenExpiredError{reason: reason}
+}
+
+// Reason returns the error that prevented the token from being refreshed.
+func (err *TokenExpiredError) Reason() error {
+	return err.reason
+}
+
+func (err *TokenExpiredError) Error() string {
+	return T("Authentication has expired.  Please log back in to re-authenticate.\n\nTIP: Use `cf login -a <endpoint> -u <user> -o <org> -s <space>` to log back in and re-authenticate.")
+}
@@ -0,0 +1,20 @@
+package errors
+
+import (
+	"strings"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+type TooManyRedirectsError struct {
+	URLs []string
+}
+
+func NewTooManyRedirectsError(urls []string) error {
+	return &TooManyRedirectsError{URLs: urls}
+}
+
+func (err *TooManyRedirectsError) Error() string {
+	return T("Error: too many redirects: {{.URLs}}",
+		map[string]interface{}{"URLs": strings.Join(err.URLs, " -> ")})
+}
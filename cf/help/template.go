@@ -28,6 +28,10 @@ func GetHelpTemplate() string {
 
 {{.Title "` + T("GLOBAL OPTIONS:") + `"}}
    --help, -h                         ` + T("Show help") + `
+   --no-color                         ` + T("Do not colorize output") + `
+   --output                           ` + T("Output format, only 'json' is supported") + `
+   --profile                          ` + T("Use the named config profile instead of the default one") + `
+   --request-timeout                  ` + T("Max wait time for a response to each request, in seconds") + `
    -v                                 ` + T("Print API request diagnostics to stdout") + `
 `
 }
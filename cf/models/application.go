@@ -44,6 +44,10 @@ func (model Application) ToParams() AppParams {
 		params.StackGUID = &model.Stack.GUID
 	}
 
+	if model.HealthCheckTimeout != 0 {
+		params.HealthCheckTimeout = &model.HealthCheckTimeout
+	}
+
 	return params
 }
 
@@ -40,5 +40,11 @@ func NewCloudControllerGateway(config coreconfig.Reader, clock func() time.Time,
 		logger:          logger,
 		PollingEnabled:  true,
 		DialTimeout:     dialTimeout(envDialTimeout),
+
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+		transports:          &transportCache{},
+		tokenRefreshes:      &tokenRefreshGroup{},
+		Metrics:             NoopMetrics{},
 	}
 }
@@ -2,7 +2,9 @@ package net
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,6 +16,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -21,6 +24,8 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/poll"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -29,6 +34,12 @@ const (
 	JobFailed              = "failed"
 	DefaultPollingThrottle = 5 * time.Second
 	DefaultDialTimeout     = 5 * time.Second
+	DefaultRetryCount      = 3
+	DefaultRetryBackoff    = 1 * time.Second
+	DefaultMaxRetryAfter   = 60 * time.Second
+
+	DefaultMaxIdleConnsPerHost = 10
+	DefaultIdleConnTimeout     = 90 * time.Second
 )
 
 type JobResource struct {
@@ -63,13 +74,53 @@ type Gateway struct {
 	PollingEnabled  bool
 	PollingThrottle time.Duration
 	trustedCerts    []tls.Certificate
+	caCertPool      *x509.CertPool
 	config          coreconfig.Reader
 	warnings        *[]string
 	Clock           func() time.Time
-	transport       *http.Transport
 	ui              terminal.UI
 	logger          trace.Printer
 	DialTimeout     time.Duration
+	RetryCount      int
+	RetryBackoff    time.Duration
+
+	// MaxRetryAfter is the longest Retry-After wait the gateway honors before
+	// retrying a rate limited (429) request. Longer waits return a
+	// RateLimitError instead.
+	MaxRetryAfter time.Duration
+
+	// RequestTimeout is the maximum time a single request, including reading
+	// the response body, may take. Zero means no timeout.
+	RequestTimeout time.Duration
+
+	// MaxIdleConnsPerHost and IdleConnTimeout tune the pool of keep-alive
+	// connections shared by every request made through the gateway.
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+
+	// RequestLogger, when set, is given a redacted summary of every request
+	// the gateway makes.
+	RequestLogger RequestLogger
+
+	// RetryPolicy, when set, decides which requests are retried and how long
+	// to wait before each retry, replacing the RetryCount, RetryBackoff and
+	// MaxRetryAfter behavior.
+	RetryPolicy RetryPolicy
+
+	// Metrics is given the endpoint, status and latency of every request the
+	// gateway makes. It defaults to NoopMetrics.
+	Metrics Metrics
+
+	transports     *transportCache
+	tokenRefreshes *tokenRefreshGroup
+}
+
+// transportCache holds the HTTP transport shared by a gateway and all of its
+// copies, so that connections are reused across requests.
+type transportCache struct {
+	mutex       sync.Mutex
+	transport   *http.Transport
+	sslDisabled bool
 }
 
 func (gateway *Gateway) AsyncTimeout() time.Duration {
@@ -198,7 +249,6 @@ func (gateway Gateway) newRequest(request *http.Request, accessToken string, bod
 	}
 
 	request.Header.Set("accept", "application/json")
-	request.Header.Set("Connection", "close")
 	request.Header.Set("content-type", "application/json")
 	request.Header.Set("User-Agent", "go-cli "+version.VersionString()+" / "+runtime.GOOS)
 
@@ -218,6 +268,9 @@ func (gateway Gateway) NewRequestForFile(method, fullURL, accessToken string, bo
 	if err != nil {
 		return nil, fmt.Errorf("%s: %s", T("Error building request"), err.Error())
 	}
+	if err = util.UnixSocketRequest(request); err != nil {
+		return nil, fmt.Errorf("%s: %s", T("Error building request"), err.Error())
+	}
 
 	fileSize := fileStats.Size()
 	progressReader.SetTotalSize(fileSize)
@@ -235,6 +288,9 @@ func (gateway Gateway) NewRequest(method, path, accessToken string, body io.Read
 	if err != nil {
 		return nil, fmt.Errorf("%s: %s", T("Error building request"), err.Error())
 	}
+	if err = util.UnixSocketRequest(request); err != nil {
+		return nil, fmt.Errorf("%s: %s", T("Error building request"), err.Error())
+	}
 	return gateway.newRequest(request, accessToken, body), nil
 }
 
@@ -251,6 +307,9 @@ func (gateway Gateway) performRequestForResponseBytes(request *Request) ([]byte,
 
 	bytes, err := ioutil.ReadAll(rawResponse.Body)
 	if err != nil {
+		if _, ok := err.(*errors.RequestTimeoutError); ok {
+			return bytes, nil, rawResponse, err
+		}
 		return bytes, nil, rawResponse, fmt.Errorf("%s: %s", T("Error reading response"), err.Error())
 	}
 
@@ -328,32 +387,39 @@ func (gateway Gateway) Warnings() []string {
 	return *gateway.warnings
 }
 
+// waitForJob polls the job until it finishes, fails or the timeout passes.
+// The wait between polls starts at PollingThrottle and backs off with jitter
+// so that long running jobs do not hammer the Cloud Controller.
 func (gateway Gateway) waitForJob(jobURL, accessToken string, timeout time.Duration) error {
-	startTime := gateway.Clock()
-	for true {
-		if gateway.Clock().Sub(startTime) > timeout && timeout != 0 {
-			return errors.NewAsyncTimeoutError(jobURL)
-		}
-		var request *Request
+	poller := poll.New(gateway.PollingThrottle, timeout)
+	poller.Now = gateway.Clock
+
+	err := poller.Poll(func() (bool, error) {
 		request, err := gateway.NewRequest("GET", jobURL, accessToken, nil)
+		if err != nil {
+			return false, err
+		}
 		response := &JobResource{}
 		_, err = gateway.PerformRequestForJSONResponse(request, response)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		switch response.Entity.Status {
 		case JobFinished:
-			return nil
+			return true, nil
 		case JobFailed:
-			return errors.New(response.Entity.ErrorDetails.Description)
+			return false, errors.New(response.Entity.ErrorDetails.Description)
 		}
 
 		accessToken = request.HTTPReq.Header.Get("Authorization")
+		return false, nil
+	})
 
-		time.Sleep(gateway.PollingThrottle)
+	if _, ok := err.(poll.TimeoutError); ok {
+		return errors.NewAsyncTimeoutError(jobURL)
 	}
-	return nil
+	return err
 }
 
 func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response, error) {
@@ -364,7 +430,7 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 	}
 
 	// perform request
-	rawResponse, err := gateway.doRequestAndHandlerError(request)
+	rawResponse, err := gateway.doRequestRetrying(request)
 	if err == nil || gateway.authenticator == nil {
 		return rawResponse, err
 	}
@@ -373,9 +439,9 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 	case *errors.InvalidTokenError:
 		// refresh the auth token
 		var newToken string
-		newToken, err = gateway.authenticator.RefreshAuthToken()
+		newToken, err = gateway.refreshAuthToken()
 		if err != nil {
-			return rawResponse, err
+			return rawResponse, errors.NewTokenExpiredError(err)
 		}
 
 		// reset the auth token and request body
@@ -386,40 +452,227 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 		}
 
 		// make the request again
-		rawResponse, err = gateway.doRequestAndHandlerError(request)
+		rawResponse, err = gateway.doRequestRetrying(request)
 	}
 
 	return rawResponse, err
 }
 
-func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Response, error) {
-	rawResponse, err := gateway.doRequest(request.HTTPReq)
-	if err != nil {
-		return rawResponse, WrapNetworkErrors(request.HTTPReq.URL.Host, err)
+// refreshAuthToken refreshes the auth token, sharing the refresh with any
+// other request made through the gateway or its copies that is refreshing at
+// the same time.
+func (gateway Gateway) refreshAuthToken() (string, error) {
+	if gateway.tokenRefreshes == nil {
+		return gateway.authenticator.RefreshAuthToken()
+	}
+	return gateway.tokenRefreshes.refresh(gateway.authenticator)
+}
+
+// doRequestRetrying makes the request, retrying it for as long as the
+// gateway's retry policy asks it to. Requests whose body cannot be rewound
+// are never retried. When the last attempt was rate limited a RateLimitError
+// is returned, and when it failed with a 500-504 after being retried a
+// RetriesExhaustedError is returned.
+func (gateway Gateway) doRequestRetrying(request *Request) (*http.Response, error) {
+	policy := gateway.retryPolicy()
+
+	for attempt := 1; ; attempt++ {
+		rawResponse, transportErr := gateway.doRequestWithTimeout(request.HTTPReq)
+
+		var err error
+		retryResponse := rawResponse
+		if transportErr != nil {
+			err = gateway.wrapTransportError(request, transportErr)
+			// A response that comes with a transport error is incomplete, so
+			// it is treated like no response when deciding whether to retry.
+			retryResponse = nil
+		} else {
+			err = gateway.handleErrorResponse(rawResponse)
+		}
+
+		var (
+			retry bool
+			wait  time.Duration
+		)
+		if canResend(request) {
+			retry, wait = shouldRetry(policy, request, retryResponse, attempt)
+		}
+		if !retry {
+			return rawResponse, gateway.lastAttemptError(request, rawResponse, err, attempt)
+		}
+
+		if rawResponse != nil {
+			if rawResponse.Body != nil {
+				_ = rawResponse.Body.Close()
+			}
+
+			if rawResponse.StatusCode == http.StatusTooManyRequests && gateway.warnings != nil {
+				*gateway.warnings = append(*gateway.warnings, T("Request to '{{.URL}}' was rate limited, retrying in {{.RetryAfter}}",
+					map[string]interface{}{"URL": request.HTTPReq.URL.String(), "RetryAfter": wait}))
+			}
+		}
+		time.Sleep(wait)
+
+		if request.SeekableBody != nil {
+			_, _ = request.SeekableBody.Seek(0, 0)
+			request.HTTPReq.Body = ioutil.NopCloser(request.SeekableBody)
+		}
 	}
+}
 
-	if rawResponse.StatusCode > 299 {
-		defer rawResponse.Body.Close()
-		jsonBytes, _ := ioutil.ReadAll(rawResponse.Body)
-		rawResponse.Body = ioutil.NopCloser(bytes.NewBuffer(jsonBytes))
-		err = gateway.errHandler(rawResponse.StatusCode, jsonBytes)
+// retryPolicy returns the gateway's RetryPolicy, or a DefaultRetryPolicy
+// using the gateway's RetryCount, RetryBackoff and MaxRetryAfter when none is
+// set.
+func (gateway Gateway) retryPolicy() RetryPolicy {
+	if gateway.RetryPolicy != nil {
+		return gateway.RetryPolicy
 	}
 
-	return rawResponse, err
+	return DefaultRetryPolicy{
+		MaxRetries:    gateway.RetryCount,
+		Backoff:       gateway.RetryBackoff,
+		MaxRetryAfter: gateway.MaxRetryAfter,
+		Clock:         gateway.Clock,
+	}
+}
+
+// shouldRetry asks the policy whether to retry. Error bodies are already
+// buffered, so the policy can read them without consuming them for the
+// caller.
+func shouldRetry(policy RetryPolicy, request *Request, rawResponse *http.Response, attempt int) (bool, time.Duration) {
+	if rawResponse == nil || rawResponse.StatusCode <= 299 {
+		return policy.ShouldRetry(request.HTTPReq, rawResponse, attempt)
+	}
+
+	if rawResponse.Body == nil {
+		return policy.ShouldRetry(request.HTTPReq, rawResponse, attempt)
+	}
+
+	body, _ := ioutil.ReadAll(rawResponse.Body)
+	rawResponse.Body = ioutil.NopCloser(bytes.NewReader(body))
+	retry, wait := policy.ShouldRetry(request.HTTPReq, rawResponse, attempt)
+	rawResponse.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return retry, wait
+}
+
+// canResend returns whether the request's body, if any, can be rewound to be
+// sent again.
+func canResend(request *Request) bool {
+	body := request.HTTPReq.Body
+	return request.SeekableBody != nil || body == nil || body == http.NoBody
+}
+
+func (gateway Gateway) lastAttemptError(request *Request, rawResponse *http.Response, err error, attempts int) error {
+	if rawResponse != nil && rawResponse.StatusCode == http.StatusTooManyRequests && isIdempotent(request.HTTPReq.Method) {
+		return errors.NewRateLimitError(request.HTTPReq.URL.String(), gateway.parseRetryAfter(rawResponse.Header.Get("Retry-After")))
+	}
+
+	if attempts > 1 && isRetryableServerError(err) {
+		return errors.NewRetriesExhaustedError(err.(errors.HTTPError), attempts)
+	}
+
+	return err
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date. RetryBackoff is used when the header is missing or invalid.
+func (gateway Gateway) parseRetryAfter(value string) time.Duration {
+	return parseRetryAfter(value, gateway.Clock(), gateway.RetryBackoff)
+}
+
+func parseRetryAfter(value string, now time.Time, fallback time.Duration) time.Duration {
+	value = strings.TrimSpace(value)
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			return 0
+		}
+		return wait
+	}
+
+	return fallback
+}
+
+func isIdempotent(method string) bool {
+	return method == "GET" || method == "HEAD"
+}
+
+func isRetryableServerError(err error) bool {
+	httpErr, ok := err.(errors.HTTPError)
+	if !ok {
+		return false
+	}
+
+	return httpErr.StatusCode() >= http.StatusInternalServerError &&
+		httpErr.StatusCode() <= http.StatusGatewayTimeout
+}
+
+// wrapTransportError wraps an error returned while sending the request.
+// Timeouts are returned as they are.
+func (gateway Gateway) wrapTransportError(request *Request, err error) error {
+	if _, ok := err.(*errors.RequestTimeoutError); ok {
+		return err
+	}
+	return WrapNetworkErrors(request.HTTPReq.URL.Host, err)
+}
+
+// handleErrorResponse buffers the body of a response with an error status and
+// returns the error the gateway's error handler makes of it.
+func (gateway Gateway) handleErrorResponse(rawResponse *http.Response) error {
+	if rawResponse.StatusCode <= 299 {
+		return nil
+	}
+
+	defer rawResponse.Body.Close()
+	jsonBytes, _ := ioutil.ReadAll(rawResponse.Body)
+	rawResponse.Body = ioutil.NopCloser(bytes.NewBuffer(jsonBytes))
+	return gateway.errHandler(rawResponse.StatusCode, jsonBytes)
+}
+
+// doRequestWithTimeout performs the request, giving up once RequestTimeout
+// has elapsed. The timeout also covers reading the response body, so the
+// timeout context is released when the body is closed.
+func (gateway Gateway) doRequestWithTimeout(request *http.Request) (*http.Response, error) {
+	if gateway.RequestTimeout <= 0 {
+		return gateway.doRequest(request)
+	}
+
+	ctx, cancel := context.WithTimeout(request.Context(), gateway.RequestTimeout)
+	request = request.WithContext(ctx)
+
+	response, err := gateway.doRequest(request)
+	if err != nil {
+		cancel()
+		if ctx.Err() == context.DeadlineExceeded {
+			return response, errors.NewRequestTimeoutError(request.URL.String(), gateway.RequestTimeout)
+		}
+		return response, err
+	}
+
+	response.Body = &timeoutBody{
+		ReadCloser: response.Body,
+		request:    request,
+		cancel:     cancel,
+		timeout:    gateway.RequestTimeout,
+	}
+	return response, nil
 }
 
 func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error) {
 	var response *http.Response
 	var err error
 
-	if gateway.transport == nil {
-		makeHTTPTransport(&gateway)
-	}
-
-	httpClient := NewHTTPClient(gateway.transport, NewRequestDumper(gateway.logger))
+	httpClient := NewHTTPClient(gateway.httpTransport(), NewRequestDumper(gateway.logger))
 
 	httpClient.DumpRequest(request)
 
+	startTime := gateway.Clock()
 	for i := 0; i < 3; i++ {
 		response, err = httpClient.Do(request)
 		if response == nil && err != nil {
@@ -429,6 +682,18 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 		}
 	}
 
+	if gateway.RequestLogger != nil {
+		gateway.logRequest(request, response, err, startTime)
+	}
+
+	if gateway.Metrics != nil {
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		gateway.Metrics.RecordRequest(request.Method, request.URL.Path, statusCode, gateway.Clock().Sub(startTime))
+	}
+
 	if err != nil {
 		return response, err
 	}
@@ -445,14 +710,94 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	return response, err
 }
 
-func makeHTTPTransport(gateway *Gateway) {
-	gateway.transport = &http.Transport{
-		Dial: (&net.Dialer{
+// timeoutBody releases the request's timeout context when the response body
+// is closed and reports reads cut short by the timeout as a
+// RequestTimeoutError.
+type timeoutBody struct {
+	io.ReadCloser
+	request *http.Request
+	cancel  context.CancelFunc
+	timeout time.Duration
+}
+
+func (body *timeoutBody) Read(p []byte) (int, error) {
+	n, err := body.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && body.request.Context().Err() == context.DeadlineExceeded {
+		err = errors.NewRequestTimeoutError(body.request.URL.String(), body.timeout)
+	}
+	return n, err
+}
+
+func (body *timeoutBody) Close() error {
+	defer body.cancel()
+	return body.ReadCloser.Close()
+}
+
+// httpTransport returns the transport shared by the gateway and its copies,
+// creating it on first use. The transport is recreated if SSL validation has
+// been enabled or disabled since it was created.
+func (gateway Gateway) httpTransport() *http.Transport {
+	if gateway.transports == nil {
+		return makeHTTPTransport(gateway)
+	}
+
+	cache := gateway.transports
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	sslDisabled := gateway.config.IsSSLDisabled()
+	if cache.transport == nil || cache.sslDisabled != sslDisabled {
+		if cache.transport != nil {
+			cache.transport.CloseIdleConnections()
+		}
+		cache.transport = makeHTTPTransport(gateway)
+		cache.sslDisabled = sslDisabled
+	}
+
+	return cache.transport
+}
+
+// resetTransport discards the shared transport so that the next request
+// creates one with the gateway's current TLS settings.
+func (gateway *Gateway) resetTransport() {
+	if gateway.transports == nil {
+		gateway.transports = &transportCache{}
+		return
+	}
+
+	gateway.CloseIdleConnections()
+
+	gateway.transports.mutex.Lock()
+	gateway.transports.transport = nil
+	gateway.transports.mutex.Unlock()
+}
+
+// CloseIdleConnections closes the keep-alive connections held open by the
+// gateway. It should be called when the gateway is no longer needed, so that
+// long running processes do not leak file descriptors.
+func (gateway Gateway) CloseIdleConnections() {
+	if gateway.transports == nil {
+		return
+	}
+
+	gateway.transports.mutex.Lock()
+	defer gateway.transports.mutex.Unlock()
+
+	if gateway.transports.transport != nil {
+		gateway.transports.transport.CloseIdleConnections()
+	}
+}
+
+func makeHTTPTransport(gateway Gateway) *http.Transport {
+	return &http.Transport{
+		DialContext: util.UnixSocketDialContext((&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   gateway.DialTimeout,
-		}).Dial,
-		TLSClientConfig: NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled()),
-		Proxy:           http.ProxyFromEnvironment,
+		}).DialContext),
+		TLSClientConfig:     NewTLSConfigWithRootCAs(gateway.caCertPool, gateway.trustedCerts, gateway.config.IsSSLDisabled()),
+		Proxy:               util.UnixSocketProxy(ProxyFromEnvironment),
+		MaxIdleConnsPerHost: gateway.MaxIdleConnsPerHost,
+		IdleConnTimeout:     gateway.IdleConnTimeout,
 	}
 }
 
@@ -464,7 +809,25 @@ func dialTimeout(envDialTimeout string) time.Duration {
 	return dialTimeout
 }
 
+// ParseRequestTimeout converts a number of seconds, as given by
+// --request-timeout, to a request timeout. Zero, meaning no timeout, is
+// returned if the value is missing or invalid.
+func ParseRequestTimeout(requestTimeout string) time.Duration {
+	timeout, err := strconv.Atoi(requestTimeout)
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return time.Duration(timeout) * time.Second
+}
+
 func (gateway *Gateway) SetTrustedCerts(certificates []tls.Certificate) {
 	gateway.trustedCerts = certificates
-	makeHTTPTransport(gateway)
+	gateway.resetTransport()
+}
+
+// SetCACertPool sets the pool of CA certificates that servers are verified
+// against, in place of the system pool. See LoadCACertPool.
+func (gateway *Gateway) SetCACertPool(certPool *x509.CertPool) {
+	gateway.caCertPool = certPool
+	gateway.resetTransport()
 }
@@ -0,0 +1,77 @@
+package net_test
+
+import (
+	"fmt"
+	stdnet "net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/i18n"
+	. "code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+)
+
+// BenchmarkSequentialGets compares making 100 sequential GETs through a
+// single gateway, which reuses its keep-alive connection, with making them
+// through a new gateway each time, which performs a TLS handshake per
+// request.
+func BenchmarkSequentialGets(b *testing.B) {
+	var handshakes int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	server.Config.ConnState = func(_ stdnet.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&handshakes, 1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	config := testconfig.NewRepositoryWithDefaults()
+	config.SetSSLDisabled(true)
+	i18n.T = i18n.Init(config)
+	newGateway := func() Gateway {
+		return NewCloudControllerGateway(config, time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+	}
+
+	get := func(b *testing.B, gateway Gateway) {
+		request, err := gateway.NewRequest("GET", server.URL, "BEARER my-access-token", nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, _, err = gateway.PerformRequestForTextResponse(request); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.Run("shared gateway", func(b *testing.B) {
+		atomic.StoreInt32(&handshakes, 0)
+		gateway := newGateway()
+		defer gateway.CloseIdleConnections()
+
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 100; j++ {
+				get(b, gateway)
+			}
+		}
+		b.Logf("%d TLS handshakes for %d requests", atomic.LoadInt32(&handshakes), b.N*100)
+	})
+
+	b.Run("gateway per request", func(b *testing.B) {
+		atomic.StoreInt32(&handshakes, 0)
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 100; j++ {
+				gateway := newGateway()
+				get(b, gateway)
+				gateway.CloseIdleConnections()
+			}
+		}
+		b.Logf("%d TLS handshakes for %d requests", atomic.LoadInt32(&handshakes), b.N*100)
+	})
+}
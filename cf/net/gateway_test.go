@@ -3,9 +3,12 @@ package net_test
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	stdnet "net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -13,6 +16,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/authentication"
@@ -50,6 +54,7 @@ var _ = Describe("Gateway", func() {
 
 		ccGateway = NewCloudControllerGateway(config, clock, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
 		ccGateway.PollingThrottle = 3 * time.Millisecond
+		ccGateway.RetryBackoff = time.Millisecond
 		uaaGateway = NewUAAGateway(config, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
 	})
 
@@ -100,6 +105,601 @@ var _ = Describe("Gateway", func() {
 		})
 	})
 
+	Describe("Server errors", func() {
+		var oldNewHTTPClient func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface
+
+		BeforeEach(func() {
+			client = new(netfakes.FakeHTTPClientInterface)
+
+			oldNewHTTPClient = NewHTTPClient
+			NewHTTPClient = func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface {
+				return client
+			}
+
+			ccGateway.RetryCount = 2
+		})
+
+		AfterEach(func() {
+			NewHTTPClient = oldNewHTTPClient
+		})
+
+		serverErrorResponse := func(*http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"code": 10001}`)),
+			}, nil
+		}
+
+		It("retries GET requests that fail with a 5xx status", func() {
+			client.DoStub = serverErrorResponse
+			request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(client.DoCallCount()).To(Equal(3))
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.RetriesExhaustedError{}))
+			Expect(apiErr.(*errors.RetriesExhaustedError).Attempts).To(Equal(3))
+			Expect(apiErr.(errors.HTTPError).StatusCode()).To(Equal(http.StatusBadGateway))
+			Expect(apiErr.Error()).To(ContainSubstring("gave up after 3 attempts"))
+		})
+
+		It("stops retrying once the request succeeds", func() {
+			client.DoStub = func(request *http.Request) (*http.Response, error) {
+				if client.DoCallCount() == 1 {
+					return serverErrorResponse(request)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+				}, nil
+			}
+			request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+			Expect(client.DoCallCount()).To(Equal(2))
+		})
+
+		It("does not retry non-idempotent requests", func() {
+			client.DoStub = serverErrorResponse
+			request, apiErr := ccGateway.NewRequest("POST", "https://example.com/v2/apps", "BEARER my-access-token", strings.NewReader("{}"))
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(client.DoCallCount()).To(Equal(1))
+			Expect(apiErr).NotTo(BeAssignableToTypeOf(&errors.RetriesExhaustedError{}))
+			Expect(apiErr.(errors.HTTPError).StatusCode()).To(Equal(http.StatusBadGateway))
+		})
+
+		It("does not retry client errors", func() {
+			client.DoStub = func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+				}, nil
+			}
+			request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(client.DoCallCount()).To(Equal(1))
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.HTTPNotFoundError{}))
+		})
+	})
+
+	Describe("Rate limiting", func() {
+		var (
+			server       *httptest.Server
+			retryAfter   string
+			limitedCount int
+			requestCount int
+		)
+
+		BeforeEach(func() {
+			retryAfter = "0"
+			limitedCount = 1
+			requestCount = 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if requestCount <= limitedCount {
+					w.Header().Set("Retry-After", retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					fmt.Fprint(w, `{}`)
+					return
+				}
+				fmt.Fprint(w, `{"ok": true}`)
+			}))
+			ccGateway.MaxRetryAfter = 10 * time.Second
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		performRequest := func(method string) (string, error) {
+			request, apiErr := ccGateway.NewRequest(method, server.URL+"/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			body, _, apiErr := ccGateway.PerformRequestForTextResponse(request)
+			return body, apiErr
+		}
+
+		Context("when Retry-After is given in seconds", func() {
+			It("waits and retries the request once, with a warning", func() {
+				body, apiErr := performRequest("GET")
+				Expect(apiErr).ToNot(HaveOccurred())
+				Expect(body).To(Equal(`{"ok": true}`))
+				Expect(requestCount).To(Equal(2))
+				Expect(ccGateway.Warnings()).To(ConsistOf(ContainSubstring("was rate limited, retrying in 0s")))
+			})
+
+			It("returns a RateLimitError when the wait is longer than MaxRetryAfter", func() {
+				retryAfter = "120"
+
+				_, apiErr := performRequest("GET")
+				Expect(apiErr).To(BeAssignableToTypeOf(&errors.RateLimitError{}))
+				Expect(apiErr.(*errors.RateLimitError).RetryAfter()).To(Equal(120 * time.Second))
+				Expect(requestCount).To(Equal(1))
+			})
+		})
+
+		Context("when Retry-After is given as an HTTP date", func() {
+			It("waits until the date and retries the request", func() {
+				retryAfter = currentTime.UTC().Format(http.TimeFormat)
+
+				_, apiErr := performRequest("GET")
+				Expect(apiErr).ToNot(HaveOccurred())
+				Expect(requestCount).To(Equal(2))
+			})
+
+			It("returns a RateLimitError when the date is further away than MaxRetryAfter", func() {
+				retryAfter = currentTime.Add(5 * time.Minute).UTC().Format(http.TimeFormat)
+
+				_, apiErr := performRequest("GET")
+				Expect(apiErr).To(BeAssignableToTypeOf(&errors.RateLimitError{}))
+				Expect(apiErr.(*errors.RateLimitError).RetryAfter()).To(Equal(5 * time.Minute))
+			})
+		})
+
+		It("returns a RateLimitError when the retry is rate limited too", func() {
+			limitedCount = 2
+
+			_, apiErr := performRequest("GET")
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.RateLimitError{}))
+			Expect(requestCount).To(Equal(2))
+		})
+
+		It("does not retry non-idempotent requests", func() {
+			_, apiErr := performRequest("POST")
+			Expect(apiErr).To(HaveOccurred())
+			Expect(apiErr).NotTo(BeAssignableToTypeOf(&errors.RateLimitError{}))
+			Expect(requestCount).To(Equal(1))
+		})
+	})
+
+	Describe("Retry policies", func() {
+		var (
+			server       *httptest.Server
+			requestCount int
+		)
+
+		BeforeEach(func() {
+			requestCount = 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if requestCount == 1 {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"code": 10001, "description": "try again later"}`)
+					return
+				}
+				fmt.Fprint(w, `{"ok": true}`)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("retries the requests the custom policy asks it to", func() {
+			policy := new(netfakes.FakeRetryPolicy)
+			policy.ShouldRetryStub = func(req *http.Request, resp *http.Response, attempt int) (bool, time.Duration) {
+				if resp == nil || resp.StatusCode != http.StatusBadRequest {
+					return false, 0
+				}
+				body, err := ioutil.ReadAll(resp.Body)
+				Expect(err).ToNot(HaveOccurred())
+				return strings.Contains(string(body), "try again later"), time.Millisecond
+			}
+			ccGateway.RetryPolicy = policy
+
+			request, apiErr := ccGateway.NewRequest("POST", server.URL+"/v2/apps", "BEARER my-access-token", strings.NewReader("{}"))
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			body, _, apiErr := ccGateway.PerformRequestForTextResponse(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+			Expect(body).To(Equal(`{"ok": true}`))
+			Expect(requestCount).To(Equal(2))
+
+			Expect(policy.ShouldRetryCallCount()).To(Equal(2))
+			_, _, attempt := policy.ShouldRetryArgsForCall(0)
+			Expect(attempt).To(Equal(1))
+			_, _, attempt = policy.ShouldRetryArgsForCall(1)
+			Expect(attempt).To(Equal(2))
+		})
+
+		It("returns the error when the custom policy does not retry", func() {
+			policy := new(netfakes.FakeRetryPolicy)
+			policy.ShouldRetryReturns(false, 0)
+			ccGateway.RetryPolicy = policy
+
+			request, apiErr := ccGateway.NewRequest("GET", server.URL+"/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, _, apiErr = ccGateway.PerformRequestForTextResponse(request)
+			Expect(apiErr).To(HaveOccurred())
+			Expect(apiErr.Error()).To(ContainSubstring("try again later"))
+			Expect(requestCount).To(Equal(1))
+		})
+
+		It("does not retry requests whose body cannot be rewound", func() {
+			policy := new(netfakes.FakeRetryPolicy)
+			policy.ShouldRetryReturns(true, time.Millisecond)
+			ccGateway.RetryPolicy = policy
+
+			httpReq, err := http.NewRequest("POST", server.URL+"/v2/apps", ioutil.NopCloser(strings.NewReader("{}")))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, apiErr := ccGateway.PerformRequestForTextResponse(&Request{HTTPReq: httpReq})
+			Expect(apiErr).To(HaveOccurred())
+			Expect(requestCount).To(Equal(1))
+			Expect(policy.ShouldRetryCallCount()).To(Equal(0))
+		})
+
+		Context("when the custom policy retries a successful response", func() {
+			var (
+				oldNewHTTPClient func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface
+				bodies           []*closeRecordingBody
+			)
+
+			BeforeEach(func() {
+				bodies = nil
+				client = new(netfakes.FakeHTTPClientInterface)
+				client.DoStub = func(*http.Request) (*http.Response, error) {
+					body := &closeRecordingBody{Reader: strings.NewReader(`{}`)}
+					bodies = append(bodies, body)
+					return &http.Response{StatusCode: http.StatusOK, Body: body}, nil
+				}
+
+				oldNewHTTPClient = NewHTTPClient
+				NewHTTPClient = func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface {
+					return client
+				}
+			})
+
+			AfterEach(func() {
+				NewHTTPClient = oldNewHTTPClient
+			})
+
+			It("closes the response body before retrying", func() {
+				policy := new(netfakes.FakeRetryPolicy)
+				policy.ShouldRetryStub = func(req *http.Request, resp *http.Response, attempt int) (bool, time.Duration) {
+					return attempt == 1, time.Millisecond
+				}
+				ccGateway.RetryPolicy = policy
+
+				request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				_, apiErr = ccGateway.PerformRequest(request)
+				Expect(apiErr).ToNot(HaveOccurred())
+				Expect(client.DoCallCount()).To(Equal(2))
+				Expect(bodies[0].closed).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Request timeouts", func() {
+		var (
+			server  *httptest.Server
+			release chan struct{}
+		)
+
+		BeforeEach(func() {
+			release = make(chan struct{})
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/hang":
+					<-release
+				case "/hang-body":
+					w.WriteHeader(http.StatusOK)
+					w.(http.Flusher).Flush()
+					<-release
+				default:
+					fmt.Fprint(w, `{}`)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			close(release)
+			server.Close()
+		})
+
+		Context("when the request timeout is set", func() {
+			BeforeEach(func() {
+				ccGateway.RequestTimeout = 50 * time.Millisecond
+			})
+
+			It("returns a RequestTimeoutError when the server does not respond in time", func() {
+				request, apiErr := ccGateway.NewRequest("GET", server.URL+"/hang", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				_, apiErr = ccGateway.PerformRequest(request)
+				Expect(apiErr).To(BeAssignableToTypeOf(&errors.RequestTimeoutError{}))
+				Expect(apiErr.Error()).To(ContainSubstring(server.URL + "/hang"))
+			})
+
+			It("returns a RequestTimeoutError when the response body is not read in time", func() {
+				request, apiErr := ccGateway.NewRequest("GET", server.URL+"/hang-body", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				_, _, apiErr = ccGateway.PerformRequestForTextResponse(request)
+				Expect(apiErr).To(BeAssignableToTypeOf(&errors.RequestTimeoutError{}))
+			})
+
+			It("succeeds when the server responds in time", func() {
+				request, apiErr := ccGateway.NewRequest("GET", server.URL+"/fast", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				body, _, apiErr := ccGateway.PerformRequestForTextResponse(request)
+				Expect(apiErr).ToNot(HaveOccurred())
+				Expect(body).To(Equal(`{}`))
+			})
+		})
+
+		Describe("ParseRequestTimeout", func() {
+			It("converts seconds to a duration", func() {
+				Expect(ParseRequestTimeout("30")).To(Equal(30 * time.Second))
+			})
+
+			It("returns no timeout for missing or invalid values", func() {
+				Expect(ParseRequestTimeout("")).To(BeZero())
+				Expect(ParseRequestTimeout("banana")).To(BeZero())
+				Expect(ParseRequestTimeout("-1")).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Connection reuse", func() {
+		var (
+			server         *httptest.Server
+			newConnections int32
+		)
+
+		BeforeEach(func() {
+			newConnections = 0
+			server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{}`)
+			}))
+			server.Config.ConnState = func(_ stdnet.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					atomic.AddInt32(&newConnections, 1)
+				}
+			}
+			server.StartTLS()
+
+			config.SetSSLDisabled(true)
+		})
+
+		AfterEach(func() {
+			ccGateway.CloseIdleConnections()
+			server.Close()
+		})
+
+		performGet := func(gateway Gateway) {
+			request, apiErr := gateway.NewRequest("GET", server.URL, "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, _, apiErr = gateway.PerformRequestForTextResponse(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+		}
+
+		It("reuses a single connection for sequential requests", func() {
+			for i := 0; i < 100; i++ {
+				performGet(ccGateway)
+			}
+
+			Expect(atomic.LoadInt32(&newConnections)).To(BeEquivalentTo(1))
+		})
+
+		It("shares connections between copies of the gateway", func() {
+			gatewayCopy := ccGateway
+			performGet(ccGateway)
+			performGet(gatewayCopy)
+
+			Expect(atomic.LoadInt32(&newConnections)).To(BeEquivalentTo(1))
+		})
+
+		It("opens a new connection after the idle connections are closed", func() {
+			performGet(ccGateway)
+			ccGateway.CloseIdleConnections()
+			performGet(ccGateway)
+
+			Expect(atomic.LoadInt32(&newConnections)).To(BeEquivalentTo(2))
+		})
+
+		It("sets the default connection pool settings", func() {
+			Expect(ccGateway.MaxIdleConnsPerHost).To(Equal(DefaultMaxIdleConnsPerHost))
+			Expect(ccGateway.IdleConnTimeout).To(Equal(DefaultIdleConnTimeout))
+		})
+	})
+
+	Describe("Request logging", func() {
+		var (
+			server        *httptest.Server
+			requestLogger *netfakes.FakeRequestLogger
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				currentTime = currentTime.Add(250 * time.Millisecond)
+				w.Header().Set("Set-Cookie", "session=some-session")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"access_token":"some-response-token","entity":{"name":"some-name","credentials":{"username":"some-user"}}}`)
+			}))
+
+			requestLogger = new(netfakes.FakeRequestLogger)
+			ccGateway.RequestLogger = requestLogger
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("logs the method, URL, status and elapsed time of each request", func() {
+			request, apiErr := ccGateway.NewRequest("GET", server.URL+"/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, _, apiErr = ccGateway.PerformRequestForTextResponse(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			Expect(requestLogger.LogRequestCallCount()).To(Equal(1))
+			entry := requestLogger.LogRequestArgsForCall(0)
+			Expect(entry.Method).To(Equal("GET"))
+			Expect(entry.URL).To(Equal(server.URL + "/v2/apps"))
+			Expect(entry.StatusCode).To(Equal(http.StatusCreated))
+			Expect(entry.Elapsed).To(Equal(250 * time.Millisecond))
+			Expect(entry.Err).ToNot(HaveOccurred())
+		})
+
+		It("redacts tokens and credentials from headers and bodies", func() {
+			body := strings.NewReader(`{"name":"some-name","password":"some-password","parameters":{"client_secret":"some-secret"}}`)
+			request, apiErr := ccGateway.NewRequest("POST", server.URL+"/v2/service_keys", "BEARER my-access-token", body)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			responseBody, _, apiErr := ccGateway.PerformRequestForTextResponse(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+			Expect(responseBody).To(ContainSubstring("some-response-token"))
+
+			entry := requestLogger.LogRequestArgsForCall(0)
+			Expect(entry.RequestHeader.Get("Authorization")).To(Equal("[PRIVATE DATA HIDDEN]"))
+			Expect(entry.ResponseHeader.Get("Set-Cookie")).To(Equal("[PRIVATE DATA HIDDEN]"))
+
+			Expect(entry.RequestBody).To(MatchJSON(`{"name":"some-name","password":"[PRIVATE DATA HIDDEN]","parameters":{"client_secret":"[PRIVATE DATA HIDDEN]"}}`))
+			Expect(entry.ResponseBody).To(MatchJSON(`{"access_token":"[PRIVATE DATA HIDDEN]","entity":{"name":"some-name","credentials":"[PRIVATE DATA HIDDEN]"}}`))
+
+			for _, secret := range []string{"my-access-token", "some-password", "some-secret", "some-response-token", "some-user", "some-session"} {
+				Expect(fmt.Sprintf("%v", entry)).ToNot(ContainSubstring(secret))
+			}
+		})
+
+		It("does not redact the request that is sent", func() {
+			request, apiErr := ccGateway.NewRequest("GET", server.URL, "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, _, apiErr = ccGateway.PerformRequestForTextResponse(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+			Expect(request.HTTPReq.Header.Get("Authorization")).To(Equal("BEARER my-access-token"))
+		})
+
+		Describe("PrinterRequestLogger", func() {
+			It("prints one line per request", func() {
+				printer := new(tracefakes.FakePrinter)
+				ccGateway.RequestLogger = NewPrinterRequestLogger(printer)
+
+				request, apiErr := ccGateway.NewRequest("GET", server.URL+"/v2/apps", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+				_, _, apiErr = ccGateway.PerformRequestForTextResponse(request)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				Expect(printer.PrintfCallCount()).To(Equal(1))
+				format, args := printer.PrintfArgsForCall(0)
+				Expect(fmt.Sprintf(format, args...)).To(Equal(fmt.Sprintf("GET %s/v2/apps 201 250ms\n", server.URL)))
+			})
+		})
+	})
+
+	Describe("Request metrics", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				currentTime = currentTime.Add(75 * time.Millisecond)
+				if strings.HasSuffix(r.URL.Path, "/missing") {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(w, `{}`)
+					return
+				}
+				fmt.Fprint(w, `{}`)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		performRequest := func(method string, path string) {
+			request, apiErr := ccGateway.NewRequest(method, server.URL+path, "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+			_, _, _ = ccGateway.PerformRequestForTextResponse(request)
+		}
+
+		It("discards metrics by default", func() {
+			Expect(ccGateway.Metrics).To(Equal(NoopMetrics{}))
+			performRequest("GET", "/v2/apps")
+			Expect(ccGateway.Metrics.Endpoints()).To(BeEmpty())
+		})
+
+		It("records each request by method and normalized path", func() {
+			metrics := NewInMemoryMetrics()
+			ccGateway.Metrics = metrics
+
+			performRequest("GET", "/v2/apps/11111111-2222-3333-4444-555555555555")
+			performRequest("GET", "/v2/apps/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee")
+			performRequest("GET", "/v2/apps/missing")
+			performRequest("DELETE", "/v2/apps/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee")
+
+			endpoints := metrics.Endpoints()
+			Expect(endpoints).To(HaveLen(3))
+
+			getApp := endpoints["GET /v2/apps/:guid"]
+			Expect(getApp.Count).To(Equal(2))
+			Expect(getApp.Errors).To(Equal(0))
+			Expect(getApp.TotalLatency).To(Equal(150 * time.Millisecond))
+			Expect(getApp.LatencyHistogram[2]).To(Equal(2))
+
+			Expect(endpoints["GET /v2/apps/missing"].Errors).To(Equal(1))
+			Expect(endpoints["DELETE /v2/apps/:guid"].Count).To(Equal(1))
+		})
+	})
+
+	Describe("Redirects", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/loop", http.StatusFound)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("returns a TooManyRedirectsError with the visited URLs when redirected in a loop", func() {
+			request, apiErr := ccGateway.NewRequest("GET", server.URL+"/loop", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.TooManyRedirectsError{}))
+			Expect(apiErr.(*errors.TooManyRedirectsError).URLs).To(Equal([]string{
+				server.URL + "/loop",
+				server.URL + "/loop",
+			}))
+		})
+	})
+
 	Describe("NewRequest", func() {
 		var (
 			request *Request
@@ -528,6 +1128,84 @@ var _ = Describe("Gateway", func() {
 		})
 	})
 
+	Describe("when the token cannot be refreshed", func() {
+		var (
+			apiServer  *httptest.Server
+			authServer *httptest.Server
+		)
+
+		BeforeEach(func() {
+			apiServer = httptest.NewTLSServer(refreshTokenAPIEndPoint(
+				`{ "code": 1000, "description": "Auth token is invalid" }`,
+				testnet.TestResponse{Status: http.StatusOK},
+			))
+			authServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintln(w, `{ "error": "invalid_token", "error_description": "Refresh token expired" }`)
+			}))
+			apiServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+			authServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+		})
+
+		AfterEach(func() {
+			apiServer.Close()
+			authServer.Close()
+		})
+
+		It("returns a TokenExpiredError prompting the user to log in again", func() {
+			config, auth := createAuthenticationRepository(apiServer, authServer)
+			ccGateway.SetTokenRefresher(auth)
+			ccGateway.SetTrustedCerts(apiServer.TLS.Certificates)
+
+			request, _ := ccGateway.NewRequest("POST", config.APIEndpoint()+"/v2/foo", config.AccessToken(), strings.NewReader("expected body"))
+			_, apiErr := ccGateway.PerformRequest(request)
+
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.TokenExpiredError{}))
+			Expect(apiErr.Error()).To(ContainSubstring("Please log back in to re-authenticate"))
+			Expect(config.AccessToken()).To(Equal("bearer initial-access-token"))
+		})
+	})
+
+	Describe("when concurrent requests find the token expired", func() {
+		var apiServer *httptest.Server
+
+		BeforeEach(func() {
+			apiServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") == "bearer new-access-token" {
+					fmt.Fprintln(w, `{}`)
+					return
+				}
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintln(w, `{ "code": 1000, "description": "Auth token is invalid" }`)
+			}))
+			apiServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+			ccGateway.SetTrustedCerts(apiServer.TLS.Certificates)
+		})
+
+		AfterEach(func() {
+			apiServer.Close()
+		})
+
+		It("refreshes the token only once", func() {
+			refresher := &slowTokenRefresher{token: "bearer new-access-token", delay: 200 * time.Millisecond}
+			ccGateway.SetTokenRefresher(refresher)
+
+			errs := make(chan error)
+			for i := 0; i < 5; i++ {
+				go func() {
+					request, _ := ccGateway.NewRequest("GET", apiServer.URL+"/v2/foo", "bearer initial-access-token", nil)
+					_, err := ccGateway.PerformRequest(request)
+					errs <- err
+				}()
+			}
+
+			for i := 0; i < 5; i++ {
+				Expect(<-errs).NotTo(HaveOccurred())
+			}
+			Expect(atomic.LoadInt32(&refresher.calls)).To(BeEquivalentTo(1))
+		})
+	})
+
 	Describe("SSL certificate validation errors", func() {
 		var (
 			request   *Request
@@ -594,6 +1272,68 @@ var _ = Describe("Gateway", func() {
 			})
 		})
 
+		Context("when a CA certificate file is provided", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				file, err := ioutil.TempFile("", "ca-cert")
+				Expect(err).NotTo(HaveOccurred())
+				caCertFile = file.Name()
+				Expect(pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: apiServer.Certificate().Raw})).To(Succeed())
+				Expect(file.Close()).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Remove(caCertFile)).To(Succeed())
+			})
+
+			It("trusts servers signed by the CA", func() {
+				_, apiErr := ccGateway.PerformRequest(request)
+				Expect(apiErr).To(HaveOccurred())
+
+				caCertPool, err := LoadCACertPool(caCertFile)
+				Expect(err).NotTo(HaveOccurred())
+				ccGateway.SetCACertPool(caCertPool)
+
+				request, _ = ccGateway.NewRequest("POST", apiServer.URL+"/v2/foo", "the-access-token", nil)
+				_, apiErr = ccGateway.PerformRequest(request)
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
+
+	})
+
+	Describe("LoadCACertPool", func() {
+		var caCertFile string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "ca-cert")
+			Expect(err).NotTo(HaveOccurred())
+			caCertFile = file.Name()
+			Expect(file.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(caCertFile)).To(Succeed())
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns a descriptive error", func() {
+				_, err := LoadCACertPool(caCertFile + "-missing")
+				Expect(err).To(MatchError(ContainSubstring("Unable to read CA certificate file " + caCertFile + "-missing")))
+			})
+		})
+
+		Context("when the file does not contain any certificates", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(caCertFile, []byte("not a certificate"), 0600)).To(Succeed())
+			})
+
+			It("returns a descriptive error", func() {
+				_, err := LoadCACertPool(caCertFile)
+				Expect(err).To(MatchError("CA certificate file " + caCertFile + " does not contain any valid PEM encoded certificates"))
+			})
+		})
 	})
 
 	Describe("collecting warnings", func() {
@@ -660,6 +1400,18 @@ func getHost(urlString string) string {
 	return url.Host
 }
 
+type slowTokenRefresher struct {
+	calls int32
+	token string
+	delay time.Duration
+}
+
+func (refresher *slowTokenRefresher) RefreshAuthToken() (string, error) {
+	atomic.AddInt32(&refresher.calls, 1)
+	time.Sleep(refresher.delay)
+	return refresher.token, nil
+}
+
 func refreshTokenAPIEndPoint(unauthorizedBody string, secondReqResp testnet.TestResponse) http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
 		var jsonResponse string
@@ -701,3 +1453,13 @@ func createAuthenticationRepository(apiServer *httptest.Server, authServer *http
 
 	return config, authenticator
 }
+
+type closeRecordingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (body *closeRecordingBody) Close() error {
+	body.closed = true
+	return nil
+}
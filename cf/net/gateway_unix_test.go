@@ -0,0 +1,87 @@
+// +build !windows
+
+package net_test
+
+import (
+	"io/ioutil"
+	stdnet "net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gateway over a unix socket", func() {
+	var (
+		socketDir  string
+		socketPath string
+		server     *httptest.Server
+		gateway    Gateway
+		request    *http.Request
+	)
+
+	BeforeEach(func() {
+		var err error
+		socketDir, err = ioutil.TempDir("", "cf-net-unix")
+		Expect(err).ToNot(HaveOccurred())
+		socketPath = filepath.Join(socketDir, "cc.sock")
+
+		listener, err := stdnet.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"api_version": "2.100.0"}`))
+		}))
+		server.Listener = listener
+		server.Start()
+
+		gateway = NewCloudControllerGateway(testconfig.NewRepository(), time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(socketDir)
+	})
+
+	It("sends requests for unix URLs over the socket", func() {
+		var info struct {
+			APIVersion string `json:"api_version"`
+		}
+		err := gateway.GetResource("unix://"+socketPath+"/v2/info", &info)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(info.APIVersion).To(Equal("2.100.0"))
+		Expect(request.URL.Path).To(Equal("/v2/info"))
+		Expect(request.Host).To(Equal("localhost"))
+	})
+
+	Context("when the proxy environment is set", func() {
+		BeforeEach(func() {
+			os.Setenv("HTTP_PROXY", "http://127.0.0.1:1")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("HTTP_PROXY")
+		})
+
+		It("does not proxy requests for unix URLs", func() {
+			err := gateway.GetResource("unix://"+socketPath+"/v2/info", &struct{}{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	It("returns an error for unix URLs that do not name a socket", func() {
+		err := gateway.GetResource("unix://"+socketDir+"/v2/info", &struct{}{})
+		Expect(err).To(MatchError(ContainSubstring("does not name a .sock file")))
+	})
+})
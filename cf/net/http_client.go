@@ -14,6 +14,10 @@ import (
 	"golang.org/x/net/websocket"
 )
 
+// MaxRedirects is the number of redirects a request may follow before a
+// TooManyRedirectsError is returned.
+const MaxRedirects = 1
+
 //go:generate counterfeiter . HTTPClientInterface
 
 type HTTPClientInterface interface {
@@ -45,24 +49,42 @@ func (cl *client) ExecuteCheckRedirect(req *http.Request, via []*http.Request) e
 }
 
 func (cl *client) checkRedirect(req *http.Request, via []*http.Request) error {
-	if len(via) > 1 {
-		return errors.New(T("stopped after 1 redirect"))
+	if len(via) > MaxRedirects || isRedirectLoop(req, via) {
+		urls := make([]string, 0, len(via)+1)
+		for _, visited := range via {
+			urls = append(urls, visited.URL.String())
+		}
+		return errors.NewTooManyRedirectsError(append(urls, req.URL.String()))
 	}
 
 	prevReq := via[len(via)-1]
-	cl.copyHeaders(prevReq, req, getBaseDomain(req.URL.String()) == getBaseDomain(via[0].URL.String()))
+	cl.copyHeaders(prevReq, req, req.URL.Host == via[0].URL.Host)
 	cl.dumper.DumpRequest(req)
 
 	return nil
 }
 
-func (cl *client) copyHeaders(from *http.Request, to *http.Request, sameDomain bool) {
+func (cl *client) copyHeaders(from *http.Request, to *http.Request, sameHost bool) {
 	for key, values := range from.Header {
 		// do not copy POST-specific headers
-		if key != "Content-Type" && key != "Content-Length" && !(!sameDomain && key == "Authorization") {
+		if key != "Content-Type" && key != "Content-Length" && !(!sameHost && key == "Authorization") {
 			to.Header.Set(key, strings.Join(values, ","))
 		}
 	}
+
+	// never leak the token to another host, even if it was already copied
+	if !sameHost {
+		to.Header.Del("Authorization")
+	}
+}
+
+func isRedirectLoop(req *http.Request, via []*http.Request) bool {
+	for _, visited := range via {
+		if visited.URL.String() == req.URL.String() {
+			return true
+		}
+	}
+	return false
 }
 
 func (cl *client) DumpRequest(req *http.Request) {
@@ -84,6 +106,8 @@ func WrapNetworkErrors(host string, err error) error {
 
 	if innerErr != nil {
 		switch typedInnerErr := innerErr.(type) {
+		case *errors.TooManyRedirectsError:
+			return typedInnerErr
 		case x509.UnknownAuthorityError:
 			return errors.NewInvalidSSLCert(host, T("unknown authority"))
 		case x509.HostnameError:
@@ -99,9 +123,3 @@ func WrapNetworkErrors(host string, err error) error {
 
 	return fmt.Errorf("%s: %s", T("Error performing request"), err.Error())
 }
-
-func getBaseDomain(host string) string {
-	hostURL, _ := url.Parse(host)
-	hostStrs := strings.Split(hostURL.Host, ".")
-	return hostStrs[len(hostStrs)-2] + "." + hostStrs[len(hostStrs)-1]
-}
@@ -99,10 +99,52 @@ var _ = Describe("HTTP Client", func() {
 			err = client.ExecuteCheckRedirect(redirectReq, via)
 
 			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(&errors.TooManyRedirectsError{}))
+			Expect(err.(*errors.TooManyRedirectsError).URLs).To(Equal([]string{
+				"http://local.com/foo",
+				"http://local.com/manchu",
+				"http://local.com/bar",
+			}))
+		})
+
+		It("fails when redirected back to a URL it has already visited", func() {
+			originalReq, err := http.NewRequest("GET", "http://local.com/foo", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			redirectReq, err := http.NewRequest("GET", "http://local.com/foo", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.ExecuteCheckRedirect(redirectReq, []*http.Request{originalReq})
+
+			Expect(err).To(MatchError(errors.NewTooManyRedirectsError([]string{
+				"http://local.com/foo",
+				"http://local.com/foo",
+			})))
+		})
+
+		It("does not transfer 'Authorization' headers during a redirect to a different host on the same domain", func() {
+			originalReq, err := http.NewRequest("GET", "http://api.local.com/foo", nil)
+			Expect(err).NotTo(HaveOccurred())
+			originalReq.Header.Set("Authorization", "my-auth-token")
+
+			redirectReq, err := http.NewRequest("GET", "http://login.local.com/bar", nil)
+			Expect(err).NotTo(HaveOccurred())
+			redirectReq.Header.Set("Authorization", "my-auth-token")
+
+			err = client.ExecuteCheckRedirect(redirectReq, []*http.Request{originalReq})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(redirectReq.Header.Get("Authorization")).To(Equal(""))
 		})
 	})
 
 	Describe("WrapNetworkErrors", func() {
+		It("returns TooManyRedirectsError errors unwrapped", func() {
+			redirectErr := errors.NewTooManyRedirectsError([]string{"http://local.com/foo", "http://local.com/foo"})
+			err := WrapNetworkErrors("local.com", &url.Error{Err: redirectErr})
+			Expect(err).To(Equal(redirectErr))
+		})
+
 		It("replaces http unknown authority errors with InvalidSSLCert errors", func() {
 			err, ok := WrapNetworkErrors("example.com", &url.Error{Err: x509.UnknownAuthorityError{}}).(*errors.InvalidSSLCert)
 			Expect(ok).To(BeTrue())
@@ -0,0 +1,128 @@
+package net
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds of the buckets used by the latency
+// histograms kept by InMemoryMetrics.
+var LatencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+var guidPathSegment = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Metrics records the requests a gateway makes so that they can be inspected
+// later, for example by a plugin or a test looking for the calls that
+// dominate a command.
+type Metrics interface {
+	RecordRequest(method string, path string, statusCode int, elapsed time.Duration)
+	Endpoints() map[string]EndpointMetrics
+}
+
+// EndpointMetrics are the aggregated metrics of a single endpoint.
+type EndpointMetrics struct {
+	// Count is the number of requests made to the endpoint.
+	Count int
+
+	// Errors is the number of requests that failed or returned a status of
+	// 400 or above.
+	Errors int
+
+	// TotalLatency is the sum of the latencies of all the requests.
+	TotalLatency time.Duration
+
+	// LatencyHistogram has one more element than LatencyBuckets. Element i
+	// counts the requests that took at most LatencyBuckets[i] and were not
+	// counted in an earlier element; the last element counts the rest.
+	LatencyHistogram []int
+}
+
+// NoopMetrics discards every request. It is the default for all gateways.
+type NoopMetrics struct{}
+
+func (NoopMetrics) RecordRequest(string, string, int, time.Duration) {}
+
+func (NoopMetrics) Endpoints() map[string]EndpointMetrics {
+	return map[string]EndpointMetrics{}
+}
+
+// InMemoryMetrics aggregates requests per endpoint in memory. It is safe for
+// concurrent use.
+type InMemoryMetrics struct {
+	mutex     sync.Mutex
+	endpoints map[string]*EndpointMetrics
+}
+
+// NewInMemoryMetrics returns an empty InMemoryMetrics.
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{endpoints: map[string]*EndpointMetrics{}}
+}
+
+// RecordRequest adds the request to the metrics of its endpoint, which is
+// the method and path with GUIDs collapsed to :guid, e.g.
+// "GET /v2/apps/:guid".
+func (metrics *InMemoryMetrics) RecordRequest(method string, path string, statusCode int, elapsed time.Duration) {
+	endpoint := method + " " + NormalizePath(path)
+
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	endpointMetrics, ok := metrics.endpoints[endpoint]
+	if !ok {
+		endpointMetrics = &EndpointMetrics{LatencyHistogram: make([]int, len(LatencyBuckets)+1)}
+		metrics.endpoints[endpoint] = endpointMetrics
+	}
+
+	endpointMetrics.Count++
+	if statusCode == 0 || statusCode >= 400 {
+		endpointMetrics.Errors++
+	}
+	endpointMetrics.TotalLatency += elapsed
+
+	bucket := len(LatencyBuckets)
+	for i, upperBound := range LatencyBuckets {
+		if elapsed <= upperBound {
+			bucket = i
+			break
+		}
+	}
+	endpointMetrics.LatencyHistogram[bucket]++
+}
+
+// Endpoints returns a copy of the metrics recorded so far, keyed by
+// endpoint.
+func (metrics *InMemoryMetrics) Endpoints() map[string]EndpointMetrics {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	endpoints := map[string]EndpointMetrics{}
+	for endpoint, endpointMetrics := range metrics.endpoints {
+		copied := *endpointMetrics
+		copied.LatencyHistogram = append([]int(nil), endpointMetrics.LatencyHistogram...)
+		endpoints[endpoint] = copied
+	}
+	return endpoints
+}
+
+// NormalizePath replaces every GUID segment of path with :guid.
+func NormalizePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if guidPathSegment.MatchString(segment) {
+			segments[i] = ":guid"
+		}
+	}
+	return strings.Join(segments, "/")
+}
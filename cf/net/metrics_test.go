@@ -0,0 +1,54 @@
+package net_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics", func() {
+	Describe("NormalizePath", func() {
+		It("collapses GUID segments to :guid", func() {
+			Expect(NormalizePath("/v2/apps/11111111-2222-3333-4444-555555555555/routes/AAAAAAAA-BBBB-CCCC-DDDD-EEEEEEEEEEEE")).To(Equal("/v2/apps/:guid/routes/:guid"))
+		})
+
+		It("leaves other segments alone", func() {
+			Expect(NormalizePath("/v2/apps/some-app-guid/instances/0")).To(Equal("/v2/apps/some-app-guid/instances/0"))
+		})
+	})
+
+	Describe("InMemoryMetrics", func() {
+		var metrics *InMemoryMetrics
+
+		BeforeEach(func() {
+			metrics = NewInMemoryMetrics()
+		})
+
+		It("aggregates counts, errors and latencies per endpoint", func() {
+			metrics.RecordRequest("GET", "/v2/info", 200, 5*time.Millisecond)
+			metrics.RecordRequest("GET", "/v2/info", 500, 300*time.Millisecond)
+			metrics.RecordRequest("GET", "/v2/info", 0, time.Minute)
+
+			info := metrics.Endpoints()["GET /v2/info"]
+			Expect(info.Count).To(Equal(3))
+			Expect(info.Errors).To(Equal(2))
+			Expect(info.TotalLatency).To(Equal(time.Minute + 305*time.Millisecond))
+
+			Expect(info.LatencyHistogram).To(HaveLen(len(LatencyBuckets) + 1))
+			Expect(info.LatencyHistogram[0]).To(Equal(1))
+			Expect(info.LatencyHistogram[4]).To(Equal(1))
+			Expect(info.LatencyHistogram[len(LatencyBuckets)]).To(Equal(1))
+		})
+
+		It("returns a copy of the metrics", func() {
+			metrics.RecordRequest("GET", "/v2/info", 200, time.Millisecond)
+
+			endpoints := metrics.Endpoints()
+			endpoints["GET /v2/info"].LatencyHistogram[0] = 42
+
+			Expect(metrics.Endpoints()["GET /v2/info"].LatencyHistogram[0]).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// This file was generated by counterfeiter
+package netfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/net"
+)
+
+type FakeRequestLogger struct {
+	LogRequestStub        func(entry net.RequestLogEntry)
+	logRequestMutex       sync.RWMutex
+	logRequestArgsForCall []struct {
+		entry net.RequestLogEntry
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRequestLogger) LogRequest(entry net.RequestLogEntry) {
+	fake.logRequestMutex.Lock()
+	fake.logRequestArgsForCall = append(fake.logRequestArgsForCall, struct {
+		entry net.RequestLogEntry
+	}{entry})
+	fake.recordInvocation("LogRequest", []interface{}{entry})
+	fake.logRequestMutex.Unlock()
+	if fake.LogRequestStub != nil {
+		fake.LogRequestStub(entry)
+	}
+}
+
+func (fake *FakeRequestLogger) LogRequestCallCount() int {
+	fake.logRequestMutex.RLock()
+	defer fake.logRequestMutex.RUnlock()
+	return len(fake.logRequestArgsForCall)
+}
+
+func (fake *FakeRequestLogger) LogRequestArgsForCall(i int) net.RequestLogEntry {
+	fake.logRequestMutex.RLock()
+	defer fake.logRequestMutex.RUnlock()
+	return fake.logRequestArgsForCall[i].entry
+}
+
+func (fake *FakeRequestLogger) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.logRequestMutex.RLock()
+	defer fake.logRequestMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRequestLogger) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ net.RequestLogger = new(FakeRequestLogger)
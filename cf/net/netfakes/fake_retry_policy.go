@@ -0,0 +1,109 @@
+// This file was generated by counterfeiter
+package netfakes
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/net"
+)
+
+type FakeRetryPolicy struct {
+	ShouldRetryStub        func(req *http.Request, resp *http.Response, attempt int) (bool, time.Duration)
+	shouldRetryMutex       sync.RWMutex
+	shouldRetryArgsForCall []struct {
+		req     *http.Request
+		resp    *http.Response
+		attempt int
+	}
+	shouldRetryReturns struct {
+		result1 bool
+		result2 time.Duration
+	}
+	shouldRetryReturnsOnCall map[int]struct {
+		result1 bool
+		result2 time.Duration
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRetryPolicy) ShouldRetry(req *http.Request, resp *http.Response, attempt int) (bool, time.Duration) {
+	fake.shouldRetryMutex.Lock()
+	ret, specificReturn := fake.shouldRetryReturnsOnCall[len(fake.shouldRetryArgsForCall)]
+	fake.shouldRetryArgsForCall = append(fake.shouldRetryArgsForCall, struct {
+		req     *http.Request
+		resp    *http.Response
+		attempt int
+	}{req, resp, attempt})
+	fake.recordInvocation("ShouldRetry", []interface{}{req, resp, attempt})
+	fake.shouldRetryMutex.Unlock()
+	if fake.ShouldRetryStub != nil {
+		return fake.ShouldRetryStub(req, resp, attempt)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.shouldRetryReturns.result1, fake.shouldRetryReturns.result2
+}
+
+func (fake *FakeRetryPolicy) ShouldRetryCallCount() int {
+	fake.shouldRetryMutex.RLock()
+	defer fake.shouldRetryMutex.RUnlock()
+	return len(fake.shouldRetryArgsForCall)
+}
+
+func (fake *FakeRetryPolicy) ShouldRetryArgsForCall(i int) (*http.Request, *http.Response, int) {
+	fake.shouldRetryMutex.RLock()
+	defer fake.shouldRetryMutex.RUnlock()
+	return fake.shouldRetryArgsForCall[i].req, fake.shouldRetryArgsForCall[i].resp, fake.shouldRetryArgsForCall[i].attempt
+}
+
+func (fake *FakeRetryPolicy) ShouldRetryReturns(result1 bool, result2 time.Duration) {
+	fake.ShouldRetryStub = nil
+	fake.shouldRetryReturns = struct {
+		result1 bool
+		result2 time.Duration
+	}{result1, result2}
+}
+
+func (fake *FakeRetryPolicy) ShouldRetryReturnsOnCall(i int, result1 bool, result2 time.Duration) {
+	fake.ShouldRetryStub = nil
+	if fake.shouldRetryReturnsOnCall == nil {
+		fake.shouldRetryReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 time.Duration
+		})
+	}
+	fake.shouldRetryReturnsOnCall[i] = struct {
+		result1 bool
+		result2 time.Duration
+	}{result1, result2}
+}
+
+func (fake *FakeRetryPolicy) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.shouldRetryMutex.RLock()
+	defer fake.shouldRetryMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRetryPolicy) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ net.RetryPolicy = new(FakeRetryPolicy)
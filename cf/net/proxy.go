@@ -0,0 +1,120 @@
+package net
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// ProxyFromEnvironment returns the proxy to use for req based on the
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables (or their
+// lowercase versions). Unlike http.ProxyFromEnvironment, the environment is
+// read on every call and NO_PROXY may contain CIDR blocks (10.0.0.0/8),
+// wildcard domains (*.example.com or .example.com) and host:port pairs. A
+// nil URL is returned when the request should not be proxied.
+func ProxyFromEnvironment(req *http.Request) (*url.URL, error) {
+	var proxy string
+	if req.URL.Scheme == "https" {
+		proxy = getEnvAny("HTTPS_PROXY", "https_proxy")
+	} else {
+		proxy = getEnvAny("HTTP_PROXY", "http_proxy")
+	}
+	if proxy == "" {
+		return nil, nil
+	}
+
+	if !useProxy(req.URL, getEnvAny("NO_PROXY", "no_proxy")) {
+		return nil, nil
+	}
+
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || !strings.HasPrefix(proxyURL.Scheme, "http") {
+		// proxy was bogus; try prepending "http://" to it and see if that
+		// parses correctly
+		if proxyURL, err := url.Parse("http://" + proxy); err == nil {
+			return proxyURL, nil
+		}
+	}
+	return proxyURL, err
+}
+
+func getEnvAny(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// useProxy returns false if requestURL's host is localhost, a loopback
+// address or matches an entry in noProxy.
+func useProxy(requestURL *url.URL, noProxy string) bool {
+	host, port := requestURL.Hostname(), requestURL.Port()
+	if port == "" {
+		port = defaultPort(requestURL.Scheme)
+	}
+	host = strings.ToLower(host)
+
+	if host == "localhost" {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip != nil && ip.IsLoopback() {
+		return false
+	}
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return false
+		}
+
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return false
+			}
+			continue
+		}
+
+		entryHost, entryPort := entry, ""
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			entryHost, entryPort = h, p
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+
+		if entryIP := net.ParseIP(entryHost); entryIP != nil {
+			if ip != nil && entryIP.Equal(ip) {
+				return false
+			}
+			continue
+		}
+
+		entryHost = strings.TrimPrefix(entryHost, "*")
+		if strings.HasPrefix(entryHost, ".") {
+			if strings.HasSuffix(host, entryHost) {
+				return false
+			}
+			continue
+		}
+		if host == entryHost || strings.HasSuffix(host, "."+entryHost) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func defaultPort(scheme string) string {
+	if scheme == "https" {
+		return "443"
+	}
+	return "80"
+}
@@ -0,0 +1,102 @@
+package net_test
+
+import (
+	"net/http"
+	"os"
+
+	. "code.cloudfoundry.org/cli/cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProxyFromEnvironment", func() {
+	var proxyEnvVars = []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy"}
+	var savedEnv map[string]string
+
+	BeforeEach(func() {
+		savedEnv = map[string]string{}
+		for _, name := range proxyEnvVars {
+			savedEnv[name] = os.Getenv(name)
+			os.Unsetenv(name)
+		}
+	})
+
+	AfterEach(func() {
+		for name, value := range savedEnv {
+			os.Setenv(name, value)
+		}
+	})
+
+	proxyFor := func(rawURL string) string {
+		req, err := http.NewRequest("GET", rawURL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		proxyURL, err := ProxyFromEnvironment(req)
+		Expect(err).ToNot(HaveOccurred())
+		if proxyURL == nil {
+			return ""
+		}
+		return proxyURL.String()
+	}
+
+	Context("when no proxy is configured", func() {
+		It("does not use a proxy", func() {
+			Expect(proxyFor("https://api.example.com")).To(BeEmpty())
+		})
+	})
+
+	Context("when proxies are configured", func() {
+		BeforeEach(func() {
+			os.Setenv("https_proxy", "http://secure-proxy.corp:8080")
+			os.Setenv("HTTP_PROXY", "plain-proxy.corp:3128")
+		})
+
+		It("uses the proxy matching the request scheme", func() {
+			Expect(proxyFor("https://api.example.com")).To(Equal("http://secure-proxy.corp:8080"))
+			Expect(proxyFor("http://api.example.com")).To(Equal("http://plain-proxy.corp:3128"))
+		})
+
+		It("never proxies localhost or loopback addresses", func() {
+			Expect(proxyFor("https://localhost:8443")).To(BeEmpty())
+			Expect(proxyFor("https://127.0.0.1")).To(BeEmpty())
+		})
+
+		DescribeTable("NO_PROXY exclusions",
+			func(noProxy string, rawURL string, bypass bool) {
+				os.Setenv("NO_PROXY", noProxy)
+				if bypass {
+					Expect(proxyFor(rawURL)).To(BeEmpty())
+				} else {
+					Expect(proxyFor(rawURL)).To(Equal("http://secure-proxy.corp:8080"))
+				}
+			},
+
+			Entry("exact host", "api.local", "https://api.local", true),
+			Entry("subdomain of a domain", "local", "https://api.sys.local", true),
+			Entry("leading dot domain", ".sys.local", "https://api.sys.local", true),
+			Entry("leading dot does not match the bare domain", ".sys.local", "https://sys.local", false),
+			Entry("wildcard domain", "*.sys.local", "https://api.sys.local", true),
+			Entry("unrelated host", "api.local", "https://api.example.com", false),
+			Entry("partial host name", "example.com", "https://notexample.com", false),
+			Entry("everything", "*", "https://api.example.com", true),
+			Entry("IP address", "10.0.0.5", "https://10.0.0.5", true),
+			Entry("IP address inside a CIDR block", "other.host, 10.0.0.0/8", "https://10.1.2.3:9000", true),
+			Entry("IP address outside a CIDR block", "10.0.0.0/8", "https://192.168.1.1", false),
+			Entry("host with matching port", "api.local:443", "https://api.local", true),
+			Entry("host with different port", "api.local:8443", "https://api.local", false),
+			Entry("mixed case entry", "API.Local", "https://api.local", true),
+		)
+
+		It("honors lowercase no_proxy", func() {
+			os.Setenv("no_proxy", "api.local")
+			Expect(proxyFor("https://api.local")).To(BeEmpty())
+		})
+
+		It("reads the environment on every call", func() {
+			Expect(proxyFor("https://api.local")).ToNot(BeEmpty())
+			os.Setenv("NO_PROXY", "api.local")
+			Expect(proxyFor("https://api.local")).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package net
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/trace"
+)
+
+//go:generate counterfeiter . RequestLogger
+
+// RequestLogger records a summary of every request a gateway makes. Entries
+// are redacted before they are passed to the logger, so implementations can
+// write them anywhere, including to plugins capturing traces.
+type RequestLogger interface {
+	LogRequest(entry RequestLogEntry)
+}
+
+// RequestLogEntry describes a single request and its response. Sensitive
+// headers and body fields have been replaced with a placeholder.
+type RequestLogEntry struct {
+	Method         string
+	URL            string
+	StatusCode     int
+	Elapsed        time.Duration
+	RequestHeader  http.Header
+	RequestBody    string
+	ResponseHeader http.Header
+	ResponseBody   string
+	Err            error
+}
+
+// PrinterRequestLogger writes one line per request to a trace.Printer.
+type PrinterRequestLogger struct {
+	printer trace.Printer
+}
+
+// NewPrinterRequestLogger returns a RequestLogger that writes the method, URL,
+// status and elapsed time of each request to printer.
+func NewPrinterRequestLogger(printer trace.Printer) PrinterRequestLogger {
+	return PrinterRequestLogger{printer: printer}
+}
+
+func (logger PrinterRequestLogger) LogRequest(entry RequestLogEntry) {
+	if entry.Err != nil {
+		logger.printer.Printf("%s %s failed after %s: %s\n", entry.Method, entry.URL, entry.Elapsed, entry.Err)
+		return
+	}
+	logger.printer.Printf("%s %s %d %s\n", entry.Method, entry.URL, entry.StatusCode, entry.Elapsed)
+}
+
+var (
+	redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+	redactedFields  = regexp.MustCompile(`(?i)token|password|secret|credentials`)
+)
+
+// redactHeader returns a copy of header with credential headers replaced by
+// the private data placeholder.
+func redactHeader(header http.Header) http.Header {
+	redacted := http.Header{}
+	for key, values := range header {
+		redacted[key] = append([]string(nil), values...)
+	}
+	for _, key := range redactedHeaders {
+		if _, ok := redacted[key]; ok {
+			redacted.Set(key, trace.PrivateDataPlaceholder())
+		}
+	}
+	return redacted
+}
+
+// redactBody replaces the values of credential fields in a JSON body. Bodies
+// that are not JSON are sanitized the same way CF_TRACE output is.
+func redactBody(body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return trace.Sanitize(string(body))
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(decoded))
+	if err != nil {
+		return trace.Sanitize(string(body))
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if redactedFields.MatchString(key) {
+				typed[key] = trace.PrivateDataPlaceholder()
+			} else {
+				typed[key] = redactJSONValue(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = redactJSONValue(child)
+		}
+	}
+	return value
+}
+
+func (gateway Gateway) logRequest(request *http.Request, response *http.Response, err error, startTime time.Time) {
+	entry := RequestLogEntry{
+		Method:        request.Method,
+		URL:           request.URL.String(),
+		Elapsed:       gateway.Clock().Sub(startTime),
+		RequestHeader: redactHeader(request.Header),
+		Err:           err,
+	}
+
+	if request.GetBody != nil && !strings.Contains(request.Header.Get("Content-Type"), "multipart/form-data") {
+		if body, bodyErr := request.GetBody(); bodyErr == nil {
+			if raw, readErr := ioutil.ReadAll(body); readErr == nil && len(raw) > 0 {
+				entry.RequestBody = redactBody(raw)
+			}
+			_ = body.Close()
+		}
+	}
+
+	if response != nil {
+		entry.StatusCode = response.StatusCode
+		entry.ResponseHeader = redactHeader(response.Header)
+
+		if response.Body != nil {
+			raw, readErr := ioutil.ReadAll(response.Body)
+			_ = response.Body.Close()
+			response.Body = ioutil.NopCloser(bytes.NewReader(raw))
+			if readErr != nil {
+				entry.Err = fmt.Errorf("reading response body: %s", readErr)
+			} else if len(raw) > 0 {
+				entry.ResponseBody = redactBody(raw)
+			}
+		}
+	}
+
+	gateway.RequestLogger.LogRequest(entry)
+}
@@ -0,0 +1,68 @@
+package net
+
+import (
+	"net/http"
+	"time"
+)
+
+//go:generate counterfeiter . RetryPolicy
+
+// RetryPolicy decides whether a request made through the gateway should be
+// retried. ShouldRetry is called after every attempt, starting at attempt 1,
+// with the response received (nil if the request could not be made) and
+// returns whether to retry and how long to wait first. Error response bodies
+// can be read by the policy without consuming them.
+type RetryPolicy interface {
+	ShouldRetry(req *http.Request, resp *http.Response, attempt int) (bool, time.Duration)
+}
+
+// DefaultRetryPolicy retries idempotent requests that fail with a 500-504
+// up to MaxRetries times, doubling Backoff between attempts, and retries a
+// 429 once after its Retry-After, as long as that is within MaxRetryAfter.
+type DefaultRetryPolicy struct {
+	MaxRetries    int
+	Backoff       time.Duration
+	MaxRetryAfter time.Duration
+	Clock         func() time.Time
+}
+
+// NewDefaultRetryPolicy returns a DefaultRetryPolicy with the same limits the
+// gateway uses when no RetryPolicy is set.
+func NewDefaultRetryPolicy() DefaultRetryPolicy {
+	return DefaultRetryPolicy{
+		MaxRetries:    DefaultRetryCount,
+		Backoff:       DefaultRetryBackoff,
+		MaxRetryAfter: DefaultMaxRetryAfter,
+		Clock:         time.Now,
+	}
+}
+
+func (policy DefaultRetryPolicy) ShouldRetry(req *http.Request, resp *http.Response, attempt int) (bool, time.Duration) {
+	if resp == nil || !isIdempotent(req.Method) {
+		return false, 0
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if attempt > 1 {
+			return false, 0
+		}
+
+		clock := policy.Clock
+		if clock == nil {
+			clock = time.Now
+		}
+		wait := parseRetryAfter(resp.Header.Get("Retry-After"), clock(), policy.Backoff)
+		if wait > policy.MaxRetryAfter {
+			return false, 0
+		}
+		return true, wait
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode <= http.StatusGatewayTimeout:
+		if attempt > policy.MaxRetries {
+			return false, 0
+		}
+		return true, policy.Backoff * time.Duration(1<<uint(attempt-1))
+	}
+
+	return false, 0
+}
@@ -0,0 +1,92 @@
+package net_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DefaultRetryPolicy", func() {
+	var (
+		policy DefaultRetryPolicy
+		now    time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2017, time.April, 1, 12, 0, 0, 0, time.UTC)
+		policy = DefaultRetryPolicy{
+			MaxRetries:    2,
+			Backoff:       time.Second,
+			MaxRetryAfter: 10 * time.Second,
+			Clock:         func() time.Time { return now },
+		}
+	})
+
+	newRequest := func(method string) *http.Request {
+		request, err := http.NewRequest(method, "https://example.com/v2/apps", nil)
+		Expect(err).ToNot(HaveOccurred())
+		return request
+	}
+
+	newResponse := func(statusCode int, retryAfter string) *http.Response {
+		response := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+		if retryAfter != "" {
+			response.Header.Set("Retry-After", retryAfter)
+		}
+		return response
+	}
+
+	Context("when the response is a 5xx", func() {
+		It("retries up to MaxRetries times, doubling the backoff", func() {
+			retry, wait := policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusBadGateway, ""), 1)
+			Expect(retry).To(BeTrue())
+			Expect(wait).To(Equal(time.Second))
+
+			retry, wait = policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusServiceUnavailable, ""), 2)
+			Expect(retry).To(BeTrue())
+			Expect(wait).To(Equal(2 * time.Second))
+
+			retry, _ = policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusServiceUnavailable, ""), 3)
+			Expect(retry).To(BeFalse())
+		})
+
+		It("does not retry non-idempotent requests", func() {
+			retry, _ := policy.ShouldRetry(newRequest("POST"), newResponse(http.StatusBadGateway, ""), 1)
+			Expect(retry).To(BeFalse())
+		})
+	})
+
+	Context("when the response is a 429", func() {
+		It("retries once after Retry-After", func() {
+			retry, wait := policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusTooManyRequests, "5"), 1)
+			Expect(retry).To(BeTrue())
+			Expect(wait).To(Equal(5 * time.Second))
+
+			retry, _ = policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusTooManyRequests, "5"), 2)
+			Expect(retry).To(BeFalse())
+		})
+
+		It("accepts Retry-After as an HTTP date", func() {
+			retryAfter := now.Add(3 * time.Second).Format(http.TimeFormat)
+			retry, wait := policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusTooManyRequests, retryAfter), 1)
+			Expect(retry).To(BeTrue())
+			Expect(wait).To(Equal(3 * time.Second))
+		})
+
+		It("does not retry when Retry-After is longer than MaxRetryAfter", func() {
+			retry, _ := policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusTooManyRequests, "120"), 1)
+			Expect(retry).To(BeFalse())
+		})
+	})
+
+	It("does not retry other responses or failed requests", func() {
+		retry, _ := policy.ShouldRetry(newRequest("GET"), newResponse(http.StatusNotFound, ""), 1)
+		Expect(retry).To(BeFalse())
+
+		retry, _ = policy.ShouldRetry(newRequest("GET"), nil, 1)
+		Expect(retry).To(BeFalse())
+	})
+})
@@ -37,5 +37,11 @@ func NewRoutingAPIGateway(config coreconfig.Reader, clock func() time.Time, ui t
 		logger:          logger,
 		PollingEnabled:  true,
 		DialTimeout:     dialTimeout(envDialTimeout),
+
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+		transports:          &transportCache{},
+		tokenRefreshes:      &tokenRefreshGroup{},
+		Metrics:             NoopMetrics{},
 	}
 }
@@ -3,15 +3,30 @@ package net
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
 )
 
 func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *tls.Config) {
+	return NewTLSConfigWithRootCAs(nil, trustedCerts, disableSSL)
+}
+
+// NewTLSConfigWithRootCAs returns a TLS config that verifies servers against
+// rootCAs. The trusted certificates are added to rootCAs; when rootCAs is nil
+// they replace the system pool.
+func NewTLSConfigWithRootCAs(rootCAs *x509.CertPool, trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *tls.Config) {
 	TLSConfig = &tls.Config{
 		MinVersion: tls.VersionTLS10,
+		RootCAs:    rootCAs,
 	}
 
 	if len(trustedCerts) > 0 {
-		certPool := x509.NewCertPool()
+		certPool := rootCAs
+		if certPool == nil {
+			certPool = x509.NewCertPool()
+		}
 		for _, tlsCert := range trustedCerts {
 			cert, _ := x509.ParseCertificate(tlsCert.Certificate[0])
 			certPool.AddCert(cert)
@@ -23,3 +38,26 @@ func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *t
 
 	return
 }
+
+// LoadCACertPool returns the system certificate pool with the PEM encoded
+// certificates in the file at path appended to it. An error is returned if
+// the file cannot be read or does not contain any valid certificate.
+func LoadCACertPool(path string) (*x509.CertPool, error) {
+	certPool, err := x509.SystemCertPool()
+	if err != nil || certPool == nil {
+		certPool = x509.NewCertPool()
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.New(T("Unable to read CA certificate file {{.Path}}: {{.Err}}",
+			map[string]interface{}{"Path": path, "Err": err.Error()}))
+	}
+
+	if !certPool.AppendCertsFromPEM(contents) {
+		return nil, errors.New(T("CA certificate file {{.Path}} does not contain any valid PEM encoded certificates",
+			map[string]interface{}{"Path": path}))
+	}
+
+	return certPool, nil
+}
@@ -0,0 +1,40 @@
+package net
+
+import "sync"
+
+// tokenRefreshGroup makes concurrent requests that fail with an invalid token
+// share a single refresh instead of each exchanging the refresh token.
+type tokenRefreshGroup struct {
+	mutex    sync.Mutex
+	inFlight *tokenRefreshCall
+}
+
+type tokenRefreshCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// refresh refreshes the token using auth, or waits for and returns the result
+// of a refresh that is already in progress.
+func (group *tokenRefreshGroup) refresh(auth tokenRefresher) (string, error) {
+	group.mutex.Lock()
+	if call := group.inFlight; call != nil {
+		group.mutex.Unlock()
+		<-call.done
+		return call.token, call.err
+	}
+
+	call := &tokenRefreshCall{done: make(chan struct{})}
+	group.inFlight = call
+	group.mutex.Unlock()
+
+	call.token, call.err = auth.RefreshAuthToken()
+
+	group.mutex.Lock()
+	group.inFlight = nil
+	group.mutex.Unlock()
+	close(call.done)
+
+	return call.token, call.err
+}
@@ -37,5 +37,11 @@ func NewUAAGateway(config coreconfig.Reader, ui terminal.UI, logger trace.Printe
 		logger:          logger,
 		PollingEnabled:  false,
 		DialTimeout:     dialTimeout(envDialTimeout),
+
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+		transports:          &transportCache{},
+		tokenRefreshes:      &tokenRefreshGroup{},
+		Metrics:             NoopMetrics{},
 	}
 }
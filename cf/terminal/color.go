@@ -22,6 +22,9 @@ var (
 	colorize               func(message string, textColor color.Attribute, bold int) string
 	TerminalSupportsColors = isTerminal()
 	UserAskedForColors     = ""
+	// NoColorFlag is set by the --no-color global flag and takes precedence
+	// over $CF_COLOR and the config.
+	NoColorFlag = false
 )
 
 func init() {
@@ -46,6 +49,10 @@ func InitColorSupport() {
 }
 
 func colorsEnabled() bool {
+	if NoColorFlag {
+		return false
+	}
+
 	if os.Getenv("CF_COLOR") == "true" {
 		return true
 	}
@@ -12,6 +12,7 @@ import (
 var _ = Describe("Terminal colors", func() {
 	BeforeEach(func() {
 		UserAskedForColors = ""
+		NoColorFlag = false
 	})
 
 	JustBeforeEach(func() {
@@ -86,6 +87,17 @@ var _ = Describe("Terminal colors", func() {
 					itDoesntColorize()
 				})
 			})
+
+			Context("When the --no-color flag is given, even if CF_COLOR is set to 'true'", func() {
+				BeforeEach(func() {
+					os.Setenv("CF_COLOR", "true")
+					TerminalSupportsColors = true
+					UserAskedForColors = "true"
+					NoColorFlag = true
+				})
+
+				itDoesntColorize()
+			})
 		})
 	})
 
@@ -99,6 +111,7 @@ var _ = Describe("Terminal colors", func() {
 
 	AfterEach(func() {
 		TerminalSupportsColors = originalTerminalSupportsColors
+		NoColorFlag = false
 		os.Setenv("CF_COLOR", "false")
 	})
 })
@@ -11,7 +11,11 @@ import (
 var Commands commandList
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	NoColor          bool   `long:"no-color" description:"Do not colorize output"`
+	Output           string `long:"output" choice:"json" description:"Output format, only 'json' is supported"`
+	Profile          string `long:"profile" description:"Use the named config profile instead of the default one"`
+	RequestTimeout   int    `long:"request-timeout" description:"Max wait time for a response to each request, in seconds"`
 
 	V2Push v2.V2PushCommand `command:"v2-push" description:"Push a new app or sync changes to an existing app"`
 
@@ -177,6 +181,7 @@ type commandList struct {
 	UnbindSecurityGroup                v2.UnbindSecurityGroupCommand                `command:"unbind-security-group" description:"Unbind a security group from a space"`
 	UnbindService                      v2.UnbindServiceCommand                      `command:"unbind-service" alias:"us" description:"Unbind a service instance from an app"`
 	UnbindStagingSecurityGroup         v2.UnbindStagingSecurityGroupCommand         `command:"unbind-staging-security-group" description:"Unbind a security group from the set of security groups for staging applications"`
+	UninstallAllPlugins                plugin.UninstallAllPluginsCommand            `command:"uninstall-all-plugins" description:"Uninstall all CLI plugins"`
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v2.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
 	UnsetEnv                           v2.UnsetEnvCommand                           `command:"unset-env" description:"Remove an env variable"`
@@ -25,6 +25,17 @@ type FakeInstallPluginActor struct {
 		result1 string
 		result2 error
 	}
+	DirectoryExistsStub        func(path string) bool
+	directoryExistsMutex       sync.RWMutex
+	directoryExistsArgsForCall []struct {
+		path string
+	}
+	directoryExistsReturns struct {
+		result1 bool
+	}
+	directoryExistsReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	DownloadExecutableBinaryFromURLStub        func(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
 	downloadExecutableBinaryFromURLMutex       sync.RWMutex
 	downloadExecutableBinaryFromURLArgsForCall []struct {
@@ -40,6 +51,22 @@ type FakeInstallPluginActor struct {
 		result1 string
 		result2 error
 	}
+	FetchPluginFromURLWithChecksumStub        func(url string, tempPluginDir string, expectedChecksum string, proxyReader plugin.ProxyReader) (string, error)
+	fetchPluginFromURLWithChecksumMutex       sync.RWMutex
+	fetchPluginFromURLWithChecksumArgsForCall []struct {
+		url              string
+		tempPluginDir    string
+		expectedChecksum string
+		proxyReader      plugin.ProxyReader
+	}
+	fetchPluginFromURLWithChecksumReturns struct {
+		result1 string
+		result2 error
+	}
+	fetchPluginFromURLWithChecksumReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	FileExistsStub        func(path string) bool
 	fileExistsMutex       sync.RWMutex
 	fileExistsArgsForCall []struct {
@@ -78,6 +105,21 @@ type FakeInstallPluginActor struct {
 	getPlatformStringReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetPluginBinaryFromDirectoryStub        func(directory string, runtimeGOOS string, runtimeGOARCH string) (string, error)
+	getPluginBinaryFromDirectoryMutex       sync.RWMutex
+	getPluginBinaryFromDirectoryArgsForCall []struct {
+		directory     string
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}
+	getPluginBinaryFromDirectoryReturns struct {
+		result1 string
+		result2 error
+	}
+	getPluginBinaryFromDirectoryReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetPluginInfoFromRepositoriesForPlatformStub        func(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
 	getPluginInfoFromRepositoriesForPlatformMutex       sync.RWMutex
 	getPluginInfoFromRepositoriesForPlatformArgsForCall []struct {
@@ -108,6 +150,24 @@ type FakeInstallPluginActor struct {
 		result1 configv3.PluginRepository
 		result2 error
 	}
+	GetPluginVersionInfoFromRepositoriesForPlatformStub        func(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	getPluginVersionInfoFromRepositoriesForPlatformMutex       sync.RWMutex
+	getPluginVersionInfoFromRepositoriesForPlatformArgsForCall []struct {
+		pluginName  string
+		version     string
+		pluginRepos []configv3.PluginRepository
+		platform    string
+	}
+	getPluginVersionInfoFromRepositoriesForPlatformReturns struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}
+	getPluginVersionInfoFromRepositoriesForPlatformReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}
 	InstallPluginFromPathStub        func(path string, plugin configv3.Plugin) error
 	installPluginFromPathMutex       sync.RWMutex
 	installPluginFromPathArgsForCall []struct {
@@ -155,6 +215,18 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	VerifyPluginChecksumStub        func(path string, expectedChecksum string) error
+	verifyPluginChecksumMutex       sync.RWMutex
+	verifyPluginChecksumArgsForCall []struct {
+		path             string
+		expectedChecksum string
+	}
+	verifyPluginChecksumReturns struct {
+		result1 error
+	}
+	verifyPluginChecksumReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -211,6 +283,54 @@ func (fake *FakeInstallPluginActor) CreateExecutableCopyReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeInstallPluginActor) DirectoryExists(path string) bool {
+	fake.directoryExistsMutex.Lock()
+	ret, specificReturn := fake.directoryExistsReturnsOnCall[len(fake.directoryExistsArgsForCall)]
+	fake.directoryExistsArgsForCall = append(fake.directoryExistsArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("DirectoryExists", []interface{}{path})
+	fake.directoryExistsMutex.Unlock()
+	if fake.DirectoryExistsStub != nil {
+		return fake.DirectoryExistsStub(path)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.directoryExistsReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) DirectoryExistsCallCount() int {
+	fake.directoryExistsMutex.RLock()
+	defer fake.directoryExistsMutex.RUnlock()
+	return len(fake.directoryExistsArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) DirectoryExistsArgsForCall(i int) string {
+	fake.directoryExistsMutex.RLock()
+	defer fake.directoryExistsMutex.RUnlock()
+	return fake.directoryExistsArgsForCall[i].path
+}
+
+func (fake *FakeInstallPluginActor) DirectoryExistsReturns(result1 bool) {
+	fake.DirectoryExistsStub = nil
+	fake.directoryExistsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) DirectoryExistsReturnsOnCall(i int, result1 bool) {
+	fake.DirectoryExistsStub = nil
+	if fake.directoryExistsReturnsOnCall == nil {
+		fake.directoryExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.directoryExistsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error) {
 	fake.downloadExecutableBinaryFromURLMutex.Lock()
 	ret, specificReturn := fake.downloadExecutableBinaryFromURLReturnsOnCall[len(fake.downloadExecutableBinaryFromURLArgsForCall)]
@@ -264,6 +384,60 @@ func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURLReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeInstallPluginActor) FetchPluginFromURLWithChecksum(url string, tempPluginDir string, expectedChecksum string, proxyReader plugin.ProxyReader) (string, error) {
+	fake.fetchPluginFromURLWithChecksumMutex.Lock()
+	ret, specificReturn := fake.fetchPluginFromURLWithChecksumReturnsOnCall[len(fake.fetchPluginFromURLWithChecksumArgsForCall)]
+	fake.fetchPluginFromURLWithChecksumArgsForCall = append(fake.fetchPluginFromURLWithChecksumArgsForCall, struct {
+		url              string
+		tempPluginDir    string
+		expectedChecksum string
+		proxyReader      plugin.ProxyReader
+	}{url, tempPluginDir, expectedChecksum, proxyReader})
+	fake.recordInvocation("FetchPluginFromURLWithChecksum", []interface{}{url, tempPluginDir, expectedChecksum, proxyReader})
+	fake.fetchPluginFromURLWithChecksumMutex.Unlock()
+	if fake.FetchPluginFromURLWithChecksumStub != nil {
+		return fake.FetchPluginFromURLWithChecksumStub(url, tempPluginDir, expectedChecksum, proxyReader)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.fetchPluginFromURLWithChecksumReturns.result1, fake.fetchPluginFromURLWithChecksumReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) FetchPluginFromURLWithChecksumCallCount() int {
+	fake.fetchPluginFromURLWithChecksumMutex.RLock()
+	defer fake.fetchPluginFromURLWithChecksumMutex.RUnlock()
+	return len(fake.fetchPluginFromURLWithChecksumArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) FetchPluginFromURLWithChecksumArgsForCall(i int) (string, string, string, plugin.ProxyReader) {
+	fake.fetchPluginFromURLWithChecksumMutex.RLock()
+	defer fake.fetchPluginFromURLWithChecksumMutex.RUnlock()
+	return fake.fetchPluginFromURLWithChecksumArgsForCall[i].url, fake.fetchPluginFromURLWithChecksumArgsForCall[i].tempPluginDir, fake.fetchPluginFromURLWithChecksumArgsForCall[i].expectedChecksum, fake.fetchPluginFromURLWithChecksumArgsForCall[i].proxyReader
+}
+
+func (fake *FakeInstallPluginActor) FetchPluginFromURLWithChecksumReturns(result1 string, result2 error) {
+	fake.FetchPluginFromURLWithChecksumStub = nil
+	fake.fetchPluginFromURLWithChecksumReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) FetchPluginFromURLWithChecksumReturnsOnCall(i int, result1 string, result2 error) {
+	fake.FetchPluginFromURLWithChecksumStub = nil
+	if fake.fetchPluginFromURLWithChecksumReturnsOnCall == nil {
+		fake.fetchPluginFromURLWithChecksumReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.fetchPluginFromURLWithChecksumReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) FileExists(path string) bool {
 	fake.fileExistsMutex.Lock()
 	ret, specificReturn := fake.fileExistsReturnsOnCall[len(fake.fileExistsArgsForCall)]
@@ -414,6 +588,59 @@ func (fake *FakeInstallPluginActor) GetPlatformStringReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) GetPluginBinaryFromDirectory(directory string, runtimeGOOS string, runtimeGOARCH string) (string, error) {
+	fake.getPluginBinaryFromDirectoryMutex.Lock()
+	ret, specificReturn := fake.getPluginBinaryFromDirectoryReturnsOnCall[len(fake.getPluginBinaryFromDirectoryArgsForCall)]
+	fake.getPluginBinaryFromDirectoryArgsForCall = append(fake.getPluginBinaryFromDirectoryArgsForCall, struct {
+		directory     string
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}{directory, runtimeGOOS, runtimeGOARCH})
+	fake.recordInvocation("GetPluginBinaryFromDirectory", []interface{}{directory, runtimeGOOS, runtimeGOARCH})
+	fake.getPluginBinaryFromDirectoryMutex.Unlock()
+	if fake.GetPluginBinaryFromDirectoryStub != nil {
+		return fake.GetPluginBinaryFromDirectoryStub(directory, runtimeGOOS, runtimeGOARCH)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPluginBinaryFromDirectoryReturns.result1, fake.getPluginBinaryFromDirectoryReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) GetPluginBinaryFromDirectoryCallCount() int {
+	fake.getPluginBinaryFromDirectoryMutex.RLock()
+	defer fake.getPluginBinaryFromDirectoryMutex.RUnlock()
+	return len(fake.getPluginBinaryFromDirectoryArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetPluginBinaryFromDirectoryArgsForCall(i int) (string, string, string) {
+	fake.getPluginBinaryFromDirectoryMutex.RLock()
+	defer fake.getPluginBinaryFromDirectoryMutex.RUnlock()
+	return fake.getPluginBinaryFromDirectoryArgsForCall[i].directory, fake.getPluginBinaryFromDirectoryArgsForCall[i].runtimeGOOS, fake.getPluginBinaryFromDirectoryArgsForCall[i].runtimeGOARCH
+}
+
+func (fake *FakeInstallPluginActor) GetPluginBinaryFromDirectoryReturns(result1 string, result2 error) {
+	fake.GetPluginBinaryFromDirectoryStub = nil
+	fake.getPluginBinaryFromDirectoryReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) GetPluginBinaryFromDirectoryReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetPluginBinaryFromDirectoryStub = nil
+	if fake.getPluginBinaryFromDirectoryReturnsOnCall == nil {
+		fake.getPluginBinaryFromDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getPluginBinaryFromDirectoryReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error) {
 	var pluginReposCopy []configv3.PluginRepository
 	if pluginRepos != nil {
@@ -526,6 +753,68 @@ func (fake *FakeInstallPluginActor) GetPluginRepositoryReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeInstallPluginActor) GetPluginVersionInfoFromRepositoriesForPlatform(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error) {
+	var pluginReposCopy []configv3.PluginRepository
+	if pluginRepos != nil {
+		pluginReposCopy = make([]configv3.PluginRepository, len(pluginRepos))
+		copy(pluginReposCopy, pluginRepos)
+	}
+	fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.Lock()
+	ret, specificReturn := fake.getPluginVersionInfoFromRepositoriesForPlatformReturnsOnCall[len(fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall)]
+	fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall = append(fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall, struct {
+		pluginName  string
+		version     string
+		pluginRepos []configv3.PluginRepository
+		platform    string
+	}{pluginName, version, pluginReposCopy, platform})
+	fake.recordInvocation("GetPluginVersionInfoFromRepositoriesForPlatform", []interface{}{pluginName, version, pluginReposCopy, platform})
+	fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.Unlock()
+	if fake.GetPluginVersionInfoFromRepositoriesForPlatformStub != nil {
+		return fake.GetPluginVersionInfoFromRepositoriesForPlatformStub(pluginName, version, pluginRepos, platform)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPluginVersionInfoFromRepositoriesForPlatformReturns.result1, fake.getPluginVersionInfoFromRepositoriesForPlatformReturns.result2, fake.getPluginVersionInfoFromRepositoriesForPlatformReturns.result3
+}
+
+func (fake *FakeInstallPluginActor) GetPluginVersionInfoFromRepositoriesForPlatformCallCount() int {
+	fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.RLock()
+	defer fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.RUnlock()
+	return len(fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetPluginVersionInfoFromRepositoriesForPlatformArgsForCall(i int) (string, string, []configv3.PluginRepository, string) {
+	fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.RLock()
+	defer fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.RUnlock()
+	return fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall[i].pluginName, fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall[i].version, fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall[i].pluginRepos, fake.getPluginVersionInfoFromRepositoriesForPlatformArgsForCall[i].platform
+}
+
+func (fake *FakeInstallPluginActor) GetPluginVersionInfoFromRepositoriesForPlatformReturns(result1 pluginaction.PluginInfo, result2 []string, result3 error) {
+	fake.GetPluginVersionInfoFromRepositoriesForPlatformStub = nil
+	fake.getPluginVersionInfoFromRepositoriesForPlatformReturns = struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInstallPluginActor) GetPluginVersionInfoFromRepositoriesForPlatformReturnsOnCall(i int, result1 pluginaction.PluginInfo, result2 []string, result3 error) {
+	fake.GetPluginVersionInfoFromRepositoriesForPlatformStub = nil
+	if fake.getPluginVersionInfoFromRepositoriesForPlatformReturnsOnCall == nil {
+		fake.getPluginVersionInfoFromRepositoriesForPlatformReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginInfo
+			result2 []string
+			result3 error
+		})
+	}
+	fake.getPluginVersionInfoFromRepositoriesForPlatformReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInstallPluginActor) InstallPluginFromPath(path string, plugin configv3.Plugin) error {
 	fake.installPluginFromPathMutex.Lock()
 	ret, specificReturn := fake.installPluginFromPathReturnsOnCall[len(fake.installPluginFromPathArgsForCall)]
@@ -721,23 +1010,80 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) VerifyPluginChecksum(path string, expectedChecksum string) error {
+	fake.verifyPluginChecksumMutex.Lock()
+	ret, specificReturn := fake.verifyPluginChecksumReturnsOnCall[len(fake.verifyPluginChecksumArgsForCall)]
+	fake.verifyPluginChecksumArgsForCall = append(fake.verifyPluginChecksumArgsForCall, struct {
+		path             string
+		expectedChecksum string
+	}{path, expectedChecksum})
+	fake.recordInvocation("VerifyPluginChecksum", []interface{}{path, expectedChecksum})
+	fake.verifyPluginChecksumMutex.Unlock()
+	if fake.VerifyPluginChecksumStub != nil {
+		return fake.VerifyPluginChecksumStub(path, expectedChecksum)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.verifyPluginChecksumReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginChecksumCallCount() int {
+	fake.verifyPluginChecksumMutex.RLock()
+	defer fake.verifyPluginChecksumMutex.RUnlock()
+	return len(fake.verifyPluginChecksumArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginChecksumArgsForCall(i int) (string, string) {
+	fake.verifyPluginChecksumMutex.RLock()
+	defer fake.verifyPluginChecksumMutex.RUnlock()
+	return fake.verifyPluginChecksumArgsForCall[i].path, fake.verifyPluginChecksumArgsForCall[i].expectedChecksum
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginChecksumReturns(result1 error) {
+	fake.VerifyPluginChecksumStub = nil
+	fake.verifyPluginChecksumReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginChecksumReturnsOnCall(i int, result1 error) {
+	fake.VerifyPluginChecksumStub = nil
+	if fake.verifyPluginChecksumReturnsOnCall == nil {
+		fake.verifyPluginChecksumReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyPluginChecksumReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createExecutableCopyMutex.RLock()
 	defer fake.createExecutableCopyMutex.RUnlock()
+	fake.directoryExistsMutex.RLock()
+	defer fake.directoryExistsMutex.RUnlock()
 	fake.downloadExecutableBinaryFromURLMutex.RLock()
 	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	fake.fetchPluginFromURLWithChecksumMutex.RLock()
+	defer fake.fetchPluginFromURLWithChecksumMutex.RUnlock()
 	fake.fileExistsMutex.RLock()
 	defer fake.fileExistsMutex.RUnlock()
 	fake.getAndValidatePluginMutex.RLock()
 	defer fake.getAndValidatePluginMutex.RUnlock()
 	fake.getPlatformStringMutex.RLock()
 	defer fake.getPlatformStringMutex.RUnlock()
+	fake.getPluginBinaryFromDirectoryMutex.RLock()
+	defer fake.getPluginBinaryFromDirectoryMutex.RUnlock()
 	fake.getPluginInfoFromRepositoriesForPlatformMutex.RLock()
 	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.RUnlock()
 	fake.getPluginRepositoryMutex.RLock()
 	defer fake.getPluginRepositoryMutex.RUnlock()
+	fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.RLock()
+	defer fake.getPluginVersionInfoFromRepositoriesForPlatformMutex.RUnlock()
 	fake.installPluginFromPathMutex.RLock()
 	defer fake.installPluginFromPathMutex.RUnlock()
 	fake.isPluginInstalledMutex.RLock()
@@ -746,6 +1092,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.verifyPluginChecksumMutex.RLock()
+	defer fake.verifyPluginChecksumMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -310,6 +310,10 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--no-color", cmd.UI.TranslateText("Do not colorize output")},
+		{"--output", cmd.UI.TranslateText("Output format, only 'json' is supported")},
+		{"--profile", cmd.UI.TranslateText("Use the named config profile instead of the default one")},
+		{"--request-timeout", cmd.UI.TranslateText("Max wait time for a response to each request, in seconds")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
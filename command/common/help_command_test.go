@@ -411,8 +411,12 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("  install-plugin    list-plugin-repos"))
 
 			Expect(testUI.Out).To(Say("Global options:"))
-			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
-			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
+			Expect(testUI.Out).To(Say("  --help, -h                                Show help"))
+			Expect(testUI.Out).To(Say("  --no-color                                Do not colorize output"))
+			Expect(testUI.Out).To(Say("  --output                                  Output format, only 'json' is supported"))
+			Expect(testUI.Out).To(Say("  --profile                                 Use the named config profile instead of the default one"))
+			Expect(testUI.Out).To(Say("  --request-timeout                         Max wait time for a response to each request, in seconds"))
+			Expect(testUI.Out).To(Say("  -v                                        Print API request diagnostics to stdout"))
 
 			Expect(testUI.Out).To(Say("These are commonly used commands. Use 'cf help -a' to see all, with descriptions."))
 			Expect(testUI.Out).To(Say("See 'cf help <command>' to read about a specific command."))
@@ -600,8 +604,12 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
 
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
-				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
-				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --help, -h                                Show help"))
+				Expect(testUI.Out).To(Say("   --no-color                                Do not colorize output"))
+				Expect(testUI.Out).To(Say("   --output                                  Output format, only 'json' is supported"))
+				Expect(testUI.Out).To(Say("   --profile                                 Use the named config profile instead of the default one"))
+				Expect(testUI.Out).To(Say("   --request-timeout                         Max wait time for a response to each request, in seconds"))
+				Expect(testUI.Out).To(Say("   -v                                        Print API request diagnostics to stdout"))
 			})
 
 			Context("when there are multiple installed plugins", func() {
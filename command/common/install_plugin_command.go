@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/hex"
 	"io/ioutil"
 	"os"
 	"runtime"
@@ -21,16 +22,21 @@ import (
 
 type InstallPluginActor interface {
 	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DirectoryExists(path string) bool
 	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	FetchPluginFromURLWithChecksum(url string, tempPluginDir string, expectedChecksum string, proxyReader plugin.ProxyReader) (string, error)
 	FileExists(path string) bool
 	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
 	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginBinaryFromDirectory(directory string, runtimeGOOS string, runtimeGOARCH string) (string, error)
 	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
 	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	GetPluginVersionInfoFromRepositoriesForPlatform(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	IsPluginInstalled(pluginName string) bool
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginChecksum(path string, expectedChecksum string) error
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -55,7 +61,9 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	Checksum             string                 `long:"checksum" description:"SHA-1 or SHA-256 checksum the plugin binary must match"`
+	Offline              bool                   `long:"offline" description:"Search only the cached plugin repository catalogs, without contacting the repositories"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME[@VERSION] [-r REPO_NAME] [--offline] [--checksum CHECKSUM] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN [--checksum CHECKSUM] [-f]\n   CF_NAME install-plugin URL [--checksum CHECKSUM] [-f]\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo\n   CF_NAME install-plugin -r My-Repo plugin-echo@1.2.0"`
 	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
@@ -66,7 +74,9 @@ type InstallPluginCommand struct {
 func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	actor := pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	actor.UseRepositoryCache(cmd.Offline)
+	cmd.Actor = actor
 
 	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
 
@@ -74,6 +84,13 @@ func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd InstallPluginCommand) Execute([]string) error {
+	if cmd.Checksum != "" && !isHexChecksum(cmd.Checksum) {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--checksum",
+			ExpectedType: "a 40 or 64 character hexadecimal checksum",
+		}
+	}
+
 	err := os.MkdirAll(cmd.Config.PluginHome(), 0700)
 	if err != nil {
 		return shared.HandleError(err)
@@ -91,6 +108,14 @@ func (cmd InstallPluginCommand) Execute([]string) error {
 		return shared.HandleError(err)
 	}
 
+	// plugins downloaded from a URL are verified while they are fetched
+	if cmd.Checksum != "" && pluginSource != PluginFromURL {
+		err = cmd.Actor.VerifyPluginChecksum(tempPluginPath, cmd.Checksum)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	// copy twice when downloading from a URL to keep Windows specific code
 	// isolated to CreateExecutableCopy
 	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPluginPath, tempPluginDir)
@@ -108,6 +133,9 @@ func (cmd InstallPluginCommand) Execute([]string) error {
 		return shared.HandleError(err)
 	}
 
+	cmd.UI.DisplayStructuredOutput("plugin", plugin.Name)
+	cmd.UI.DisplayStructuredOutput("version", plugin.Version.String())
+
 	if cmd.Actor.IsPluginInstalled(plugin.Name) {
 		if !cmd.Force && pluginSource != PluginFromRepository {
 			return translatableerror.PluginAlreadyInstalledError{
@@ -136,6 +164,7 @@ func (cmd InstallPluginCommand) installPlugin(plugin configv3.Plugin, pluginPath
 		return installErr
 	}
 
+	cmd.UI.DisplayStructuredOutput("status", "installed")
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayText("Plugin {{.Name}} {{.Version}} successfully installed.", map[string]interface{}{
 		"Name":    plugin.Name,
@@ -172,17 +201,26 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 		if err != nil {
 			return "", 0, err
 		}
-		path, pluginSource, err := cmd.getPluginFromRepositories(pluginNameOrLocation, []configv3.PluginRepository{pluginRepository}, tempPluginDir)
+		pluginName, pluginVersion := parsePluginNameAndVersion(pluginNameOrLocation)
+		path, pluginSource, err := cmd.getPluginFromRepositories(pluginName, pluginVersion, []configv3.PluginRepository{pluginRepository}, tempPluginDir)
 
 		if err != nil {
 			switch pluginErr := err.(type) {
 			case pluginaction.PluginNotFoundInAnyRepositoryError:
 				return "", 0, translatableerror.PluginNotFoundInRepositoryError{
 					BinaryName:     cmd.Config.BinaryName(),
-					PluginName:     pluginNameOrLocation,
+					PluginName:     pluginName,
 					RepositoryName: cmd.RegisteredRepository,
 				}
 
+			case pluginaction.PluginVersionNotFoundInAnyRepositoryError:
+				return "", 0, translatableerror.PluginVersionNotFoundInRepositoryError{
+					PluginName:        pluginName,
+					Version:           pluginVersion,
+					RepositoryName:    cmd.RegisteredRepository,
+					AvailableVersions: pluginErr.AvailableVersions,
+				}
+
 			case pluginaction.FetchingPluginInfoFromRepositoryError:
 				// The error wrapped inside pluginErr is handled differently in the case of
 				// a specified repo from that of searching through all repos.  pluginErr.Err
@@ -195,6 +233,12 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 		}
 		return path, pluginSource, nil
 
+	case util.IsFileScheme(pluginNameOrLocation):
+		return cmd.getPluginFromFileURL(pluginNameOrLocation)
+
+	case cmd.Actor.DirectoryExists(pluginNameOrLocation):
+		return cmd.getPluginFromLocalDirectory(pluginNameOrLocation)
+
 	case cmd.Actor.FileExists(pluginNameOrLocation):
 		return cmd.getPluginFromLocalFile(pluginNameOrLocation)
 
@@ -210,12 +254,20 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 			return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 		}
 
-		path, pluginSource, err := cmd.getPluginFromRepositories(pluginNameOrLocation, repos, tempPluginDir)
+		pluginName, pluginVersion := parsePluginNameAndVersion(pluginNameOrLocation)
+		path, pluginSource, err := cmd.getPluginFromRepositories(pluginName, pluginVersion, repos, tempPluginDir)
 		if err != nil {
 			switch pluginErr := err.(type) {
 			case pluginaction.PluginNotFoundInAnyRepositoryError:
 				return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 
+			case pluginaction.PluginVersionNotFoundInAnyRepositoryError:
+				return "", 0, translatableerror.PluginVersionNotFoundInAnyRepositoryError{
+					PluginName:        pluginName,
+					Version:           pluginVersion,
+					AvailableVersions: pluginErr.AvailableVersions,
+				}
+
 			case pluginaction.FetchingPluginInfoFromRepositoryError:
 				return "", 0, cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
 
@@ -254,6 +306,24 @@ func (InstallPluginCommand) handleFetchingPluginInfoFromRepositoriesError(fetchE
 	}
 }
 
+// getPluginFromFileURL installs the local file or directory a file:// URL
+// refers to.
+func (cmd InstallPluginCommand) getPluginFromFileURL(fileURL string) (string, PluginSource, error) {
+	pluginLocation, err := util.FileURLToPath(fileURL)
+	if err != nil {
+		return "", 0, translatableerror.UnsupportedURLSchemeError{UnsupportedURL: fileURL}
+	}
+
+	switch {
+	case cmd.Actor.DirectoryExists(pluginLocation):
+		return cmd.getPluginFromLocalDirectory(pluginLocation)
+	case cmd.Actor.FileExists(pluginLocation):
+		return cmd.getPluginFromLocalFile(pluginLocation)
+	default:
+		return "", 0, translatableerror.FileNotFoundError{Path: pluginLocation}
+	}
+}
+
 func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (string, PluginSource, error) {
 	err := cmd.installPluginPrompt(installConfirmationPrompt, map[string]interface{}{
 		"Path": pluginLocation,
@@ -265,6 +335,15 @@ func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (s
 	return pluginLocation, PluginFromLocalFile, err
 }
 
+func (cmd InstallPluginCommand) getPluginFromLocalDirectory(pluginDirectory string) (string, PluginSource, error) {
+	pluginLocation, err := cmd.Actor.GetPluginBinaryFromDirectory(pluginDirectory, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return cmd.getPluginFromLocalFile(pluginLocation)
+}
+
 func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPluginDir string) (string, PluginSource, error) {
 	var err error
 
@@ -277,7 +356,12 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 
 	cmd.UI.DisplayText("Starting download of plugin binary from URL...")
 
-	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginLocation, tempPluginDir, cmd.ProgressBar)
+	var tempPath string
+	if cmd.Checksum != "" {
+		tempPath, err = cmd.Actor.FetchPluginFromURLWithChecksum(pluginLocation, tempPluginDir, cmd.Checksum, cmd.ProgressBar)
+	} else {
+		tempPath, err = cmd.Actor.DownloadExecutableBinaryFromURL(pluginLocation, tempPluginDir, cmd.ProgressBar)
+	}
 	if err != nil {
 		return "", 0, err
 	}
@@ -285,7 +369,30 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 	return tempPath, PluginFromURL, err
 }
 
-func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, repos []configv3.PluginRepository, tempPluginDir string) (string, PluginSource, error) {
+// isHexChecksum returns whether checksum is a hexadecimal SHA-1 or SHA-256
+// checksum.
+func isHexChecksum(checksum string) bool {
+	if len(checksum) != 40 && len(checksum) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(checksum)
+	return err == nil
+}
+
+// parsePluginNameAndVersion splits a PLUGIN_NAME@VERSION argument into the
+// plugin name and the requested version. The version is empty when no
+// version was requested.
+func parsePluginNameAndVersion(arg string) (string, string) {
+	index := strings.LastIndex(arg, "@")
+	if index <= 0 || index == len(arg)-1 {
+		return arg, ""
+	}
+	return arg[:index], arg[index+1:]
+}
+
+// getPluginFromRepositories downloads the plugin from repos. When
+// pluginVersion is empty the newest version is downloaded.
+func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, pluginVersion string, repos []configv3.PluginRepository, tempPluginDir string) (string, PluginSource, error) {
 	var repoNames []string
 	for _, repo := range repos {
 		repoNames = append(repoNames, repo.Name)
@@ -297,7 +404,16 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 	})
 
 	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
-	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(pluginName, repos, currentPlatform)
+	var (
+		pluginInfo pluginaction.PluginInfo
+		repoList   []string
+		err        error
+	)
+	if pluginVersion == "" {
+		pluginInfo, repoList, err = cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(pluginName, repos, currentPlatform)
+	} else {
+		pluginInfo, repoList, err = cmd.Actor.GetPluginVersionInfoFromRepositoriesForPlatform(pluginName, pluginVersion, repos, currentPlatform)
+	}
 
 	if err != nil {
 		return "", 0, err
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"runtime"
 	"strconv"
 
 	"code.cloudfoundry.org/cli/actor/pluginaction"
@@ -62,6 +63,40 @@ var _ = Describe("install-plugin command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Describe("when the --checksum value is invalid", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = "some-path"
+		})
+
+		Context("when it is not 40 or 64 characters long", func() {
+			BeforeEach(func() {
+				cmd.Checksum = "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9aa"
+			})
+
+			It("returns a ParseArgumentError without installing", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "--checksum",
+					ExpectedType: "a 40 or 64 character hexadecimal checksum",
+				}))
+				Expect(fakeActor.FileExistsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when it is not hexadecimal", func() {
+			BeforeEach(func() {
+				cmd.Checksum = "zc13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"
+			})
+
+			It("returns a ParseArgumentError without installing", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "--checksum",
+					ExpectedType: "a 40 or 64 character hexadecimal checksum",
+				}))
+				Expect(fakeActor.FileExistsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("installing from a local file", func() {
 		BeforeEach(func() {
 			cmd.OptionalArgs.PluginNameOrLocation = "some-path"
@@ -246,6 +281,40 @@ var _ = Describe("install-plugin command", func() {
 						Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
 					})
 
+					Context("when the --checksum flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Checksum = "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"
+						})
+
+						It("verifies the checksum of the local file", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.VerifyPluginChecksumCallCount()).To(Equal(1))
+							path, checksum := fakeActor.VerifyPluginChecksumArgsForCall(0)
+							Expect(path).To(Equal("some-path"))
+							Expect(checksum).To(Equal("2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"))
+						})
+
+						Context("when the checksum does not match", func() {
+							BeforeEach(func() {
+								fakeActor.VerifyPluginChecksumReturns(pluginaction.PluginChecksumMismatchError{
+									ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+									ActualChecksum:   "some-other-checksum",
+								})
+							})
+
+							It("returns a PluginChecksumMismatchError and does not install the plugin", func() {
+								Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+									ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+									ActualChecksum:   "some-other-checksum",
+								}))
+
+								Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+								Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when there is an error making an executable copy of the plugin binary", func() {
 						BeforeEach(func() {
 							expectedErr = errors.New("create executable copy error")
@@ -269,6 +338,36 @@ var _ = Describe("install-plugin command", func() {
 							Expect(testUI.Out).ToNot(Say("Plugin some-plugin 1\\.2\\.3 successfully installed\\."))
 						})
 					})
+
+					Context("when JSON output is enabled", func() {
+						var out *Buffer
+
+						BeforeEach(func() {
+							out = testUI.Out.(*Buffer)
+							testUI.EnableJSONOutput()
+						})
+
+						It("outputs only the installed plugin as JSON", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.FlushStructuredOutput()).To(Succeed())
+
+							Expect(out.Contents()).To(MatchJSON(`{"plugin":"some-plugin","version":"1.2.3","status":"installed"}`))
+						})
+
+						Context("when an error is encountered installing the plugin", func() {
+							BeforeEach(func() {
+								expectedErr = errors.New("install plugin error")
+								fakeActor.InstallPluginFromPathReturns(expectedErr)
+							})
+
+							It("outputs valid JSON without an installed status", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(testUI.FlushStructuredOutput()).To(Succeed())
+
+								Expect(out.Contents()).To(MatchJSON(`{"plugin":"some-plugin","version":"1.2.3"}`))
+							})
+						})
+					})
 				})
 			})
 
@@ -389,6 +488,118 @@ var _ = Describe("install-plugin command", func() {
 		})
 	})
 
+	Describe("installing from a local directory", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = "some-directory"
+			cmd.Force = true
+			fakeActor.DirectoryExistsReturns(true)
+		})
+
+		Context("when the directory contains a binary for the current platform", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginBinaryFromDirectoryReturns("some-directory/plugin_linux_amd64", nil)
+				fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+				fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+			})
+
+			It("installs the binary for the current platform", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.DirectoryExistsCallCount()).To(Equal(1))
+				Expect(fakeActor.DirectoryExistsArgsForCall(0)).To(Equal("some-directory"))
+				Expect(fakeActor.FileExistsCallCount()).To(Equal(0))
+
+				Expect(fakeActor.GetPluginBinaryFromDirectoryCallCount()).To(Equal(1))
+				directory, goos, goarch := fakeActor.GetPluginBinaryFromDirectoryArgsForCall(0)
+				Expect(directory).To(Equal("some-directory"))
+				Expect(goos).To(Equal(runtime.GOOS))
+				Expect(goarch).To(Equal(runtime.GOARCH))
+
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(1))
+				pathArg, _ := fakeActor.CreateExecutableCopyArgsForCall(0)
+				Expect(pathArg).To(Equal("some-directory/plugin_linux_amd64"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the directory does not contain a binary for the current platform", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginBinaryFromDirectoryReturns("", pluginaction.NoCompatibleBinaryInDirectoryError{
+					Path:     "some-directory",
+					Platform: "linux_amd64",
+					Binaries: []string{"plugin_darwin_amd64"},
+				})
+			})
+
+			It("returns a NoCompatibleBinaryInDirectoryError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoCompatibleBinaryInDirectoryError{
+					Path:     "some-directory",
+					Platform: "linux_amd64",
+					Binaries: []string{"plugin_darwin_amd64"},
+				}))
+
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("installing from a file URL", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = "file:///some/path"
+			cmd.Force = true
+		})
+
+		Context("when the file exists", func() {
+			BeforeEach(func() {
+				fakeActor.FileExistsReturns(true)
+				fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+				fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+			})
+
+			It("installs the plugin from the local path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.DirectoryExistsCallCount()).To(Equal(1))
+				Expect(fakeActor.DirectoryExistsArgsForCall(0)).To(Equal("/some/path"))
+				Expect(fakeActor.FileExistsCallCount()).To(Equal(1))
+				Expect(fakeActor.FileExistsArgsForCall(0)).To(Equal("/some/path"))
+
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(1))
+				pathArg, _ := fakeActor.CreateExecutableCopyArgsForCall(0)
+				Expect(pathArg).To(Equal("/some/path"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.FileExistsReturns(false)
+			})
+
+			It("returns a FileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{Path: "/some/path"}))
+
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the URL refers to a remote host", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.PluginNameOrLocation = "file://some-host/some/path"
+			})
+
+			It("returns an error indicating an unsupported URL scheme", func() {
+				Expect(executeErr).To(MatchError(translatableerror.UnsupportedURLSchemeError{
+					UnsupportedURL: "file://some-host/some/path",
+				}))
+
+				Expect(fakeActor.FileExistsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("installing from an unsupported URL scheme", func() {
 		BeforeEach(func() {
 			cmd.OptionalArgs.PluginNameOrLocation = "ftp://some-url"
@@ -433,6 +644,45 @@ var _ = Describe("install-plugin command", func() {
 				Expect(tempPluginDir).To(ContainSubstring("some-pluginhome"))
 				Expect(tempPluginDir).To(ContainSubstring("temp"))
 				Expect(proxyReader).To(Equal(fakeProgressBar))
+
+				Expect(fakeActor.FetchPluginFromURLWithChecksumCallCount()).To(Equal(0))
+			})
+
+			Context("when the --checksum flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Checksum = "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"
+				})
+
+				It("downloads the plugin and verifies the checksum", func() {
+					Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+
+					Expect(fakeActor.FetchPluginFromURLWithChecksumCallCount()).To(Equal(1))
+					url, tempPluginDir, checksum, proxyReader := fakeActor.FetchPluginFromURLWithChecksumArgsForCall(0)
+					Expect(url).To(Equal("http://some-url"))
+					Expect(tempPluginDir).To(ContainSubstring("some-pluginhome"))
+					Expect(tempPluginDir).To(ContainSubstring("temp"))
+					Expect(checksum).To(Equal("2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"))
+					Expect(proxyReader).To(Equal(fakeProgressBar))
+				})
+
+				Context("when the checksum does not match", func() {
+					BeforeEach(func() {
+						fakeActor.FetchPluginFromURLWithChecksumReturns("", pluginaction.PluginChecksumMismatchError{
+							ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+							ActualChecksum:   "some-other-checksum",
+						})
+					})
+
+					It("returns a PluginChecksumMismatchError and does not install the plugin", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+							ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+							ActualChecksum:   "some-other-checksum",
+						}))
+
+						Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+						Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+					})
+				})
 			})
 
 			Context("When getting the binary fails", func() {
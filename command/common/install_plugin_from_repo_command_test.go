@@ -186,6 +186,59 @@ var _ = Describe("install-plugin command", func() {
 				})
 			})
 
+			Context("when a version is requested", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.PluginNameOrLocation = flag.Path(pluginName + "@1.2.0")
+				})
+
+				Context("when the version is found", func() {
+					BeforeEach(func() {
+						cmd.Force = true
+						fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: "1.2.0", URL: pluginURL, Checksum: "some-checksum"}, []string{repoName}, nil)
+						fakeActor.DownloadExecutableBinaryFromURLReturns("some-path", nil)
+						fakeActor.ValidateFileChecksumReturns(false)
+					})
+
+					It("downloads the requested version instead of the newest one", func() {
+						Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+
+						Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
+						Expect(testUI.Out).To(Say("Plugin %s 1\\.2\\.0 found in: %s", pluginName, repoName))
+
+						Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(0))
+						Expect(fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformCallCount()).To(Equal(1))
+						pluginNameArg, versionArg, pluginRepositoriesArg, pluginPlatform := fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformArgsForCall(0)
+						Expect(pluginNameArg).To(Equal(pluginName))
+						Expect(versionArg).To(Equal("1.2.0"))
+						Expect(pluginRepositoriesArg).To(Equal([]configv3.PluginRepository{{Name: repoName, URL: repoURL}}))
+						Expect(pluginPlatform).To(Equal(platform))
+
+						Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(1))
+						urlArg, _, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+						Expect(urlArg).To(Equal(pluginURL))
+					})
+				})
+
+				Context("when the version is not found", func() {
+					BeforeEach(func() {
+						fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, pluginaction.PluginVersionNotFoundInAnyRepositoryError{
+							PluginName:        pluginName,
+							Version:           "1.2.0",
+							AvailableVersions: []string{"1.3.0", "1.1.0"},
+						})
+					})
+
+					It("returns a PluginVersionNotFoundInRepositoryError listing the available versions", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PluginVersionNotFoundInRepositoryError{
+							PluginName:        pluginName,
+							Version:           "1.2.0",
+							RepositoryName:    repoName,
+							AvailableVersions: []string{"1.3.0", "1.1.0"},
+						}))
+					})
+				})
+			})
+
 			Context("when the plugin is found", func() {
 				var (
 					checksum                string
@@ -290,6 +343,29 @@ var _ = Describe("install-plugin command", func() {
 									fakeActor.ValidateFileChecksumReturns(true)
 								})
 
+								Context("when the --checksum flag is provided and does not match", func() {
+									BeforeEach(func() {
+										cmd.Checksum = "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"
+										fakeActor.VerifyPluginChecksumReturns(pluginaction.PluginChecksumMismatchError{
+											ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+											ActualChecksum:   "some-other-checksum",
+										})
+									})
+
+									It("returns a PluginChecksumMismatchError and does not install the plugin", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+											ExpectedChecksum: "2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9",
+											ActualChecksum:   "some-other-checksum",
+										}))
+
+										Expect(fakeActor.VerifyPluginChecksumCallCount()).To(Equal(1))
+										pathArg, checksumArg := fakeActor.VerifyPluginChecksumArgsForCall(0)
+										Expect(pathArg).To(Equal(execPath))
+										Expect(checksumArg).To(Equal("2c13c28a5c4a4ee2b6bbf6ab2f42b4f4f6d8a1b9"))
+										Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+									})
+								})
+
 								Context("when creating an executable copy errors", func() {
 									BeforeEach(func() {
 										fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
@@ -761,6 +837,53 @@ var _ = Describe("install-plugin command", func() {
 				})
 			})
 
+			Context("when a version is requested", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.PluginNameOrLocation = flag.Path(pluginName + "@1.2.0")
+				})
+
+				Context("when the version is found", func() {
+					BeforeEach(func() {
+						cmd.Force = true
+						fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: "1.2.0", URL: pluginURL, Checksum: "some-checksum"}, []string{repoName}, nil)
+						fakeActor.DownloadExecutableBinaryFromURLReturns("some-path", nil)
+						fakeActor.ValidateFileChecksumReturns(false)
+					})
+
+					It("downloads the requested version instead of the newest one", func() {
+						Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+
+						Expect(testUI.Out).To(Say("Plugin %s 1\\.2\\.0 found in: %s", pluginName, repoName))
+
+						Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(0))
+						Expect(fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformCallCount()).To(Equal(1))
+						pluginNameArg, versionArg, pluginRepositoriesArg, pluginPlatform := fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformArgsForCall(0)
+						Expect(pluginNameArg).To(Equal(pluginName))
+						Expect(versionArg).To(Equal("1.2.0"))
+						Expect(pluginRepositoriesArg).To(Equal([]configv3.PluginRepository{{Name: repoName, URL: repoURL}}))
+						Expect(pluginPlatform).To(Equal(platform))
+					})
+				})
+
+				Context("when the version is not found", func() {
+					BeforeEach(func() {
+						fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, pluginaction.PluginVersionNotFoundInAnyRepositoryError{
+							PluginName:        pluginName,
+							Version:           "1.2.0",
+							AvailableVersions: []string{"1.3.0", "1.1.0"},
+						})
+					})
+
+					It("returns a PluginVersionNotFoundInAnyRepositoryError listing the available versions", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PluginVersionNotFoundInAnyRepositoryError{
+							PluginName:        pluginName,
+							Version:           "1.2.0",
+							AvailableVersions: []string{"1.3.0", "1.1.0"},
+						}))
+					})
+				})
+			})
+
 			Context("when the plugin is found", func() {
 				var (
 					checksum                string
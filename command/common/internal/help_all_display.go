@@ -141,7 +141,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "uninstall-plugin", "uninstall-all-plugins"},
 		},
 	},
 }
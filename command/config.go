@@ -32,6 +32,7 @@ type Config interface {
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
+	RequestTimeout() time.Duration
 	RemovePlugin(string)
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
@@ -39,12 +40,14 @@ type Config interface {
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAGrantType(grantType string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	UAAGrantType() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
@@ -26,7 +26,9 @@ type AddPluginRepoCommand struct {
 func (cmd *AddPluginRepoCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	actor := pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	actor.UseRepositoryCache(false)
+	cmd.Actor = actor
 	return nil
 }
 
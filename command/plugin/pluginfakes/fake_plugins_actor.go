@@ -6,9 +6,32 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type FakePluginsActor struct {
+	CalculatePluginChecksumStub        func(pluginName string) (string, error)
+	calculatePluginChecksumMutex       sync.RWMutex
+	calculatePluginChecksumArgsForCall []struct {
+		pluginName string
+	}
+	calculatePluginChecksumReturns struct {
+		result1 string
+		result2 error
+	}
+	calculatePluginChecksumReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetInstalledPluginsStub        func() []configv3.Plugin
+	getInstalledPluginsMutex       sync.RWMutex
+	getInstalledPluginsArgsForCall []struct{}
+	getInstalledPluginsReturns     struct {
+		result1 []configv3.Plugin
+	}
+	getInstalledPluginsReturnsOnCall map[int]struct {
+		result1 []configv3.Plugin
+	}
 	GetOutdatedPluginsStub        func() ([]pluginaction.OutdatedPlugin, error)
 	getOutdatedPluginsMutex       sync.RWMutex
 	getOutdatedPluginsArgsForCall []struct{}
@@ -24,6 +47,97 @@ type FakePluginsActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePluginsActor) CalculatePluginChecksum(pluginName string) (string, error) {
+	fake.calculatePluginChecksumMutex.Lock()
+	ret, specificReturn := fake.calculatePluginChecksumReturnsOnCall[len(fake.calculatePluginChecksumArgsForCall)]
+	fake.calculatePluginChecksumArgsForCall = append(fake.calculatePluginChecksumArgsForCall, struct {
+		pluginName string
+	}{pluginName})
+	fake.recordInvocation("CalculatePluginChecksum", []interface{}{pluginName})
+	fake.calculatePluginChecksumMutex.Unlock()
+	if fake.CalculatePluginChecksumStub != nil {
+		return fake.CalculatePluginChecksumStub(pluginName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.calculatePluginChecksumReturns.result1, fake.calculatePluginChecksumReturns.result2
+}
+
+func (fake *FakePluginsActor) CalculatePluginChecksumCallCount() int {
+	fake.calculatePluginChecksumMutex.RLock()
+	defer fake.calculatePluginChecksumMutex.RUnlock()
+	return len(fake.calculatePluginChecksumArgsForCall)
+}
+
+func (fake *FakePluginsActor) CalculatePluginChecksumArgsForCall(i int) string {
+	fake.calculatePluginChecksumMutex.RLock()
+	defer fake.calculatePluginChecksumMutex.RUnlock()
+	return fake.calculatePluginChecksumArgsForCall[i].pluginName
+}
+
+func (fake *FakePluginsActor) CalculatePluginChecksumReturns(result1 string, result2 error) {
+	fake.CalculatePluginChecksumStub = nil
+	fake.calculatePluginChecksumReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginsActor) CalculatePluginChecksumReturnsOnCall(i int, result1 string, result2 error) {
+	fake.CalculatePluginChecksumStub = nil
+	if fake.calculatePluginChecksumReturnsOnCall == nil {
+		fake.calculatePluginChecksumReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.calculatePluginChecksumReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginsActor) GetInstalledPlugins() []configv3.Plugin {
+	fake.getInstalledPluginsMutex.Lock()
+	ret, specificReturn := fake.getInstalledPluginsReturnsOnCall[len(fake.getInstalledPluginsArgsForCall)]
+	fake.getInstalledPluginsArgsForCall = append(fake.getInstalledPluginsArgsForCall, struct{}{})
+	fake.recordInvocation("GetInstalledPlugins", []interface{}{})
+	fake.getInstalledPluginsMutex.Unlock()
+	if fake.GetInstalledPluginsStub != nil {
+		return fake.GetInstalledPluginsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getInstalledPluginsReturns.result1
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsCallCount() int {
+	fake.getInstalledPluginsMutex.RLock()
+	defer fake.getInstalledPluginsMutex.RUnlock()
+	return len(fake.getInstalledPluginsArgsForCall)
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsReturns(result1 []configv3.Plugin) {
+	fake.GetInstalledPluginsStub = nil
+	fake.getInstalledPluginsReturns = struct {
+		result1 []configv3.Plugin
+	}{result1}
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsReturnsOnCall(i int, result1 []configv3.Plugin) {
+	fake.GetInstalledPluginsStub = nil
+	if fake.getInstalledPluginsReturnsOnCall == nil {
+		fake.getInstalledPluginsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Plugin
+		})
+	}
+	fake.getInstalledPluginsReturnsOnCall[i] = struct {
+		result1 []configv3.Plugin
+	}{result1}
+}
+
 func (fake *FakePluginsActor) GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error) {
 	fake.getOutdatedPluginsMutex.Lock()
 	ret, specificReturn := fake.getOutdatedPluginsReturnsOnCall[len(fake.getOutdatedPluginsArgsForCall)]
@@ -70,6 +184,10 @@ func (fake *FakePluginsActor) GetOutdatedPluginsReturnsOnCall(i int, result1 []p
 func (fake *FakePluginsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.calculatePluginChecksumMutex.RLock()
+	defer fake.calculatePluginChecksumMutex.RUnlock()
+	fake.getInstalledPluginsMutex.RLock()
+	defer fake.getInstalledPluginsMutex.RUnlock()
 	fake.getOutdatedPluginsMutex.RLock()
 	defer fake.getOutdatedPluginsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
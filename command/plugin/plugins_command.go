@@ -13,6 +13,8 @@ import (
 //go:generate counterfeiter . PluginsActor
 
 type PluginsActor interface {
+	CalculatePluginChecksum(pluginName string) (string, error)
+	GetInstalledPlugins() []configv3.Plugin
 	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
 }
 
@@ -40,9 +42,9 @@ func (cmd PluginsCommand) Execute([]string) error {
 	case cmd.Outdated:
 		return cmd.displayOutdatedPlugins()
 	case cmd.Checksum:
-		return cmd.displayPluginChecksums(cmd.Config.Plugins())
+		return cmd.displayPluginChecksums(cmd.Actor.GetInstalledPlugins())
 	default:
-		return cmd.displayPluginCommands(cmd.Config.Plugins())
+		return cmd.displayPluginCommands(cmd.Actor.GetInstalledPlugins())
 	}
 }
 
@@ -50,7 +52,11 @@ func (cmd PluginsCommand) displayPluginChecksums(plugins []configv3.Plugin) erro
 	cmd.UI.DisplayText("Computing sha1 for installed plugins, this may take a while...")
 	table := [][]string{{"plugin", "version", "sha1"}}
 	for _, plugin := range plugins {
-		table = append(table, []string{plugin.Name, plugin.Version.String(), plugin.CalculateSHA1()})
+		checksum, err := cmd.Actor.CalculatePluginChecksum(plugin.Name)
+		if err != nil {
+			checksum = "N/A"
+		}
+		table = append(table, []string{plugin.Name, plugin.Version.String(), checksum})
 	}
 
 	cmd.UI.DisplayNewline()
@@ -1,9 +1,6 @@
 package plugin_test
 
 import (
-	"io/ioutil"
-	"os"
-
 	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/plugin"
@@ -111,7 +108,7 @@ var _ = Describe("plugins Command", func() {
 					},
 				},
 			}
-			fakeConfig.PluginsReturns(plugins)
+			fakeActor.GetInstalledPluginsReturns(plugins)
 		})
 
 		It("displays the plugins in alphabetical order and their commands", func() {
@@ -129,29 +126,15 @@ var _ = Describe("plugins Command", func() {
 		})
 
 		Context("when the --checksum flag is provided", func() {
-			var (
-				file *os.File
-			)
-
 			BeforeEach(func() {
 				cmd.Checksum = true
 
-				var err error
-				file, err = ioutil.TempFile("", "")
-				defer file.Close()
-				Expect(err).NotTo(HaveOccurred())
-
-				err = ioutil.WriteFile(file.Name(), []byte("some-text"), 0600)
-				Expect(err).NotTo(HaveOccurred())
-
-				plugins[0].Location = file.Name()
-
-				plugins[1].Location = "/wut/wut/"
-			})
-
-			AfterEach(func() {
-				err := os.Remove(file.Name())
-				Expect(err).NotTo(HaveOccurred())
+				fakeActor.CalculatePluginChecksumStub = func(pluginName string) (string, error) {
+					if pluginName == "Sorted-first" {
+						return "2142a57cb8587400fa7f4ee492f25cf07567f4a5", nil
+					}
+					return "", pluginaction.PluginBinaryMissingError{PluginName: pluginName, Path: "/wut/wut/"}
+				}
 			})
 
 			It("displays the plugin checksums", func() {
@@ -162,6 +145,10 @@ var _ = Describe("plugins Command", func() {
 				Expect(testUI.Out).To(Say("plugin\\s+version\\s+sha1"))
 				Expect(testUI.Out).To(Say("Sorted-first\\s+1\\.1\\.0\\s+2142a57cb8587400fa7f4ee492f25cf07567f4a5"))
 				Expect(testUI.Out).To(Say("sorted-second\\s+N/A\\s+N/A"))
+
+				Expect(fakeActor.CalculatePluginChecksumCallCount()).To(Equal(2))
+				Expect(fakeActor.CalculatePluginChecksumArgsForCall(0)).To(Equal("Sorted-first"))
+				Expect(fakeActor.CalculatePluginChecksumArgsForCall(1)).To(Equal("sorted-second"))
 			})
 		})
 
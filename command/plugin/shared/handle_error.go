@@ -25,6 +25,14 @@ func HandleError(err error) error {
 		return translatableerror.GettingPluginRepositoryError{Name: e.Name, Message: e.Message}
 	case pluginaction.NoCompatibleBinaryError:
 		return translatableerror.NoCompatibleBinaryError{}
+	case pluginaction.NoCompatibleBinaryInDirectoryError:
+		return translatableerror.NoCompatibleBinaryInDirectoryError{Path: e.Path, Platform: e.Platform, Binaries: e.Binaries}
+	case pluginaction.PluginBinaryMissingError:
+		return translatableerror.PluginBinaryMissingError{PluginName: e.PluginName, Path: e.Path}
+	case pluginaction.PluginArchitectureMismatchError:
+		return translatableerror.PluginArchitectureMismatchError{Path: e.Path, ExpectedArchitecture: e.ExpectedArchitecture, ActualArchitecture: e.ActualArchitecture}
+	case pluginaction.PluginChecksumMismatchError:
+		return translatableerror.PluginChecksumMismatchError{ExpectedChecksum: e.ExpectedChecksum, ActualChecksum: e.ActualChecksum}
 	case pluginaction.PluginCommandsConflictError:
 		return translatableerror.PluginCommandsConflictError{
 			PluginName:     e.PluginName,
@@ -36,6 +44,8 @@ func HandleError(err error) error {
 		return translatableerror.PluginInvalidError{Err: e.Err}
 	case pluginaction.PluginNotFoundError:
 		return translatableerror.PluginNotFoundError{PluginName: e.PluginName}
+	case pluginaction.PluginRepositoryNotCachedError:
+		return translatableerror.PluginRepositoryNotCachedError{URL: e.URL}
 	case pluginaction.RepositoryNameTakenError:
 		return translatableerror.RepositoryNameTakenError{Name: e.Name}
 	case pluginaction.RepositoryNotRegisteredError:
@@ -51,6 +51,18 @@ var _ = Describe("HandleError", func() {
 		Entry("pluginaction.NoCompatibleBinaryError -> NoCompatibleBinaryError",
 			pluginaction.NoCompatibleBinaryError{},
 			translatableerror.NoCompatibleBinaryError{}),
+		Entry("pluginaction.NoCompatibleBinaryInDirectoryError -> NoCompatibleBinaryInDirectoryError",
+			pluginaction.NoCompatibleBinaryInDirectoryError{Path: "some-path", Platform: "linux_amd64", Binaries: []string{"plugin_darwin_amd64"}},
+			translatableerror.NoCompatibleBinaryInDirectoryError{Path: "some-path", Platform: "linux_amd64", Binaries: []string{"plugin_darwin_amd64"}}),
+		Entry("pluginaction.PluginBinaryMissingError -> PluginBinaryMissingError",
+			pluginaction.PluginBinaryMissingError{PluginName: "some-plugin", Path: "some-path"},
+			translatableerror.PluginBinaryMissingError{PluginName: "some-plugin", Path: "some-path"}),
+		Entry("pluginaction.PluginArchitectureMismatchError -> PluginArchitectureMismatchError",
+			pluginaction.PluginArchitectureMismatchError{Path: "some-path", ExpectedArchitecture: "ELF amd64", ActualArchitecture: "PE 386"},
+			translatableerror.PluginArchitectureMismatchError{Path: "some-path", ExpectedArchitecture: "ELF amd64", ActualArchitecture: "PE 386"}),
+		Entry("pluginaction.PluginChecksumMismatchError -> PluginChecksumMismatchError",
+			pluginaction.PluginChecksumMismatchError{ExpectedChecksum: "some-checksum", ActualChecksum: "some-other-checksum"},
+			translatableerror.PluginChecksumMismatchError{ExpectedChecksum: "some-checksum", ActualChecksum: "some-other-checksum"}),
 		Entry("pluginaction.PluginCommandConflictError -> PluginCommandConflictError",
 			pluginaction.PluginCommandsConflictError{
 				PluginName:     "some-plugin",
@@ -73,6 +85,9 @@ var _ = Describe("HandleError", func() {
 		Entry("pluginaction.PluginNotFoundError -> PluginNotFoundError",
 			pluginaction.PluginNotFoundError{PluginName: "some-plugin"},
 			translatableerror.PluginNotFoundError{PluginName: "some-plugin"}),
+		Entry("pluginaction.PluginRepositoryNotCachedError -> PluginRepositoryNotCachedError",
+			pluginaction.PluginRepositoryNotCachedError{URL: "https://some-repo.com"},
+			translatableerror.PluginRepositoryNotCachedError{URL: "https://some-repo.com"}),
 		Entry("pluginaction.RepositoryNameTakenError -> RepositoryNameTakenError",
 			pluginaction.RepositoryNameTakenError{Name: "some-repo"},
 			translatableerror.RepositoryNameTakenError{Name: "some-repo"}),
@@ -16,9 +16,17 @@ func (p ProgressBarProxyReader) Wrap(reader io.Reader) io.ReadCloser {
 	return p.bar.NewProxyReader(reader)
 }
 
+// Start displays a progress bar for a download of the given size. If the size
+// is unknown (less than or equal to 0), only the number of bytes downloaded so
+// far is displayed.
 func (p *ProgressBarProxyReader) Start(size int64) {
 	p.bar = pb.New(int(size)).SetUnits(pb.U_BYTES)
 	p.bar.Output = p.writer
+	if size <= 0 {
+		p.bar.ShowPercent = false
+		p.bar.ShowBar = false
+		p.bar.ShowTimeLeft = false
+	}
 	p.bar.Start()
 }
 
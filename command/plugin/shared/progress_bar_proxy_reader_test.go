@@ -0,0 +1,47 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/command/plugin/shared"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ProgressBarProxyReader", func() {
+	var (
+		output      *Buffer
+		proxyReader *ProgressBarProxyReader
+	)
+
+	BeforeEach(func() {
+		output = NewBuffer()
+		proxyReader = NewProgressBarProxyReader(output)
+	})
+
+	download := func(size int64) {
+		proxyReader.Start(size)
+		reader := proxyReader.Wrap(strings.NewReader("some-plugin-data"))
+		_, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		proxyReader.Finish()
+	}
+
+	Context("when the size of the download is known", func() {
+		It("displays the percentage downloaded", func() {
+			download(16)
+			Expect(output).To(Say("16 B / 16 B"))
+			Expect(string(output.Contents())).To(ContainSubstring("100.00%"))
+		})
+	})
+
+	Context("when the size of the download is unknown", func() {
+		It("displays the number of bytes downloaded so far", func() {
+			download(-1)
+			Expect(output).To(Say("16 B"))
+			Expect(string(output.Contents())).ToNot(ContainSubstring("%"))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+//go:generate counterfeiter . UninstallAllPluginsActor
+
+type UninstallAllPluginsActor interface {
+	UninstallAllPlugins(uninstaller pluginaction.PluginUninstaller) ([]string, []error)
+}
+
+type UninstallAllPluginsCommand struct {
+	Force           bool        `short:"f" description:"Force uninstall without confirmation"`
+	usage           interface{} `usage:"CF_NAME uninstall-all-plugins [-f]"`
+	relatedCommands interface{} `related_commands:"plugins, uninstall-plugin"`
+
+	Config command.Config
+	UI     command.UI
+	Actor  UninstallAllPluginsActor
+}
+
+func (cmd *UninstallAllPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd UninstallAllPluginsCommand) Execute(args []string) error {
+	if !cmd.Force {
+		uninstallAll, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really uninstall all plugins?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !uninstallAll {
+			cmd.UI.DisplayText("Plugins have not been uninstalled.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Uninstalling all plugins...")
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	uninstalled, errs := cmd.Actor.UninstallAllPlugins(rpcService)
+	for _, pluginName := range uninstalled {
+		cmd.UI.DisplayText("Plugin {{.PluginName}} successfully uninstalled.",
+			map[string]interface{}{
+				"PluginName": pluginName,
+			})
+	}
+
+	if len(errs) > 0 {
+		for _, uninstallErr := range errs {
+			if pluginErr, ok := uninstallErr.(pluginaction.PluginUninstallError); ok {
+				cmd.UI.DisplayWarning("Failed to uninstall plugin {{.PluginName}} from {{.Path}}: {{.Error}}", map[string]interface{}{
+					"PluginName": pluginErr.PluginName,
+					"Path":       pluginErr.Path,
+					"Error":      pluginErr.Err.Error(),
+				})
+				continue
+			}
+			cmd.UI.DisplayWarning(uninstallErr.Error())
+		}
+		return translatableerror.UninstallAllPluginsError{FailureCount: len(errs)}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("uninstall-all-plugins command", func() {
+	var (
+		cmd        UninstallAllPluginsCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeUninstallAllPluginsActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeUninstallAllPluginsActor)
+
+		cmd = UninstallAllPluginsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user declines the prompt", func() {
+			BeforeEach(func() {
+				input.Write([]byte("n\n"))
+			})
+
+			It("does not uninstall any plugins", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Really uninstall all plugins\\?"))
+				Expect(testUI.Out).To(Say("Plugins have not been uninstalled\\."))
+				Expect(fakeActor.UninstallAllPluginsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user accepts the prompt", func() {
+			BeforeEach(func() {
+				input.Write([]byte("y\n"))
+			})
+
+			It("uninstalls all plugins", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Really uninstall all plugins\\?"))
+				Expect(testUI.Out).To(Say("Uninstalling all plugins\\.\\.\\."))
+				Expect(fakeActor.UninstallAllPluginsCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeActor.UninstallAllPluginsReturns([]string{"plugin-1", "plugin-2"}, nil)
+			})
+
+			It("uninstalls every plugin without prompting and outputs the success messages", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Really uninstall all plugins\\?"))
+				Expect(testUI.Out).To(Say("Uninstalling all plugins\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Plugin plugin-1 successfully uninstalled\\."))
+				Expect(testUI.Out).To(Say("Plugin plugin-2 successfully uninstalled\\."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.UninstallAllPluginsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when errors are encountered uninstalling some plugins", func() {
+			BeforeEach(func() {
+				fakeActor.UninstallAllPluginsReturns(
+					[]string{"plugin-2"},
+					[]error{
+						pluginaction.PluginUninstallError{
+							PluginName: "plugin-1",
+							Path:       "/some/path/plugin-1",
+							Err:        pluginaction.PluginExecuteError{Err: errors.New("some-execute-error")},
+						},
+						errors.New("some-other-error"),
+					},
+				)
+			})
+
+			It("displays the errors as warnings and returns an UninstallAllPluginsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.UninstallAllPluginsError{FailureCount: 2}))
+
+				Expect(testUI.Out).To(Say("Plugin plugin-2 successfully uninstalled\\."))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("Failed to uninstall plugin plugin-1 from /some/path/plugin-1: some-execute-error"))
+				Expect(testUI.Err).To(Say("some-other-error"))
+			})
+		})
+	})
+})
@@ -13,3 +13,7 @@ func (e ApplicationNotFoundError) Translate(translate func(string, ...interface{
 		"AppName": e.Name,
 	})
 }
+
+func (ApplicationNotFoundError) ExitCode() int {
+	return ExitCodeNotFound
+}
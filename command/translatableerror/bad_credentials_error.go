@@ -9,3 +9,7 @@ func (BadCredentialsError) Error() string {
 func (e BadCredentialsError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{})
 }
+
+func (BadCredentialsError) ExitCode() int {
+	return ExitCodeUnauthorized
+}
@@ -0,0 +1,38 @@
+package translatableerror
+
+// Exit codes returned by the CLI when a command fails. Scripts may rely on
+// these values, so existing codes must not be changed.
+const (
+	// ExitCodeGeneral is returned for any failure that does not have a more
+	// specific exit code.
+	ExitCodeGeneral = 1
+
+	// ExitCodeUnauthorized is returned when the user is not logged in, their
+	// credentials are rejected, or their token has expired.
+	ExitCodeUnauthorized = 2
+
+	// ExitCodeTimeout is returned when an operation does not complete within
+	// its timeout.
+	ExitCodeTimeout = 3
+
+	// ExitCodeNotFound is returned when a requested resource does not exist.
+	ExitCodeNotFound = 4
+
+	// ExitCodeQuotaExceeded is returned when a request would exceed an
+	// organization or space quota.
+	ExitCodeQuotaExceeded = 5
+)
+
+// ExitCoder is implemented by errors that map to a specific exit code.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// ExitCode returns the exit code for err. Errors that do not implement
+// ExitCoder return ExitCodeGeneral.
+func ExitCode(err error) int {
+	if exitCoder, ok := err.(ExitCoder); ok {
+		return exitCoder.ExitCode()
+	}
+	return ExitCodeGeneral
+}
@@ -0,0 +1,40 @@
+package translatableerror_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExitCode", func() {
+	DescribeTable("returns the documented exit code",
+		func(err error, expectedCode int) {
+			Expect(ExitCode(err)).To(Equal(expectedCode))
+		},
+
+		Entry("BadCredentialsError", BadCredentialsError{}, 2),
+		Entry("InvalidRefreshTokenError", InvalidRefreshTokenError{}, 2),
+		Entry("NotLoggedInError", NotLoggedInError{}, 2),
+
+		Entry("JobTimeoutError", JobTimeoutError{}, 3),
+		Entry("StagingTimeoutError", StagingTimeoutError{}, 3),
+		Entry("StartupTimeoutError", StartupTimeoutError{}, 3),
+
+		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}, 4),
+		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}, 4),
+		Entry("OrganizationNotFoundError", OrganizationNotFoundError{}, 4),
+		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}, 4),
+		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}, 4),
+		Entry("SpaceNotFoundError", SpaceNotFoundError{}, 4),
+		Entry("StackNotFoundError", StackNotFoundError{}, 4),
+
+		Entry("QuotaExceededError", QuotaExceededError{}, 5),
+
+		Entry("an unclassified translatable error", NoAPISetError{}, 1),
+		Entry("a generic error", errors.New("some-error"), 1),
+	)
+})
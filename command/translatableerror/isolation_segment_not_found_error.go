@@ -13,3 +13,7 @@ func (e IsolationSegmentNotFoundError) Translate(translate func(string, ...inter
 		"Name": e.Name,
 	})
 }
+
+func (IsolationSegmentNotFoundError) ExitCode() int {
+	return ExitCodeNotFound
+}
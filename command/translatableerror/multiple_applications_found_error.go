@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+// MultipleApplicationsFoundError is returned when an application name matches
+// more than one application when case is ignored.
+type MultipleApplicationsFoundError struct {
+	Name  string
+	Names []string
+}
+
+func (MultipleApplicationsFoundError) Error() string {
+	return "App name {{.AppName}} matches multiple apps: {{.AppNames}}. Use the exact app name."
+}
+
+func (e MultipleApplicationsFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":  e.Name,
+		"AppNames": strings.Join(e.Names, ", "),
+	})
+}
@@ -0,0 +1,26 @@
+package translatableerror
+
+import "strings"
+
+// NoCompatibleBinaryInDirectoryError is returned when a plugin directory does
+// not contain a binary for the current platform.
+type NoCompatibleBinaryInDirectoryError struct {
+	Path     string
+	Platform string
+	Binaries []string
+}
+
+func (e NoCompatibleBinaryInDirectoryError) Error() string {
+	if len(e.Binaries) == 0 {
+		return "No plugin binary for {{.Platform}} found in {{.Path}}. The directory contains no files."
+	}
+	return "No plugin binary for {{.Platform}} found in {{.Path}}. Found: {{.Binaries}}"
+}
+
+func (e NoCompatibleBinaryInDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":     e.Path,
+		"Platform": e.Platform,
+		"Binaries": strings.Join(e.Binaries, ", "),
+	})
+}
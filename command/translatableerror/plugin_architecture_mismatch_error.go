@@ -0,0 +1,21 @@
+package translatableerror
+
+// PluginArchitectureMismatchError is returned when a plugin binary was built
+// for a different operating system or architecture than the current one.
+type PluginArchitectureMismatchError struct {
+	Path                 string
+	ExpectedArchitecture string
+	ActualArchitecture   string
+}
+
+func (e PluginArchitectureMismatchError) Error() string {
+	return "Plugin binary {{.Path}} is built for {{.ActualArchitecture}}, but this system requires {{.ExpectedArchitecture}}.\nDownload the plugin binary for your platform and try again."
+}
+
+func (e PluginArchitectureMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":                 e.Path,
+		"ExpectedArchitecture": e.ExpectedArchitecture,
+		"ActualArchitecture":   e.ActualArchitecture,
+	})
+}
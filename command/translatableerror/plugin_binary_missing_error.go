@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginBinaryMissingError is returned when an installed plugin's binary no
+// longer exists at the location recorded in the plugin config.
+type PluginBinaryMissingError struct {
+	PluginName string
+	Path       string
+}
+
+func (e PluginBinaryMissingError) Error() string {
+	return "Plugin {{.PluginName}} binary is missing from {{.Path}}. Reinstall the plugin to restore it."
+}
+
+func (e PluginBinaryMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Path":       e.Path,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginChecksumMismatchError is returned when a downloaded plugin binary
+// does not match the checksum provided by the user.
+type PluginChecksumMismatchError struct {
+	ExpectedChecksum string
+	ActualChecksum   string
+}
+
+func (e PluginChecksumMismatchError) Error() string {
+	return "Downloaded plugin binary's checksum {{.ActualChecksum}} does not match the expected checksum {{.ExpectedChecksum}}.\nPlease try again or contact the plugin author."
+}
+
+func (e PluginChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ExpectedChecksum": e.ExpectedChecksum,
+		"ActualChecksum":   e.ActualChecksum,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginRepositoryNotCachedError is returned by install-plugin --offline when
+// a plugin repository's catalog has not been cached yet.
+type PluginRepositoryNotCachedError struct {
+	URL string
+}
+
+func (PluginRepositoryNotCachedError) Error() string {
+	return "Plugin repository {{.URL}} has not been cached.\nRun the command without --offline to download its catalog."
+}
+
+func (e PluginRepositoryNotCachedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL": e.URL,
+	})
+}
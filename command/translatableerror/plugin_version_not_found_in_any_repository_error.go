@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+type PluginVersionNotFoundInAnyRepositoryError struct {
+	PluginName        string
+	Version           string
+	AvailableVersions []string
+}
+
+func (e PluginVersionNotFoundInAnyRepositoryError) Error() string {
+	return "Plugin {{.PluginName}} version {{.Version}} not found in any registered repo.\nAvailable versions: {{.AvailableVersions}}"
+}
+
+func (e PluginVersionNotFoundInAnyRepositoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":        e.PluginName,
+		"Version":           e.Version,
+		"AvailableVersions": strings.Join(e.AvailableVersions, ", "),
+	})
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+import "strings"
+
+type PluginVersionNotFoundInRepositoryError struct {
+	PluginName        string
+	Version           string
+	RepositoryName    string
+	AvailableVersions []string
+}
+
+func (e PluginVersionNotFoundInRepositoryError) Error() string {
+	return "Plugin {{.PluginName}} version {{.Version}} not found in repository {{.RepositoryName}}.\nAvailable versions: {{.AvailableVersions}}"
+}
+
+func (e PluginVersionNotFoundInRepositoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":        e.PluginName,
+		"Version":           e.Version,
+		"RepositoryName":    e.RepositoryName,
+		"AvailableVersions": strings.Join(e.AvailableVersions, ", "),
+	})
+}
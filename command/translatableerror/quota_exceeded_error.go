@@ -0,0 +1,19 @@
+package translatableerror
+
+type QuotaExceededError struct {
+	Message string
+}
+
+func (QuotaExceededError) Error() string {
+	return "{{.Message}}"
+}
+
+func (e QuotaExceededError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
+
+func (QuotaExceededError) ExitCode() int {
+	return ExitCodeQuotaExceeded
+}
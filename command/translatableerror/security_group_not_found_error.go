@@ -13,3 +13,7 @@ func (e SecurityGroupNotFoundError) Translate(translate func(string, ...interfac
 		"Name": e.Name,
 	})
 }
+
+func (SecurityGroupNotFoundError) ExitCode() int {
+	return ExitCodeNotFound
+}
@@ -13,3 +13,7 @@ func (e ServiceInstanceNotFoundError) Translate(translate func(string, ...interf
 		"ServiceInstance": e.Name,
 	})
 }
+
+func (ServiceInstanceNotFoundError) ExitCode() int {
+	return ExitCodeNotFound
+}
@@ -17,3 +17,7 @@ func (e StagingTimeoutError) Translate(translate func(string, ...interface{}) st
 		"Timeout": e.Timeout.Minutes(),
 	})
 }
+
+func (StagingTimeoutError) ExitCode() int {
+	return ExitCodeTimeout
+}
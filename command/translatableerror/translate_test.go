@@ -61,8 +61,10 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
+		Entry("MultipleApplicationsFoundError", MultipleApplicationsFoundError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
+		Entry("NoCompatibleBinaryInDirectoryError", NoCompatibleBinaryInDirectoryError{}),
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
@@ -71,14 +73,21 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
+		Entry("PluginArchitectureMismatchError", PluginArchitectureMismatchError{}),
+		Entry("PluginBinaryMissingError", PluginBinaryMissingError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
 		Entry("PluginBinaryUninstallError", PluginBinaryUninstallError{}),
+		Entry("PluginChecksumMismatchError", PluginChecksumMismatchError{}),
 		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
 		Entry("PluginInvalidError", PluginInvalidError{}),
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
+		Entry("PluginRepositoryNotCachedError", PluginRepositoryNotCachedError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PluginVersionNotFoundInAnyRepositoryError", PluginVersionNotFoundInAnyRepositoryError{}),
+		Entry("PluginVersionNotFoundInRepositoryError", PluginVersionNotFoundInRepositoryError{}),
+		Entry("QuotaExceededError", QuotaExceededError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
@@ -91,8 +100,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedError", StagingFailedError{}),
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
+		Entry("StackNotFoundError", StackNotFoundError{}),
+		Entry("StackNotFoundError", StackNotFoundError{Name: "some-stack"}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
+		Entry("UninstallAllPluginsError", UninstallAllPluginsError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
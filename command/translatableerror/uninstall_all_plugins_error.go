@@ -0,0 +1,17 @@
+package translatableerror
+
+// UninstallAllPluginsError is returned when one or more errors are
+// encountered while uninstalling all plugins.
+type UninstallAllPluginsError struct {
+	FailureCount int
+}
+
+func (e UninstallAllPluginsError) Error() string {
+	return "Encountered {{.FailureCount}} error(s) while uninstalling plugins."
+}
+
+func (e UninstallAllPluginsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailureCount": e.FailureCount,
+	})
+}
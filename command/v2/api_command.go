@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util"
 )
 
 //go:generate counterfeiter . APIActor
@@ -92,6 +93,7 @@ func (cmd *ApiCommand) setAPI() error {
 		URL:               apiURL,
 		SkipSSLValidation: cmd.SkipSSLValidation,
 		DialTimeout:       cmd.Config.DialTimeout(),
+		RequestTimeout:    cmd.Config.RequestTimeout(),
 	})
 	if err != nil {
 		return shared.HandleError(err)
@@ -107,7 +109,7 @@ func (cmd *ApiCommand) setAPI() error {
 }
 
 func processURL(apiURL string) string {
-	if !strings.HasPrefix(apiURL, "http") {
+	if !strings.HasPrefix(apiURL, "http") && !util.IsUnixSocketScheme(apiURL) {
 		return fmt.Sprintf("https://%s", apiURL)
 
 	}
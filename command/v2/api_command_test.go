@@ -178,6 +178,26 @@ api version:    some-version`,
 			})
 		})
 
+		Context("when the API is a unix socket", func() {
+			var CCAPI string
+
+			BeforeEach(func() {
+				CCAPI = "unix:///var/run/cc.sock"
+				cmd.OptionalArgs.URL = CCAPI
+			})
+
+			It("sets the target to the socket URL unchanged", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				_, settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.URL).To(Equal(CCAPI))
+
+				Expect(testUI.Out).To(Say("Setting api endpoint to %s...", CCAPI))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
 		Context("when the API is set but the user is not logged in", func() {
 			BeforeEach(func() {
 				cmd.OptionalArgs.URL = "https://api.foo.com"
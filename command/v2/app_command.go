@@ -61,7 +61,7 @@ func (cmd AppCommand) displayAppGUID() error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayText(app.GUID)
+	cmd.UI.DisplayGUID(app.GUID)
 	return nil
 }
 
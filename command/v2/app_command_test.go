@@ -113,10 +113,10 @@ var _ = Describe("App Command", func() {
 						nil)
 				})
 
-				It("displays the application guid and all warnings", func() {
+				It("displays only the application guid and all warnings", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 
-					Expect(testUI.Out).To(Say("some-guid"))
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("some-guid\n"))
 					Expect(testUI.Err).To(Say("warning-1"))
 					Expect(testUI.Err).To(Say("warning-2"))
 				})
@@ -85,7 +85,7 @@ func (cmd OrgCommand) displayOrgGUID() error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayText(org.GUID)
+	cmd.UI.DisplayGUID(org.GUID)
 
 	return nil
 }
@@ -69,7 +69,7 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		map[string]interface{}{"UserName": user.Name})
 
 	secGroupOrgSpaces, warnings, err := cmd.Actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
-	cmd.UI.DisplayWarnings(warnings)
+	cmd.UI.DisplayWarningsDeduplicated(warnings)
 	if err != nil {
 		return err
 	}
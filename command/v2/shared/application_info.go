@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	uiutil "code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bytefmt"
 )
 
@@ -83,9 +84,9 @@ func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstance
 				fmt.Sprintf("#%d", instance.ID),
 				ui.TranslateText(strings.ToLower(string(instance.State))),
 				zuluDate(instance.TimeSinceCreation()),
-				fmt.Sprintf("%.1f%%", instance.CPU*100),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota))),
+				uiutil.FormatCPU(instance.CPU),
+				uiutil.FormatUsage(int64(instance.Memory), int64(instance.MemoryQuota)),
+				uiutil.FormatUsage(int64(instance.Disk), int64(instance.DiskQuota)),
 				instance.Details,
 			})
 	}
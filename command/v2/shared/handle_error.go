@@ -27,6 +27,8 @@ func HandleError(err error) error {
 		}
 	case ccerror.JobTimeoutError:
 		return translatableerror.JobTimeoutError{JobGUID: e.JobGUID}
+	case ccerror.QuotaExceededError:
+		return translatableerror.QuotaExceededError{Message: e.Message}
 
 	case uaa.BadCredentialsError:
 		return translatableerror.BadCredentialsError{}
@@ -42,6 +44,8 @@ func HandleError(err error) error {
 
 	case v2action.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError{Name: e.Name}
+	case v2action.MultipleApplicationsFoundError:
+		return translatableerror.MultipleApplicationsFoundError{Name: e.Name, Names: e.Names}
 	case v2action.OrganizationNotFoundError:
 		return translatableerror.OrganizationNotFoundError{Name: e.Name}
 	case v2action.SecurityGroupNotFoundError:
@@ -50,6 +54,8 @@ func HandleError(err error) error {
 		return translatableerror.ServiceInstanceNotFoundError{Name: e.Name}
 	case v2action.SpaceNotFoundError:
 		return translatableerror.SpaceNotFoundError{Name: e.Name}
+	case v2action.StackNotFoundError:
+		return translatableerror.StackNotFoundError{GUID: e.GUID, Name: e.Name}
 	case v2action.HTTPHealthCheckInvalidError:
 		return translatableerror.HTTPHealthCheckInvalidError{}
 	case v2action.RouteInDifferentSpaceError:
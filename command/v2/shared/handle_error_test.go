@@ -43,6 +43,9 @@ var _ = Describe("HandleError", func() {
 		Entry("v2action.ApplicationNotFoundError -> ApplicationNotFoundError",
 			v2action.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
+		Entry("v2action.MultipleApplicationsFoundError -> MultipleApplicationsFoundError",
+			v2action.MultipleApplicationsFoundError{Name: "some-app", Names: []string{"Some-App", "SOME-APP"}},
+			translatableerror.MultipleApplicationsFoundError{Name: "some-app", Names: []string{"Some-App", "SOME-APP"}}),
 
 		Entry("v2action.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
@@ -60,6 +63,10 @@ var _ = Describe("HandleError", func() {
 			ccerror.JobTimeoutError{JobGUID: "some-job-guid"},
 			translatableerror.JobTimeoutError{JobGUID: "some-job-guid"}),
 
+		Entry("ccerror.QuotaExceededError -> QuotaExceededError",
+			ccerror.QuotaExceededError{Message: "some-message"},
+			translatableerror.QuotaExceededError{Message: "some-message"}),
+
 		Entry("v2action.OrganizationNotFoundError -> OrgNotFoundError",
 			v2action.OrganizationNotFoundError{Name: "some-org"},
 			translatableerror.OrganizationNotFoundError{Name: "some-org"}),
@@ -68,6 +75,10 @@ var _ = Describe("HandleError", func() {
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			translatableerror.SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("v2action.StackNotFoundError -> StackNotFoundError",
+			v2action.StackNotFoundError{Name: "some-stack"},
+			translatableerror.StackNotFoundError{Name: "some-stack"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			translatableerror.NotLoggedInError{BinaryName: "faceman"}),
@@ -50,6 +50,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
+		RequestTimeout:    config.RequestTimeout(),
 	})
 	if err != nil {
 		return nil, nil, HandleError(err)
@@ -61,6 +62,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
+		RequestTimeout:    config.RequestTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.TokenEndpoint(),
 	})
@@ -87,7 +87,7 @@ func (cmd SpaceCommand) displaySpaceGUID() error {
 		return err
 	}
 
-	cmd.UI.DisplayText(org.GUID)
+	cmd.UI.DisplayGUID(org.GUID)
 
 	return nil
 }
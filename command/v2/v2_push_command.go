@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
@@ -32,7 +33,7 @@ type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
-	ReadManifest(pathToManifest string) ([]manifest.Application, error)
+	ReadManifest(pathToManifest string, vars map[string]interface{}) ([]manifest.Application, error)
 }
 
 type V2PushCommand struct {
@@ -57,8 +58,11 @@ type V2PushCommand struct {
 	// RoutePath            string                      `long:"route-path" description:"Path for the route"`
 	StackName          string `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	HealthCheckTimeout int    `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	// Vars are merged over the contents of VarsFiles.
+	Vars      []string                      `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	VarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 
-	usage               interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	usage               interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--no-start]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	// dockerPassword       interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
@@ -84,6 +88,8 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 	v2Actor := v2action.NewActor(ccClient, uaaClient, config)
+	// apps pushed together commonly share a stack
+	v2Actor.EnableStackCache()
 	cmd.RestartActor = v2Actor
 	cmd.Actor = pushaction.NewActor(v2Actor)
 
@@ -254,11 +260,20 @@ func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSett
 		}
 	}
 
+	var varsFiles []string
+	for _, varsFile := range cmd.VarsFiles {
+		varsFiles = append(varsFiles, string(varsFile))
+	}
+	vars, err := manifestparser.ReadVars(varsFiles, cmd.Vars)
+	if err != nil {
+		return nil, err
+	}
+
 	log.WithField("pathToManifest", pathToManifest).Info("reading manifest")
 	cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
 		"Path": pathToManifest,
 	})
-	return cmd.Actor.ReadManifest(pathToManifest)
+	return cmd.Actor.ReadManifest(pathToManifest, vars)
 }
 
 func (cmd V2PushCommand) processApplyStreams(
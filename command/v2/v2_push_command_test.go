@@ -19,6 +19,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -300,7 +301,8 @@ var _ = Describe("v2-push Command", func() {
 									Expect(executeErr).ToNot(HaveOccurred())
 
 									Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-									Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(pathToManifest))
+									manifestPath, _ := fakeActor.ReadManifestArgsForCall(0)
+									Expect(manifestPath).To(Equal(pathToManifest))
 
 									Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
 									cmdSettings, manifestApps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
@@ -357,7 +359,8 @@ var _ = Describe("v2-push Command", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 
 								Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-								Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(pathToManifest))
+								manifestPath, _ := fakeActor.ReadManifestArgsForCall(0)
+								Expect(manifestPath).To(Equal(pathToManifest))
 							})
 						})
 
@@ -374,7 +377,40 @@ var _ = Describe("v2-push Command", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 
 								Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-								Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(pathToManifest))
+								manifestPath, _ := fakeActor.ReadManifestArgsForCall(0)
+								Expect(manifestPath).To(Equal(pathToManifest))
+							})
+
+							Context("when variables are provided", func() {
+								BeforeEach(func() {
+									varsFile := filepath.Join(tmpDir, "vars.yml")
+									err := ioutil.WriteFile(varsFile, []byte("instances: 2\nname: file-name\n"), 0666)
+									Expect(err).ToNot(HaveOccurred())
+
+									cmd.VarsFiles = []flag.PathWithExistenceCheck{flag.PathWithExistenceCheck(varsFile)}
+									cmd.Vars = []string{"name=flag-name"}
+								})
+
+								It("reads the manifest with the merged variables", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+									_, vars := fakeActor.ReadManifestArgsForCall(0)
+									Expect(vars).To(Equal(map[string]interface{}{
+										"instances": 2,
+										"name":      "flag-name",
+									}))
+								})
+							})
+
+							Context("when a variable flag is invalid", func() {
+								BeforeEach(func() {
+									cmd.Vars = []string{"no-equals-sign"}
+								})
+
+								It("returns the error", func() {
+									Expect(executeErr).To(MatchError(manifestparser.InvalidVarFlagError{Var: "no-equals-sign"}))
+								})
 							})
 						})
 					})
@@ -59,10 +59,11 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
-	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
+	ReadManifestStub        func(pathToManifest string, vars map[string]interface{}) ([]manifest.Application, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
 		pathToManifest string
+		vars           map[string]interface{}
 	}
 	readManifestReturns struct {
 		result1 []manifest.Application
@@ -252,16 +253,17 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeV2PushActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+func (fake *FakeV2PushActor) ReadManifest(pathToManifest string, vars map[string]interface{}) ([]manifest.Application, error) {
 	fake.readManifestMutex.Lock()
 	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
 	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
 		pathToManifest string
-	}{pathToManifest})
-	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest})
+		vars           map[string]interface{}
+	}{pathToManifest, vars})
+	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest, vars})
 	fake.readManifestMutex.Unlock()
 	if fake.ReadManifestStub != nil {
-		return fake.ReadManifestStub(pathToManifest)
+		return fake.ReadManifestStub(pathToManifest, vars)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -275,10 +277,10 @@ func (fake *FakeV2PushActor) ReadManifestCallCount() int {
 	return len(fake.readManifestArgsForCall)
 }
 
-func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) string {
+func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) (string, map[string]interface{}) {
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
-	return fake.readManifestArgsForCall[i].pathToManifest
+	return fake.readManifestArgsForCall[i].pathToManifest, fake.readManifestArgsForCall[i].vars
 }
 
 func (fake *FakeV2PushActor) ReadManifestReturns(result1 []manifest.Application, result2 error) {
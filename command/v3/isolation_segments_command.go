@@ -61,7 +61,7 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	})
 
 	summaries, warnings, err := cmd.Actor.GetIsolationSegmentSummaries()
-	cmd.UI.DisplayWarnings(warnings)
+	cmd.UI.DisplayWarningsDeduplicated(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
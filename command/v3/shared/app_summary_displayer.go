@@ -9,7 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
-	"github.com/cloudfoundry/bytefmt"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type AppSummaryDisplayer struct {
@@ -134,9 +134,9 @@ func (cmd AppSummaryDisplayer) displayAppTable(summary v3action.ApplicationSumma
 				fmt.Sprintf("#%d", instance.Index),
 				cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
 				cmd.appInstanceDate(instance.StartTime()),
-				fmt.Sprintf("%.1f%%", instance.CPU*100),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(instance.MemoryUsage), bytefmt.ByteSize(instance.MemoryQuota)),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(instance.DiskUsage), bytefmt.ByteSize(instance.DiskQuota)),
+				ui.FormatCPU(instance.CPU),
+				ui.FormatUsage(int64(instance.MemoryUsage), int64(instance.MemoryQuota)),
+				ui.FormatUsage(int64(instance.DiskUsage), int64(instance.DiskQuota)),
 			})
 		}
 
@@ -15,6 +15,8 @@ func HandleError(err error) error {
 		return translatableerror.APINotFoundError{URL: e.URL}
 	case ccerror.RequestError:
 		return translatableerror.APIRequestError{Err: e.Err}
+	case ccerror.QuotaExceededError:
+		return translatableerror.QuotaExceededError{Message: e.Message}
 	case ccerror.SSLValidationHostnameError:
 		return translatableerror.SSLCertError{Message: e.Message}
 	case ccerror.UnprocessableEntityError:
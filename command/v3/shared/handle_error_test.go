@@ -32,6 +32,10 @@ var _ = Describe("HandleError", func() {
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("ccerror.QuotaExceededError -> QuotaExceededError",
+			ccerror.QuotaExceededError{Message: "some-message"},
+			translatableerror.QuotaExceededError{Message: "some-message"}),
+
 		Entry("ccerror.SSLValidationHostnameError -> SSLCertErrorError",
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
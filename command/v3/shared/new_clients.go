@@ -50,6 +50,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
+		RequestTimeout:    config.RequestTimeout(),
 	})
 	if err != nil {
 		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
@@ -65,6 +66,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
+		RequestTimeout:    config.RequestTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.UAA(),
 	})
@@ -84,7 +84,7 @@ func (cmd TasksCommand) Execute(args []string) error {
 	})
 
 	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
-	cmd.UI.DisplayWarnings(warnings)
+	cmd.UI.DisplayWarningsDeduplicated(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
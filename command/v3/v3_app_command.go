@@ -77,6 +77,6 @@ func (cmd V3AppCommand) displayAppGUID() error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayText(app.GUID)
+	cmd.UI.DisplayGUID(app.GUID)
 	return nil
 }
@@ -67,7 +67,7 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 	cmd.UI.DisplayNewline()
 
 	summaries, warnings, err := cmd.Actor.GetApplicationSummariesBySpace(cmd.Config.TargetedSpace().GUID)
-	cmd.UI.DisplayWarnings(warnings)
+	cmd.UI.DisplayWarningsDeduplicated(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
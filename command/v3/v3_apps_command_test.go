@@ -111,6 +111,19 @@ var _ = Describe("v3-apps Command", func() {
 		})
 	})
 
+	Context("when getting the applications returns repeated warnings", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummariesBySpaceReturns([]v3action.ApplicationSummary{}, v3action.Warnings{"warning-1", "warning-2", "warning-1"}, nil)
+		})
+
+		It("prints each warning once", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("warning-1\nwarning-2\n"))
+			Expect(testUI.Err).ToNot(Say("warning-1"))
+		})
+	})
+
 	Context("when getting routes returns an error", func() {
 		var expectedErr error
 
@@ -45,8 +45,11 @@ var _ = Describe("help command", func() {
 			Eventually(session.Out).Should(Say("CLI plugin management:"))
 			Eventually(session.Out).Should(Say("  install-plugin    list-plugin-repos"))
 			Eventually(session.Out).Should(Say("Global options:"))
-			Eventually(session.Out).Should(Say("  --help, -h                         Show help"))
-			Eventually(session.Out).Should(Say("  -v                                 Print API request diagnostics to stdout"))
+			Eventually(session.Out).Should(Say("  --help, -h                                Show help"))
+			Eventually(session.Out).Should(Say("  --output                                  Output format, only 'json' is supported"))
+			Eventually(session.Out).Should(Say("  --profile                                 Use the named config profile instead of the default one"))
+			Eventually(session.Out).Should(Say("  --request-timeout                         Max wait time for a response to each request, in seconds"))
+			Eventually(session.Out).Should(Say("  -v                                        Print API request diagnostics to stdout"))
 
 			Eventually(session.Out).Should(Say("These are commonly used commands. Use 'cf help -a' to see all, with descriptions."))
 			Eventually(session.Out).Should(Say("See 'cf help <command>' to read about a specific command."))
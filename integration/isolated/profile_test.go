@@ -0,0 +1,59 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("config profiles", func() {
+	var username string
+
+	BeforeEach(func() {
+		helpers.LogoutCF()
+
+		var password string
+		username, password = helpers.GetCredentials()
+
+		loginArgs := []string{"--profile", "work", "login", "-a", apiURL, "-u", username, "-p", password, "-o", ReadOnlyOrg, "-s", ReadOnlySpace}
+		if skipSSLValidation != "" {
+			loginArgs = append(loginArgs, skipSSLValidation)
+		}
+		Eventually(helpers.CF(loginArgs...)).Should(Exit(0))
+	})
+
+	It("stores the login in the profile's config file", func() {
+		config, err := configv3.LoadConfig(configv3.FlagOverride{Profile: "work"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.CurrentProfile()).To(Equal("work"))
+		Expect(config.TargetedOrganization().Name).To(Equal(ReadOnlyOrg))
+		Expect(config.TargetedSpace().Name).To(Equal(ReadOnlySpace))
+
+		user, err := config.CurrentUserName()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user).To(Equal(username))
+	})
+
+	It("reads the login back when the profile is selected with --profile", func() {
+		session := helpers.CF("--profile", "work", "target")
+		Eventually(session.Out).Should(Say("user:\\s+%s", username))
+		Eventually(session.Out).Should(Say("org:\\s+%s", ReadOnlyOrg))
+		Eventually(session.Out).Should(Say("space:\\s+%s", ReadOnlySpace))
+		Eventually(session).Should(Exit(0))
+	})
+
+	It("reads the login back when the profile is selected with CF_PROFILE", func() {
+		session := helpers.CFWithEnv(map[string]string{"CF_PROFILE": "work"}, "target")
+		Eventually(session.Out).Should(Say("user:\\s+%s", username))
+		Eventually(session).Should(Exit(0))
+	})
+
+	It("leaves the default profile logged out", func() {
+		session := helpers.CF("target")
+		Eventually(session).Should(Say("Not logged in. Use 'cf login' to log in."))
+		Eventually(session).Should(Exit(1))
+	})
+})
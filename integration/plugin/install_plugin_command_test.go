@@ -42,13 +42,16 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session.Out).Should(Say("NAME:"))
 				Eventually(session.Out).Should(Say("install-plugin - Install CLI plugin"))
 				Eventually(session.Out).Should(Say("USAGE:"))
-				Eventually(session.Out).Should(Say("cf install-plugin PLUGIN_NAME \\[-r REPO_NAME\\] \\[-f\\]"))
-				Eventually(session.Out).Should(Say("cf install-plugin LOCAL-PATH/TO/PLUGIN | URL \\[-f\\]"))
+				Eventually(session.Out).Should(Say("cf install-plugin PLUGIN_NAME\\[@VERSION\\] \\[-r REPO_NAME\\] \\[--offline\\] \\[--checksum CHECKSUM\\] \\[-f\\]"))
+				Eventually(session.Out).Should(Say("cf install-plugin LOCAL-PATH/TO/PLUGIN \\[--checksum CHECKSUM\\] \\[-f\\]"))
+				Eventually(session.Out).Should(Say("cf install-plugin URL \\[--checksum CHECKSUM\\] \\[-f\\]"))
 				Eventually(session.Out).Should(Say("EXAMPLES:"))
 				Eventually(session.Out).Should(Say("cf install-plugin ~/Downloads/plugin-foobar"))
 				Eventually(session.Out).Should(Say("cf install-plugin https://example.com/plugin-foobar_linux_amd64"))
 				Eventually(session.Out).Should(Say("cf install-plugin -r My-Repo plugin-echo"))
+				Eventually(session.Out).Should(Say("cf install-plugin -r My-Repo plugin-echo@1.2.0"))
 				Eventually(session.Out).Should(Say("OPTIONS:"))
+				Eventually(session.Out).Should(Say("--checksum\\s+SHA-1 or SHA-256 checksum the plugin binary must match"))
 				Eventually(session.Out).Should(Say("-f\\s+Force install of plugin without confirmation"))
 				Eventually(session.Out).Should(Say("-r\\s+Restrict search for plugin to this registered repository"))
 				Eventually(session.Out).Should(Say("SEE ALSO:"))
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
@@ -29,6 +30,14 @@ type DisplayUsage interface {
 var ErrFailed = errors.New("command failed")
 var ParseErr = errors.New("incorrect type for arg")
 
+// exitCodeError is returned in place of ErrFailed when the command's error
+// maps to a specific exit code.
+type exitCodeError int
+
+func (exitCodeError) Error() string {
+	return ErrFailed.Error()
+}
+
 func main() {
 	defer panichandler.HandlePanic()
 	parse(os.Args[1:])
@@ -115,6 +124,8 @@ func parse(args []string) {
 		}
 	} else if err == ErrFailed {
 		os.Exit(1)
+	} else if code, ok := err.(exitCodeError); ok {
+		os.Exit(int(code))
 	} else if err == ParseErr {
 		fmt.Println()
 		parse([]string{"help", args[0]})
@@ -140,7 +151,10 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		NoColor:        common.Commands.NoColor,
+		Profile:        common.Commands.Profile,
+		RequestTimeout: common.Commands.RequestTimeout,
+		Verbose:        common.Commands.VerboseOrVersion,
 	})
 	if err != nil {
 		return err
@@ -162,11 +176,21 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
 
+		if common.Commands.Output == "json" {
+			commandUI.EnableJSONOutput()
+		}
+
 		err = extendedCmd.Setup(cfConfig, commandUI)
-		if err != nil {
-			return handleError(err, commandUI)
+		if err == nil {
+			err = extendedCmd.Execute(args)
+		}
+
+		// flush even when the command fails so the output is still valid JSON
+		flushErr := commandUI.FlushStructuredOutput()
+		if err == nil {
+			err = flushErr
 		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+		return handleError(err, commandUI)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
@@ -183,5 +207,9 @@ func handleError(err error, commandUI UI) error {
 		return ParseErr
 	}
 
+	if code := translatableerror.ExitCode(err); code != translatableerror.ExitCodeGeneral {
+		return exitCodeError(code)
+	}
+
 	return ErrFailed
 }
@@ -0,0 +1,42 @@
+package configv3
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// writeFileAtomically writes data to a temporary file in the same directory
+// as path and then renames it over path, so that a crash mid-write leaves
+// either the old file or the new one, never a truncated mix of the two.
+func writeFileAtomically(path string, data []byte, perm os.FileMode) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+
+	err = writeAndClose(tempFile, data, perm)
+	if err == nil {
+		err = replaceFile(tempPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
+
+func writeAndClose(file *os.File, data []byte, perm os.FileMode) error {
+	_, err := file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if err == nil {
+		err = file.Chmod(perm)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -48,7 +48,49 @@ var _ = Describe("Config", func() {
 		Entry("config=unset env=true  enabled", "", "true", ColorEnabled),
 		Entry("config=false env=unset disabled", "false", "", ColorDisabled),
 		Entry("config=true  env=unset disabled", "true", "", ColorEnabled),
-
-		Entry("config=unset env=unset falls back to default", "", "", ColorEnabled),
 	)
+
+	Context("when the config and $CF_COLOR are unset", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{}`)
+			os.Unsetenv("CF_COLOR")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("FORCE_TTY")
+		})
+
+		It("enables color when output is a TTY", func() {
+			os.Setenv("FORCE_TTY", "true")
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ColorEnabled()).To(Equal(ColorEnabled))
+		})
+
+		It("disables color when output is not a TTY", func() {
+			os.Setenv("FORCE_TTY", "false")
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ColorEnabled()).To(Equal(ColorDisabled))
+		})
+	})
+
+	Context("when the --no-color flag is passed", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{"ColorEnabled":"true"}`)
+			os.Setenv("CF_COLOR", "true")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("CF_COLOR")
+		})
+
+		It("disables color regardless of the config and environment", func() {
+			config, err := LoadConfig(FlagOverride{NoColor: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ColorEnabled()).To(Equal(ColorDisabled))
+		})
+	})
 })
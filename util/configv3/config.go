@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -64,8 +65,21 @@ const (
 //   1. CF_HOME\.cf if CF_HOME is set
 //   2. HOMEDRIVE\HOMEPATH\.cf if HOMEDRIVE or HOMEPATH is set
 //   3. USERPROFILE\.cf as the default
+//
+// When a profile other than DefaultProfile is selected (see CurrentProfile),
+// the config is read from .cf/config_<profile>.json instead of
+// .cf/config.json.
 func LoadConfig(flags ...FlagOverride) (*Config, error) {
-	filePath := ConfigFilePath()
+	var flagOverride FlagOverride
+	if len(flags) > 0 {
+		flagOverride = flags[0]
+	}
+
+	profile, err := SelectProfile(flagOverride.Profile)
+	if err != nil {
+		return nil, err
+	}
+	filePath := profileConfigFilePath(profile)
 
 	var config Config
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
@@ -103,6 +117,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		BinaryName:       filepath.Base(os.Args[0]),
 		CFColor:          os.Getenv("CF_COLOR"),
 		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
+		CFProfile:        os.Getenv("CF_PROFILE"),
 		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
 		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
 		CFTrace:          os.Getenv("CF_TRACE"),
@@ -137,9 +152,8 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
-	if len(flags) > 0 {
-		config.Flags = flags[0]
-	}
+	config.Flags = flagOverride
+	config.profile = profile
 
 	pwd, err := os.Getwd()
 	if err != nil {
@@ -169,8 +183,13 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. The file is replaced atomically, and the config's write lock is
+// held for the duration of the write so that the file is never written from a
+// partially updated config.
 func WriteConfig(c *Config) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
 	if err != nil {
 		return err
@@ -181,11 +200,16 @@ func WriteConfig(c *Config) error {
 		return err
 	}
 
-	return ioutil.WriteFile(ConfigFilePath(), rawConfig, 0600)
+	return writeFileAtomically(profileConfigFilePath(c.profile), rawConfig, 0600)
 }
 
 // Config combines the settings taken from the .cf/config.json, os.ENV, and the
 // plugin config.
+//
+// The methods on Config that read or update the .cf/config.json settings are
+// safe for concurrent use, as is WriteConfig. Reading or writing ConfigFile
+// directly bypasses this locking and is not safe while other goroutines are
+// using the same Config.
 type Config struct {
 	// ConfigFile stores the configuration from the .cf/config
 	ConfigFile CFConfig
@@ -199,7 +223,14 @@ type Config struct {
 	// detectedSettings are settings detected when the config is loaded.
 	detectedSettings detectedSettings
 
+	// profile is the name of the selected profile, or empty for the default
+	// profile.
+	profile string
+
 	pluginsConfig PluginsConfig
+
+	// lock guards ConfigFile.
+	lock sync.RWMutex
 }
 
 // CFConfig represents .cf/config.json
@@ -215,10 +246,12 @@ type CFConfig struct {
 	SSHOAuthClient           string             `json:"SSHOAuthClient"`
 	UAAOAuthClient           string             `json:"UAAOAuthClient"`
 	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string             `json:"UAAGrantType"`
 	RefreshToken             string             `json:"RefreshToken"`
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	TargetedSpace            Space              `json:"SpaceFields"`
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
+	CACertFile               string             `json:"CACertFile"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
 	Trace                    string             `json:"Trace"`
 	ColorEnabled             string             `json:"ColorEnabled"`
@@ -261,6 +294,7 @@ type EnvOverride struct {
 	CFColor          string
 	CFHome           string
 	CFPluginHome     string
+	CFProfile        string
 	CFStagingTimeout string
 	CFStartupTimeout string
 	CFTrace          string
@@ -275,7 +309,10 @@ type EnvOverride struct {
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	NoColor        bool
+	Profile        string
+	RequestTimeout int
+	Verbose        bool
 }
 
 // detectedSettings are automatically detected settings determined by the CLI.
@@ -287,6 +324,9 @@ type detectedSettings struct {
 
 // Target returns the CC API URL
 func (config *Config) Target() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.Target
 }
 
@@ -300,6 +340,9 @@ func (config *Config) PollingInterval() time.Duration {
 //   1. The config file's AsyncTimeout value (integer) is > 0
 //   2. Defaults to the DefaultOverallPollingTimeout
 func (config *Config) OverallPollingTimeout() time.Duration {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	if config.ConfigFile.AsyncTimeout == 0 {
 		return DefaultOverallPollingTimeout
 	}
@@ -309,46 +352,96 @@ func (config *Config) OverallPollingTimeout() time.Duration {
 // SkipSSLValidation returns whether or not to skip SSL validation when
 // targeting an API endpoint
 func (config *Config) SkipSSLValidation() bool {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.SkipSSLValidation
 }
 
+// CACertFile returns the path of a PEM file with CA certificates to trust in
+// addition to the system's when targeting an API endpoint
+func (config *Config) CACertFile() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
+	return config.ConfigFile.CACertFile
+}
+
 // AccessToken returns the access token for making authenticated API calls
 func (config *Config) AccessToken() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.AccessToken
 }
 
 // RefreshToken returns the refresh token for getting a new access token
 func (config *Config) RefreshToken() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.RefreshToken
 }
 
 // UAAOAuthClient returns the CLI's UAA client ID
 func (config *Config) UAAOAuthClient() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.UAAOAuthClient
 }
 
 // UAAOAuthClientSecret returns the CLI's UAA client secret
 func (config *Config) UAAOAuthClientSecret() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
-// APIVersion returns the CC API Version
+// UAAGrantType returns the grant type of the stored tokens. It is empty for
+// the password grant and "client_credentials" when the CLI authenticated as a
+// client, in which case there is no refresh token.
+func (config *Config) UAAGrantType() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
+	return config.ConfigFile.UAAGrantType
+}
+
+// APIVersion returns the CC API Version. It is captured from the Cloud
+// Controller's info endpoint when targeting an API (see SetTargetInformation)
+// and can be compared against minimum versions to decide whether a feature is
+// supported.
 func (config *Config) APIVersion() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.APIVersion
 }
 
-// MinCLIVersion returns the minimum CLI version requried by the CC
+// MinCLIVersion returns the minimum CLI version requried by the CC. Like
+// APIVersion, it is captured when targeting an API.
 func (config *Config) MinCLIVersion() string {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.MinCLIVersion
 }
 
 // TargetedOrganization returns the currently targeted organization
 func (config *Config) TargetedOrganization() Organization {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.TargetedOrganization
 }
 
 // TargetedSpace returns the currently targeted space
 func (config *Config) TargetedSpace() Space {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.TargetedSpace
 }
 
@@ -436,13 +529,16 @@ func (config *Config) Verbose() (bool, []string) {
 			envOverride = true
 		}
 	}
-	if config.ConfigFile.Trace != "" {
-		envVal, err := strconv.ParseBool(config.ConfigFile.Trace)
+	config.lock.RLock()
+	trace := config.ConfigFile.Trace
+	config.lock.RUnlock()
+	if trace != "" {
+		envVal, err := strconv.ParseBool(trace)
 		if !envOverride {
 			verbose = envVal || verbose
 		}
 		if err != nil {
-			filePath = append(filePath, config.ConfigFile.Trace)
+			filePath = append(filePath, trace)
 		}
 	}
 	verbose = config.Flags.Verbose || verbose
@@ -519,37 +615,58 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// RequestTimeout returns the maximum time each request may take, based off
+// the '--request-timeout' global flag. Zero means no timeout.
+func (config *Config) RequestTimeout() time.Duration {
+	if config.Flags.RequestTimeout > 0 {
+		return time.Duration(config.Flags.RequestTimeout) * time.Second
+	}
+
+	return 0
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
 
 // HasTargetedOrganization returns true if the organization is set
 func (config *Config) HasTargetedOrganization() bool {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.TargetedOrganization.GUID != ""
 }
 
 // HasTargetedSpace returns true if the space is set
 func (config *Config) HasTargetedSpace() bool {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+
 	return config.ConfigFile.TargetedSpace.GUID != ""
 }
 
 // SetOrganizationInformation sets the currently targeted organization
 func (config *Config) SetOrganizationInformation(guid string, name string) {
-	config.ConfigFile.TargetedOrganization.GUID = guid
-	config.ConfigFile.TargetedOrganization.Name = name
-	config.ConfigFile.TargetedOrganization.QuotaDefinition = QuotaDefinition{}
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
+	config.setOrganizationInformation(guid, name)
 }
 
 // SetSpaceInformation sets the currently targeted space
 func (config *Config) SetSpaceInformation(guid string, name string, allowSSH bool) {
-	config.ConfigFile.TargetedSpace.GUID = guid
-	config.ConfigFile.TargetedSpace.Name = name
-	config.ConfigFile.TargetedSpace.AllowSSH = allowSSH
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
+	config.setSpaceInformation(guid, name, allowSSH)
 }
 
 // SetTargetInformation sets the currently targeted CC API and related other
 // related API URLs
 func (config *Config) SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
 	config.ConfigFile.Target = api
 	config.ConfigFile.APIVersion = apiVersion
 	config.ConfigFile.AuthorizationEndpoint = auth
@@ -559,24 +676,41 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 	config.ConfigFile.RoutingEndpoint = routing
 	config.ConfigFile.SkipSSLValidation = skipSSLValidation
 
-	config.UnsetOrganizationInformation()
-	config.UnsetSpaceInformation()
+	config.setOrganizationInformation("", "")
+	config.setSpaceInformation("", "", false)
 }
 
 // SetTokenInformation sets the current token/user information
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
 	config.ConfigFile.AccessToken = accessToken
 	config.ConfigFile.RefreshToken = refreshToken
 	config.ConfigFile.SSHOAuthClient = sshOAuthClient
 }
 
+// SetUAAGrantType sets the grant type of the stored tokens
+func (config *Config) SetUAAGrantType(grantType string) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
+	config.ConfigFile.UAAGrantType = grantType
+}
+
 // SetAccessToken sets the current access token
 func (config *Config) SetAccessToken(accessToken string) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
 	config.ConfigFile.AccessToken = accessToken
 }
 
 // SetRefreshToken sets the current refresh token
 func (config *Config) SetRefreshToken(refreshToken string) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
@@ -589,3 +723,15 @@ func (config *Config) UnsetSpaceInformation() {
 func (config *Config) UnsetOrganizationInformation() {
 	config.SetOrganizationInformation("", "")
 }
+
+func (config *Config) setOrganizationInformation(guid string, name string) {
+	config.ConfigFile.TargetedOrganization.GUID = guid
+	config.ConfigFile.TargetedOrganization.Name = name
+	config.ConfigFile.TargetedOrganization.QuotaDefinition = QuotaDefinition{}
+}
+
+func (config *Config) setSpaceInformation(guid string, name string, allowSSH bool) {
+	config.ConfigFile.TargetedSpace.GUID = guid
+	config.ConfigFile.TargetedSpace.Name = name
+	config.ConfigFile.TargetedSpace.AllowSSH = allowSSH
+}
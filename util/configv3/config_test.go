@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
@@ -59,7 +60,11 @@ var _ = Describe("Config", func() {
 			Expect(config).ToNot(BeNil())
 			Expect(config.Target()).To(Equal(DefaultTarget))
 			Expect(config.SkipSSLValidation()).To(BeFalse())
-			Expect(config.ColorEnabled()).To(Equal(ColorEnabled))
+			if config.IsTTY() {
+				Expect(config.ColorEnabled()).To(Equal(ColorEnabled))
+			} else {
+				Expect(config.ColorEnabled()).To(Equal(ColorDisabled))
+			}
 			Expect(config.PluginHome()).To(Equal(filepath.Join(homeDir, ".cf", "plugins")))
 			Expect(config.StagingTimeout()).To(Equal(DefaultStagingTimeout))
 			Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
@@ -226,6 +231,24 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("CACertFile", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				rawConfig := `{ "CACertFile":"/path/to/ca.pem" }`
+				setConfig(homeDir, rawConfig)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			It("returns fields directly from config", func() {
+				Expect(config.CACertFile()).To(Equal("/path/to/ca.pem"))
+			})
+		})
+
 		Describe("AccessToken", func() {
 			var config *Config
 
@@ -389,6 +412,20 @@ var _ = Describe("Config", func() {
 
 				Expect(config.APIVersion()).To(Equal("2.59.0"))
 			})
+
+			Context("when the api version was written by a previous invocation", func() {
+				BeforeEach(func() {
+					rawConfig := `{ "APIVersion":"2.65.0", "MinCLIVersion":"6.22.0" }`
+					setConfig(homeDir, rawConfig)
+				})
+
+				It("returns the stored versions", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.APIVersion()).To(Equal("2.65.0"))
+					Expect(config.MinCLIVersion()).To(Equal("6.22.0"))
+				})
+			})
 		})
 
 		Describe("MinCLIVersion", func() {
@@ -461,6 +498,18 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("RequestTimeout", func() {
+			It("returns the request timeout from the flag override", func() {
+				config := Config{Flags: FlagOverride{RequestTimeout: 30}}
+				Expect(config.RequestTimeout()).To(Equal(30 * time.Second))
+			})
+
+			It("returns no timeout by default", func() {
+				config := Config{}
+				Expect(config.RequestTimeout()).To(BeZero())
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -517,6 +566,42 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.Target).To(Equal(config.ConfigFile.Target))
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
+
+			It("does not leave temporary files behind", func() {
+				err := WriteConfig(config)
+				Expect(err).ToNot(HaveOccurred())
+
+				files, err := ioutil.ReadDir(filepath.Join(homeDir, ".cf"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+				Expect(files[0].Name()).To(Equal("config.json"))
+			})
+		})
+
+		Context("when a previous write was interrupted part way through", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{"ConfigVersion": 3, "Target": "original.com"}`)
+
+				// A process killed mid-write leaves a truncated temporary file
+				// next to the config.
+				err := ioutil.WriteFile(filepath.Join(homeDir, ".cf", "config.json.tmp123456"), []byte(`{"ConfigVersion": 3, "Tar`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves the original config intact", func() {
+				loadedConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loadedConfig.Target()).To(Equal("original.com"))
+			})
+
+			It("can still write a new config", func() {
+				err := WriteConfig(config)
+				Expect(err).ToNot(HaveOccurred())
+
+				loadedConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loadedConfig.Target()).To(Equal("foo.com"))
+			})
 		})
 
 		Context("when an error is encountered", func() {
@@ -533,7 +618,7 @@ var _ = Describe("Config", func() {
 
 			It("returns the error", func() {
 				err := WriteConfig(config)
-				_, ok := err.(*os.PathError)
+				_, ok := err.(*os.LinkError)
 				Expect(ok).To(BeTrue())
 			})
 		})
@@ -594,6 +679,15 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAGrantType", func() {
+			It("sets the grant type of the stored tokens", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.ConfigFile.UAAGrantType).To(Equal("client_credentials"))
+				Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+			})
+		})
+
 		Describe("SetAccessToken", func() {
 			It("sets the authentication token information", func() {
 				var config Config
@@ -660,4 +754,42 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("concurrent access", func() {
+		// Run with 'go test -race' to detect unguarded access.
+		It("allows tokens and targets to be read and written from multiple goroutines", func() {
+			config := &Config{}
+			var wg sync.WaitGroup
+
+			for i := 0; i < 10; i++ {
+				wg.Add(3)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					config.SetAccessToken(fmt.Sprintf("access-token-%d", i))
+					config.SetTokenInformation(fmt.Sprintf("access-token-%d", i), fmt.Sprintf("refresh-token-%d", i), "ssh-client")
+					config.SetTargetInformation(fmt.Sprintf("https://api-%d.com", i), "2.59.0", "auth", "", "doppler", "uaa", "routing", false)
+				}(i)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(config.AccessToken()).To(Or(BeEmpty(), HavePrefix("access-token-")))
+					Expect(config.RefreshToken()).To(Or(BeEmpty(), HavePrefix("refresh-token-")))
+					Expect(config.Target()).To(Or(BeEmpty(), HavePrefix("https://api-")))
+				}()
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(WriteConfig(config)).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var writtenCFConfig CFConfig
+			Expect(json.Unmarshal(file, &writtenCFConfig)).To(Succeed())
+		})
+	})
 })
@@ -93,3 +93,26 @@ var _ = Describe("Verbose", func() {
 		})
 	})
 })
+
+var _ = Describe("WriteConfig", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	It("replaces an existing config and keeps it readable only by the user", func() {
+		setConfig(homeDir, `{"ConfigVersion": 3, "Target": "original.com"}`)
+
+		err := WriteConfig(&Config{ConfigFile: CFConfig{ConfigVersion: 3, Target: "foo.com"}})
+		Expect(err).ToNot(HaveOccurred())
+
+		info, err := os.Stat(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+})
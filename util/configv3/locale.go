@@ -14,8 +14,12 @@ const (
 //   3. The $LANG environment variable if set
 //   4. Defaults to DefaultLocale
 func (config *Config) Locale() string {
-	if config.ConfigFile.Locale != "" {
-		return config.ConfigFile.Locale
+	config.lock.RLock()
+	locale := config.ConfigFile.Locale
+	config.lock.RUnlock()
+
+	if locale != "" {
+		return locale
 	}
 
 	if config.ENV.LCAll != "" {
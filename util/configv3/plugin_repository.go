@@ -22,6 +22,9 @@ type PluginRepository struct {
 // PluginRepositories returns the currently configured plugin repositories from the
 // .cf/config.json
 func (config *Config) PluginRepositories() []PluginRepository {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
 	repos := config.ConfigFile.PluginRepositories
 	sort.Slice(repos, func(i, j int) bool {
 		return strings.ToLower(repos[i].Name) < strings.ToLower(repos[j].Name)
@@ -31,6 +34,9 @@ func (config *Config) PluginRepositories() []PluginRepository {
 
 // does not add duplicates to the config
 func (config *Config) AddPluginRepository(name string, url string) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
+
 	config.ConfigFile.PluginRepositories = append(config.ConfigFile.PluginRepositories,
 		PluginRepository{Name: name, URL: url})
 }
@@ -3,7 +3,6 @@ package configv3
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
@@ -150,5 +149,5 @@ func (config *Config) WritePluginConfig() error {
 	}
 
 	// Write to file
-	return ioutil.WriteFile(filepath.Join(pluginFileDir, "config.json"), rawConfig, 0600)
+	return writeFileAtomically(filepath.Join(pluginFileDir, "config.json"), rawConfig, 0600)
 }
@@ -353,7 +353,7 @@ var _ = Describe("PluginsConfig", func() {
 
 				It("returns the error", func() {
 					err := config.WritePluginConfig()
-					_, ok := err.(*os.PathError)
+					_, ok := err.(*os.LinkError)
 					Expect(ok).To(BeTrue())
 				})
 			})
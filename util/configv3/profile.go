@@ -0,0 +1,86 @@
+package configv3
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultProfile is the name of the profile stored in .cf/config.json. It is
+// used when no profile is selected.
+const DefaultProfile = "default"
+
+var validProfileName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// InvalidProfileNameError is returned when a profile name contains characters
+// that cannot be used in a config file name.
+type InvalidProfileNameError struct {
+	Name string
+}
+
+func (e InvalidProfileNameError) Error() string {
+	return fmt.Sprintf("Profile name '%s' is invalid. Profile names may only contain letters, numbers, '-' and '_'.", e.Name)
+}
+
+// CurrentProfile returns the name of the profile the config was loaded from.
+// The profile is selected by:
+//   1. The '--profile' global flag
+//   2. The $CF_PROFILE environment variable if set
+//   3. Defaults to DefaultProfile
+func (config *Config) CurrentProfile() string {
+	if config.profile == "" {
+		return DefaultProfile
+	}
+	return config.profile
+}
+
+// ListProfiles returns the names of all profiles with a config file in the
+// .cf directory, sorted by name. DefaultProfile is always included.
+func ListProfiles() ([]string, error) {
+	pattern := filepath.Join(homeDirectory(), ".cf", "config_*.json")
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := []string{DefaultProfile}
+	for _, match := range matches {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(match), "config_"), ".json")
+		if validProfileName.MatchString(name) && name != DefaultProfile {
+			profiles = append(profiles, name)
+		}
+	}
+	sort.Strings(profiles[1:])
+
+	return profiles, nil
+}
+
+// SelectProfile returns the profile chosen by the '--profile' global flag
+// value, or by $CF_PROFILE when the flag is empty. It returns the empty
+// string for the default profile.
+func SelectProfile(flagProfile string) (string, error) {
+	profile := flagProfile
+	if profile == "" {
+		profile = os.Getenv("CF_PROFILE")
+	}
+	if profile == "" || profile == DefaultProfile {
+		return "", nil
+	}
+
+	if !validProfileName.MatchString(profile) {
+		return "", InvalidProfileNameError{Name: profile}
+	}
+	return profile, nil
+}
+
+// profileConfigFilePath returns the location of the config file for the
+// given profile. The default profile uses ConfigFilePath.
+func profileConfigFilePath(profile string) string {
+	if profile == "" {
+		return ConfigFilePath()
+	}
+	return filepath.Join(homeDirectory(), ".cf", fmt.Sprintf("config_%s.json", profile))
+}
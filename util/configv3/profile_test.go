@@ -0,0 +1,107 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profiles", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{"ConfigVersion": 3, "Target": "default.com"}`)
+		err := ioutil.WriteFile(filepath.Join(homeDir, ".cf", "config_staging.json"), []byte(`{"ConfigVersion": 3, "Target": "staging.com"}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+		teardown(homeDir)
+	})
+
+	Describe("LoadConfig", func() {
+		Context("when no profile is selected", func() {
+			It("loads config.json as the default profile", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CurrentProfile()).To(Equal(DefaultProfile))
+				Expect(config.Target()).To(Equal("default.com"))
+			})
+		})
+
+		Context("when the profile is selected with $CF_PROFILE", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+			})
+
+			It("loads the profile's config file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CurrentProfile()).To(Equal("staging"))
+				Expect(config.Target()).To(Equal("staging.com"))
+			})
+
+			Context("when the profile flag is also provided", func() {
+				It("prefers the flag", func() {
+					config, err := LoadConfig(FlagOverride{Profile: DefaultProfile})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.CurrentProfile()).To(Equal(DefaultProfile))
+					Expect(config.Target()).To(Equal("default.com"))
+				})
+			})
+		})
+
+		Context("when the selected profile does not have a config file yet", func() {
+			It("starts from the default settings", func() {
+				config, err := LoadConfig(FlagOverride{Profile: "prod"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CurrentProfile()).To(Equal("prod"))
+				Expect(config.Target()).To(Equal(DefaultTarget))
+			})
+		})
+
+		Context("when the profile name is invalid", func() {
+			It("returns an InvalidProfileNameError", func() {
+				_, err := LoadConfig(FlagOverride{Profile: "../prod"})
+				Expect(err).To(MatchError(InvalidProfileNameError{Name: "../prod"}))
+			})
+		})
+	})
+
+	Describe("WriteConfig", func() {
+		It("writes to the selected profile's config file only", func() {
+			config, err := LoadConfig(FlagOverride{Profile: "prod"})
+			Expect(err).ToNot(HaveOccurred())
+			config.SetTargetInformation("prod.com", "", "", "", "", "", "", false)
+			Expect(WriteConfig(config)).To(Succeed())
+
+			prodConfig, err := LoadConfig(FlagOverride{Profile: "prod"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prodConfig.Target()).To(Equal("prod.com"))
+
+			defaultConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(defaultConfig.Target()).To(Equal("default.com"))
+		})
+	})
+
+	Describe("ListProfiles", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(homeDir, ".cf", "config_dev.json"), []byte(`{}`), 0600)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the default profile followed by the other profiles in order", func() {
+			profiles, err := ListProfiles()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profiles).To(Equal([]string{DefaultProfile, "dev", "staging"}))
+		})
+	})
+})
@@ -0,0 +1,10 @@
+// +build !windows
+
+package configv3
+
+import "os"
+
+// replaceFile atomically moves src over dst.
+func replaceFile(src string, dst string) error {
+	return os.Rename(src, dst)
+}
@@ -0,0 +1,32 @@
+// +build windows
+
+package configv3
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	replaceFileAttempts = 5
+	replaceFileDelay    = 50 * time.Millisecond
+)
+
+// replaceFile moves src over dst. Windows refuses to replace a file that
+// another process (such as a virus scanner or a second cf process) has open,
+// so the rename is retried briefly before falling back to removing dst first.
+func replaceFile(src string, dst string) error {
+	var err error
+	for i := 0; i < replaceFileAttempts; i++ {
+		err = os.Rename(src, dst)
+		if err == nil {
+			return nil
+		}
+		time.Sleep(replaceFileDelay)
+	}
+
+	if removeErr := os.Remove(dst); removeErr != nil && !os.IsNotExist(removeErr) {
+		return err
+	}
+	return os.Rename(src, dst)
+}
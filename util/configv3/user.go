@@ -1,16 +1,79 @@
 package configv3
 
-import "github.com/SermoDigital/jose/jws"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
 
 // User represents the user information provided by the JWT access token
 type User struct {
 	Name string
 }
 
+// MalformedAccessTokenError is returned when the access token in
+// .cf/config.json cannot be decoded.
+type MalformedAccessTokenError struct {
+	Err error
+}
+
+func (e MalformedAccessTokenError) Error() string {
+	return fmt.Sprintf("Access token is malformed: %s", e.Err)
+}
+
+// AccessTokenExpiredError is returned when the access token in
+// .cf/config.json has expired.
+type AccessTokenExpiredError struct {
+	ExpiredAt time.Time
+}
+
+func (e AccessTokenExpiredError) Error() string {
+	return fmt.Sprintf("Access token expired at %s", e.ExpiredAt.Format(time.RFC3339))
+}
+
 // CurrentUser returns user information decoded from the JWT access token in
 // .cf/config.json
 func (config *Config) CurrentUser() (User, error) {
-	return decodeUserFromJWT(config.ConfigFile.AccessToken)
+	return decodeUserFromJWT(config.AccessToken())
+}
+
+// CurrentUserName returns the user name, or the email if the user name is
+// missing, decoded from the JWT access token in .cf/config.json. The token is
+// decoded locally; it is not validated with the UAA. An empty string is
+// returned when there is no access token.
+func (config *Config) CurrentUserName() (string, error) {
+	accessToken := config.AccessToken()
+	if accessToken == "" {
+		return "", nil
+	}
+
+	token, err := jws.ParseJWT([]byte(trimBearerPrefix(accessToken)))
+	if err != nil {
+		return "", MalformedAccessTokenError{Err: err}
+	}
+
+	claims := token.Claims()
+	if expiration, ok := claims.Expiration(); ok && !expiration.After(time.Now()) {
+		return "", AccessTokenExpiredError{ExpiredAt: expiration}
+	}
+
+	for _, claim := range []string{"user_name", "email"} {
+		if name, ok := claims.Get(claim).(string); ok && name != "" {
+			return name, nil
+		}
+	}
+
+	return "", MalformedAccessTokenError{Err: errors.New("token does not contain a user_name or email claim")}
+}
+
+func trimBearerPrefix(accessToken string) string {
+	if len(accessToken) > 7 && strings.EqualFold(accessToken[:7], "bearer ") {
+		return accessToken[7:]
+	}
+	return accessToken
 }
 
 func decodeUserFromJWT(accessToken string) (User, error) {
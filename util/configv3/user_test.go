@@ -1,12 +1,29 @@
 package configv3_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	. "code.cloudfoundry.org/cli/util/configv3"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
+func buildAccessToken(claims map[string]interface{}) string {
+	encode := func(v interface{}) string {
+		raw, err := json.Marshal(v)
+		Expect(err).ToNot(HaveOccurred())
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	return fmt.Sprintf("bearer %s.%s.c2lnbmF0dXJl",
+		encode(map[string]string{"alg": "RS256", "typ": "JWT"}),
+		encode(claims))
+}
+
 var _ = Describe("Config", func() {
 	var homeDir string
 
@@ -44,4 +61,95 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+	Describe("CurrentUserName", func() {
+		var (
+			config   Config
+			userName string
+			err      error
+		)
+
+		BeforeEach(func() {
+			config = Config{}
+		})
+
+		JustBeforeEach(func() {
+			userName, err = config.CurrentUserName()
+		})
+
+		Context("when the token contains a user_name", func() {
+			BeforeEach(func() {
+				config.ConfigFile.AccessToken = buildAccessToken(map[string]interface{}{
+					"user_name": "some-user",
+					"email":     "some-user@example.com",
+					"exp":       time.Now().Add(time.Hour).Unix(),
+				})
+			})
+
+			It("returns the user name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(userName).To(Equal("some-user"))
+			})
+		})
+
+		Context("when the token only contains an email", func() {
+			BeforeEach(func() {
+				config.ConfigFile.AccessToken = buildAccessToken(map[string]interface{}{
+					"email": "some-user@example.com",
+					"exp":   time.Now().Add(time.Hour).Unix(),
+				})
+			})
+
+			It("returns the email", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(userName).To(Equal("some-user@example.com"))
+			})
+		})
+
+		Context("when the token has no user claims", func() {
+			BeforeEach(func() {
+				config.ConfigFile.AccessToken = buildAccessToken(map[string]interface{}{
+					"client_id": "cf",
+				})
+			})
+
+			It("returns a MalformedAccessTokenError", func() {
+				Expect(err).To(BeAssignableToTypeOf(MalformedAccessTokenError{}))
+				Expect(userName).To(BeEmpty())
+			})
+		})
+
+		Context("when the token has expired", func() {
+			var expiredAt time.Time
+
+			BeforeEach(func() {
+				expiredAt = time.Unix(time.Now().Add(-time.Hour).Unix(), 0)
+				config.ConfigFile.AccessToken = buildAccessToken(map[string]interface{}{
+					"user_name": "some-user",
+					"exp":       expiredAt.Unix(),
+				})
+			})
+
+			It("returns an AccessTokenExpiredError", func() {
+				Expect(err).To(MatchError(AccessTokenExpiredError{ExpiredAt: expiredAt}))
+				Expect(userName).To(BeEmpty())
+			})
+		})
+
+		Context("when the token is malformed", func() {
+			BeforeEach(func() {
+				config.ConfigFile.AccessToken = "bearer not-a-jwt"
+			})
+
+			It("returns a MalformedAccessTokenError", func() {
+				Expect(err).To(BeAssignableToTypeOf(MalformedAccessTokenError{}))
+			})
+		})
+
+		Context("when there is no token", func() {
+			It("returns an empty user name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(userName).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,212 @@
+// Package manifestparser substitutes ((variable)) placeholders in application
+// manifests.
+package manifestparser
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var variableRegexp = regexp.MustCompile(`\(\(([-/\.\w]+)\)\)`)
+
+// UnresolvedVariablesError is returned when a manifest contains placeholders
+// that have no value.
+type UnresolvedVariablesError struct {
+	Names []string
+}
+
+func (e UnresolvedVariablesError) Error() string {
+	return fmt.Sprintf("Expected to find variables: %s", strings.Join(e.Names, ", "))
+}
+
+// InvalidVariableTypeError is returned when a placeholder that is part of a
+// larger string refers to a map or list, which cannot be embedded in a string.
+type InvalidVariableTypeError struct {
+	Name string
+}
+
+func (e InvalidVariableTypeError) Error() string {
+	return fmt.Sprintf("Variable '%s' is not a string, number or boolean and cannot be embedded in a string", e.Name)
+}
+
+// InvalidVarFlagError is returned when a --var flag is not in the key=value
+// format.
+type InvalidVarFlagError struct {
+	Var string
+}
+
+func (e InvalidVarFlagError) Error() string {
+	return fmt.Sprintf("Variable '%s' must be in the form key=value", e.Var)
+}
+
+// InterpolateVariables replaces the ((name)) placeholders in the raw YAML
+// manifest with their values from vars. A placeholder that makes up a whole
+// value is replaced with the variable's value as is, so numbers, booleans,
+// maps and lists keep their type; a placeholder inside a larger string is
+// replaced with the value's string form. Nested values can be referenced
+// with dots, e.g. ((database.host)). All placeholders without a value are
+// reported in a single UnresolvedVariablesError.
+func InterpolateVariables(raw []byte, vars map[string]interface{}) ([]byte, error) {
+	var document interface{}
+	err := yaml.Unmarshal(raw, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	interpolator := interpolator{vars: vars, missing: map[string]bool{}}
+	document, err = interpolator.interpolate(document)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(interpolator.missing) > 0 {
+		var names []string
+		for name := range interpolator.missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, UnresolvedVariablesError{Names: names}
+	}
+
+	return yaml.Marshal(document)
+}
+
+// ReadVars merges the variables from the YAML vars files and the key=value
+// var flags. Later files take precedence over earlier ones and the flags take
+// precedence over the files. Flag values are parsed as YAML, so numbers and
+// booleans keep their type.
+func ReadVars(varsFiles []string, varFlags []string) (map[string]interface{}, error) {
+	vars := map[string]interface{}{}
+
+	for _, path := range varsFiles {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var fileVars map[string]interface{}
+		err = yaml.Unmarshal(raw, &fileVars)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, value := range fileVars {
+			vars[name] = value
+		}
+	}
+
+	for _, varFlag := range varFlags {
+		parts := strings.SplitN(varFlag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, InvalidVarFlagError{Var: varFlag}
+		}
+
+		var value interface{}
+		err := yaml.Unmarshal([]byte(parts[1]), &value)
+		if err != nil || value == nil {
+			value = parts[1]
+		}
+		vars[parts[0]] = value
+	}
+
+	return vars, nil
+}
+
+type interpolator struct {
+	vars    map[string]interface{}
+	missing map[string]bool
+}
+
+func (i interpolator) interpolate(node interface{}) (interface{}, error) {
+	switch typedNode := node.(type) {
+	case map[interface{}]interface{}:
+		for key, value := range typedNode {
+			interpolated, err := i.interpolate(value)
+			if err != nil {
+				return nil, err
+			}
+			typedNode[key] = interpolated
+		}
+		return typedNode, nil
+	case []interface{}:
+		for index, value := range typedNode {
+			interpolated, err := i.interpolate(value)
+			if err != nil {
+				return nil, err
+			}
+			typedNode[index] = interpolated
+		}
+		return typedNode, nil
+	case string:
+		return i.interpolateString(typedNode)
+	default:
+		return node, nil
+	}
+}
+
+func (i interpolator) interpolateString(str string) (interface{}, error) {
+	if match := variableRegexp.FindStringSubmatch(str); match != nil && match[0] == str {
+		value, found := i.lookup(match[1])
+		if !found {
+			i.missing[match[1]] = true
+			return str, nil
+		}
+		return value, nil
+	}
+
+	var typeErr error
+	interpolated := variableRegexp.ReplaceAllStringFunc(str, func(placeholder string) string {
+		name := variableRegexp.FindStringSubmatch(placeholder)[1]
+		value, found := i.lookup(name)
+		if !found {
+			i.missing[name] = true
+			return placeholder
+		}
+
+		switch value.(type) {
+		case map[interface{}]interface{}, map[string]interface{}, []interface{}:
+			if typeErr == nil {
+				typeErr = InvalidVariableTypeError{Name: name}
+			}
+			return placeholder
+		}
+		return fmt.Sprint(value)
+	})
+
+	return interpolated, typeErr
+}
+
+// lookup returns the value of the named variable, following dots into nested
+// maps when there is no variable with the full name.
+func (i interpolator) lookup(name string) (interface{}, bool) {
+	if value, found := i.vars[name]; found {
+		return value, true
+	}
+
+	parts := strings.Split(name, ".")
+	value, found := i.vars[parts[0]]
+	if !found {
+		return nil, false
+	}
+
+	for _, part := range parts[1:] {
+		switch typedValue := value.(type) {
+		case map[interface{}]interface{}:
+			value, found = typedValue[part]
+		case map[string]interface{}:
+			value, found = typedValue[part]
+		default:
+			found = false
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
@@ -0,0 +1,189 @@
+package manifestparser_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Interpolate", func() {
+	Describe("InterpolateVariables", func() {
+		var (
+			raw          []byte
+			vars         map[string]interface{}
+			interpolated map[string]interface{}
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			vars = map[string]interface{}{
+				"app-name":  "some-app",
+				"instances": 3,
+				"enabled":   true,
+				"env":       "production",
+				"routes": []interface{}{
+					map[interface{}]interface{}{"route": "some-app.example.com"},
+				},
+				"database": map[interface{}]interface{}{
+					"host": "db.example.com",
+					"port": 5432,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			var output []byte
+			output, executeErr = InterpolateVariables(raw, vars)
+			interpolated = nil
+			if executeErr == nil {
+				Expect(yaml.Unmarshal(output, &interpolated)).To(Succeed())
+			}
+		})
+
+		Context("when every placeholder has a value", func() {
+			BeforeEach(func() {
+				raw = []byte(`---
+applications:
+- name: ((app-name))
+  instances: ((instances))
+  routes: ((routes))
+  env:
+    ENABLED: ((enabled))
+    DATABASE_PORT: ((database.port))
+    DATABASE_URL: postgres://((database.host)):((database.port))/((env))
+`)
+			})
+
+			It("substitutes the values and preserves their types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(interpolated).To(Equal(map[string]interface{}{
+					"applications": []interface{}{
+						map[interface{}]interface{}{
+							"name":      "some-app",
+							"instances": 3,
+							"routes": []interface{}{
+								map[interface{}]interface{}{"route": "some-app.example.com"},
+							},
+							"env": map[interface{}]interface{}{
+								"ENABLED":       true,
+								"DATABASE_PORT": 5432,
+								"DATABASE_URL":  "postgres://db.example.com:5432/production",
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when placeholders have no value", func() {
+			BeforeEach(func() {
+				raw = []byte(`---
+applications:
+- name: ((app-name))
+  memory: ((memory))
+  env:
+    URL: http://((host))/((database.name))
+`)
+			})
+
+			It("returns an UnresolvedVariablesError listing every missing variable", func() {
+				Expect(executeErr).To(MatchError(UnresolvedVariablesError{
+					Names: []string{"database.name", "host", "memory"},
+				}))
+			})
+		})
+
+		Context("when a map is embedded in a string", func() {
+			BeforeEach(func() {
+				raw = []byte("command: start ((database))\n")
+			})
+
+			It("returns an InvalidVariableTypeError", func() {
+				Expect(executeErr).To(MatchError(InvalidVariableTypeError{Name: "database"}))
+			})
+		})
+
+		Context("when the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				raw = []byte("applications: [")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ReadVars", func() {
+		var (
+			tempDir    string
+			varsFiles  []string
+			varFlags   []string
+			vars       map[string]interface{}
+			executeErr error
+		)
+
+		writeVarsFile := func(name string, contents string) string {
+			path := filepath.Join(tempDir, name)
+			Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+			return path
+		}
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "read-vars")
+			Expect(err).ToNot(HaveOccurred())
+
+			varsFiles = []string{
+				writeVarsFile("base.yml", "instances: 1\nenv: staging\ndatabase:\n  port: 5432\n"),
+				writeVarsFile("override.yml", "env: production\n"),
+			}
+			varFlags = []string{"instances=4", "name=some-app", "url=http://a.com/?b=c"}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			vars, executeErr = ReadVars(varsFiles, varFlags)
+		})
+
+		It("merges the files and flags, with flags taking precedence", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(map[string]interface{}{
+				"instances": 4,
+				"env":       "production",
+				"name":      "some-app",
+				"url":       "http://a.com/?b=c",
+				"database":  map[interface{}]interface{}{"port": 5432},
+			}))
+		})
+
+		Context("when a flag is not in the key=value form", func() {
+			BeforeEach(func() {
+				varFlags = []string{"no-equals-sign"}
+			})
+
+			It("returns an InvalidVarFlagError", func() {
+				Expect(executeErr).To(MatchError(InvalidVarFlagError{Var: "no-equals-sign"}))
+			})
+		})
+
+		Context("when a vars file does not exist", func() {
+			BeforeEach(func() {
+				varsFiles = []string{filepath.Join(tempDir, "missing.yml")}
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+	})
+})
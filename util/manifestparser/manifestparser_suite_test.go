@@ -0,0 +1,13 @@
+package manifestparser_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestManifestParser(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Manifest Parser Suite")
+}
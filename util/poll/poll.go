@@ -0,0 +1,140 @@
+// Package poll repeatedly calls a function, backing off exponentially with
+// jitter between calls, until it reports that it is done or a deadline
+// passes.
+package poll
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const (
+	// DefaultMaxInterval is the longest wait between calls used by New.
+	DefaultMaxInterval = 30 * time.Second
+
+	// DefaultMultiplier is the factor the wait grows by after each call.
+	DefaultMultiplier = 1.5
+
+	// DefaultJitter is the fraction by which each wait is randomly shortened
+	// or lengthened.
+	DefaultJitter = 0.2
+)
+
+// TimeoutError is returned by Poll when Timeout passes before the polled
+// function reports that it is done.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("polling timed out after %s", e.Timeout)
+}
+
+// Poller calls a function until it reports that it is done. The wait between
+// calls starts at InitialInterval and is multiplied by Multiplier after each
+// call, up to MaxInterval. Each wait is randomly changed by up to Jitter
+// (a fraction of the wait) so that many clients do not poll in lockstep.
+type Poller struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	Jitter          float64
+
+	// Timeout is the overall time allowed for polling. A zero Timeout polls
+	// until the function is done or errors.
+	Timeout time.Duration
+
+	// Now, Sleep and Random default to time.Now, time.Sleep and
+	// rand.Float64. They can be replaced in tests.
+	Now    func() time.Time
+	Sleep  func(time.Duration)
+	Random func() float64
+}
+
+// New returns a Poller that starts by waiting interval between calls and
+// backs off to DefaultMaxInterval (or interval, if it is longer) until
+// timeout passes.
+func New(interval time.Duration, timeout time.Duration) Poller {
+	maxInterval := DefaultMaxInterval
+	if interval > maxInterval {
+		maxInterval = interval
+	}
+
+	return Poller{
+		InitialInterval: interval,
+		MaxInterval:     maxInterval,
+		Multiplier:      DefaultMultiplier,
+		Jitter:          DefaultJitter,
+		Timeout:         timeout,
+	}
+}
+
+// Poll calls done until it returns true or an error. A TimeoutError is
+// returned when Timeout passes first; done is not called once the deadline
+// has passed.
+func (p Poller) Poll(done func() (bool, error)) error {
+	now, sleep := p.Now, p.Sleep
+	if now == nil {
+		now = time.Now
+	}
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	startTime := now()
+	interval := p.InitialInterval
+
+	for {
+		if p.Timeout > 0 && now().Sub(startTime) >= p.Timeout {
+			return TimeoutError{Timeout: p.Timeout}
+		}
+
+		finished, err := done()
+		if err != nil || finished {
+			return err
+		}
+
+		wait := p.jitter(interval)
+		if p.MaxInterval > 0 && wait > p.MaxInterval {
+			wait = p.MaxInterval
+		}
+		if p.Timeout > 0 {
+			remaining := p.Timeout - now().Sub(startTime)
+			if remaining <= 0 {
+				return TimeoutError{Timeout: p.Timeout}
+			}
+			if wait > remaining {
+				wait = remaining
+			}
+		}
+
+		sleep(wait)
+		interval = p.nextInterval(interval)
+	}
+}
+
+func (p Poller) nextInterval(interval time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		interval = time.Duration(float64(interval) * p.Multiplier)
+	}
+	if p.MaxInterval > 0 && interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
+
+func (p Poller) jitter(interval time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return interval
+	}
+
+	random := p.Random
+	if random == nil {
+		random = rand.Float64
+	}
+
+	// Scale by a factor in [1-Jitter, 1+Jitter).
+	factor := 1 + p.Jitter*(2*random()-1)
+	return time.Duration(float64(interval) * factor)
+}
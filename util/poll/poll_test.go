@@ -0,0 +1,150 @@
+package poll_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/poll"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Poller", func() {
+	Describe("Poll", func() {
+		var (
+			poller  Poller
+			now     time.Time
+			sleeps  []time.Duration
+			calls   int
+			doneAt  int
+			failErr error
+			pollErr error
+		)
+
+		BeforeEach(func() {
+			now = time.Unix(0, 0)
+			sleeps = nil
+			calls = 0
+			doneAt = 0
+			failErr = nil
+
+			poller = Poller{
+				InitialInterval: time.Second,
+				MaxInterval:     10 * time.Second,
+				Multiplier:      2,
+				Now:             func() time.Time { return now },
+				Sleep: func(d time.Duration) {
+					sleeps = append(sleeps, d)
+					now = now.Add(d)
+				},
+				Random: func() float64 { return 0.5 },
+			}
+		})
+
+		JustBeforeEach(func() {
+			pollErr = poller.Poll(func() (bool, error) {
+				calls++
+				return calls == doneAt, failErr
+			})
+		})
+
+		Context("when the function finishes", func() {
+			BeforeEach(func() {
+				doneAt = 7
+			})
+
+			It("backs off exponentially up to the max interval", func() {
+				Expect(pollErr).ToNot(HaveOccurred())
+				Expect(calls).To(Equal(7))
+				Expect(sleeps).To(Equal([]time.Duration{
+					time.Second,
+					2 * time.Second,
+					4 * time.Second,
+					8 * time.Second,
+					10 * time.Second,
+					10 * time.Second,
+				}))
+			})
+		})
+
+		Context("when jitter is set", func() {
+			BeforeEach(func() {
+				doneAt = 4
+				poller.Jitter = 0.2
+				randoms := []float64{0, 1, 0.75}
+				poller.Random = func() float64 {
+					r := randoms[0]
+					randoms = randoms[1:]
+					return r
+				}
+			})
+
+			It("randomly shortens or lengthens each wait by up to the jitter", func() {
+				Expect(pollErr).ToNot(HaveOccurred())
+				Expect(sleeps).To(Equal([]time.Duration{
+					800 * time.Millisecond,
+					2400 * time.Millisecond,
+					4400 * time.Millisecond,
+				}))
+			})
+		})
+
+		Context("when the jittered wait exceeds the max interval", func() {
+			BeforeEach(func() {
+				doneAt = 7
+				poller.Jitter = 0.5
+				poller.Random = func() float64 { return 1 }
+			})
+
+			It("never waits longer than the max interval", func() {
+				Expect(sleeps[len(sleeps)-1]).To(Equal(10 * time.Second))
+			})
+		})
+
+		Context("when the timeout passes", func() {
+			BeforeEach(func() {
+				poller.Timeout = 10 * time.Second
+			})
+
+			It("stops at the deadline and returns a TimeoutError", func() {
+				Expect(pollErr).To(MatchError(TimeoutError{Timeout: 10 * time.Second}))
+				Expect(sleeps).To(Equal([]time.Duration{
+					time.Second,
+					2 * time.Second,
+					4 * time.Second,
+					3 * time.Second,
+				}))
+				Expect(now).To(Equal(time.Unix(10, 0)))
+				Expect(calls).To(Equal(4))
+			})
+		})
+
+		Context("when the function errors", func() {
+			BeforeEach(func() {
+				failErr = errors.New("boom")
+				poller.Timeout = time.Minute
+			})
+
+			It("returns the error without waiting", func() {
+				Expect(pollErr).To(MatchError(failErr))
+				Expect(calls).To(Equal(1))
+				Expect(sleeps).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("New", func() {
+		It("starts at the interval and backs off to the default max interval", func() {
+			p := New(2*time.Second, time.Minute)
+			Expect(p.InitialInterval).To(Equal(2 * time.Second))
+			Expect(p.MaxInterval).To(Equal(DefaultMaxInterval))
+			Expect(p.Multiplier).To(Equal(DefaultMultiplier))
+			Expect(p.Jitter).To(Equal(DefaultJitter))
+			Expect(p.Timeout).To(Equal(time.Minute))
+		})
+
+		It("does not cap the interval below the requested interval", func() {
+			Expect(New(time.Minute, 0).MaxInterval).To(Equal(time.Minute))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+// Package resolver resolves resource GUIDs, such as those found in events and
+// logs, to human readable names.
+package resolver
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// DefaultCacheSize is the number of names a Resolver created with a size of
+// zero or less keeps.
+const DefaultCacheSize = 1000
+
+// Lookup fetches the names of the resources with the provided GUIDs, keyed by
+// GUID. GUIDs that do not match a resource are left out of the map.
+type Lookup func(guids []string) (map[string]string, error)
+
+// UnknownResourceTypeError is returned when no Lookup is registered for the
+// requested resource type.
+type UnknownResourceTypeError struct {
+	Type string
+}
+
+func (e UnknownResourceTypeError) Error() string {
+	return fmt.Sprintf("no lookup registered for resource type '%s'", e.Type)
+}
+
+// NameNotFoundError is returned when the lookup for a resource type does not
+// return a name for the requested GUID.
+type NameNotFoundError struct {
+	Type string
+	GUID string
+}
+
+func (e NameNotFoundError) Error() string {
+	return fmt.Sprintf("%s with GUID '%s' not found", e.Type, e.GUID)
+}
+
+type cacheKey struct {
+	resourceType string
+	guid         string
+}
+
+type cacheEntry struct {
+	key  cacheKey
+	name string
+}
+
+// Resolver memoizes the names of resources by type and GUID in a least
+// recently used cache of bounded size. GUIDs passed to Queue are looked up
+// together with the next cache miss of their type, so that rendering a long
+// list of events needs one lookup per type rather than one per GUID. A
+// Resolver is safe for concurrent use.
+type Resolver struct {
+	mutex   sync.Mutex
+	size    int
+	lookups map[string]Lookup
+	entries map[cacheKey]*list.Element
+	order   *list.List
+	queued  map[string]map[string]bool
+}
+
+// New returns a Resolver that keeps at most size names and uses lookups,
+// keyed by resource type, to fetch the names it does not have.
+func New(size int, lookups map[string]Lookup) *Resolver {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	return &Resolver{
+		size:    size,
+		lookups: lookups,
+		entries: map[cacheKey]*list.Element{},
+		order:   list.New(),
+		queued:  map[string]map[string]bool{},
+	}
+}
+
+// Queue records GUIDs that are about to be resolved, so that they are fetched
+// in the same lookup as the next cache miss of their type.
+func (resolver *Resolver) Queue(resourceType string, guids ...string) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	if resolver.queued[resourceType] == nil {
+		resolver.queued[resourceType] = map[string]bool{}
+	}
+	for _, guid := range guids {
+		if _, cached := resolver.entries[cacheKey{resourceType, guid}]; !cached {
+			resolver.queued[resourceType][guid] = true
+		}
+	}
+}
+
+// Resolve returns the name of the resource of the provided type and GUID.
+func (resolver *Resolver) Resolve(resourceType string, guid string) (string, error) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	key := cacheKey{resourceType, guid}
+	if element, ok := resolver.entries[key]; ok {
+		resolver.order.MoveToFront(element)
+		return element.Value.(*cacheEntry).name, nil
+	}
+
+	lookup, ok := resolver.lookups[resourceType]
+	if !ok {
+		return "", UnknownResourceTypeError{Type: resourceType}
+	}
+
+	guids := []string{guid}
+	for queuedGUID := range resolver.queued[resourceType] {
+		if queuedGUID != guid {
+			guids = append(guids, queuedGUID)
+		}
+	}
+	delete(resolver.queued, resourceType)
+
+	names, err := lookup(guids)
+	if err != nil {
+		return "", err
+	}
+
+	for _, lookedUpGUID := range guids {
+		if name, found := names[lookedUpGUID]; found {
+			resolver.add(cacheKey{resourceType, lookedUpGUID}, name)
+		}
+	}
+
+	name, found := names[guid]
+	if !found {
+		return "", NameNotFoundError{Type: resourceType, GUID: guid}
+	}
+	return name, nil
+}
+
+// add caches name, evicting the least recently used names when the cache is
+// full.
+func (resolver *Resolver) add(key cacheKey, name string) {
+	if element, ok := resolver.entries[key]; ok {
+		element.Value.(*cacheEntry).name = name
+		resolver.order.MoveToFront(element)
+		return
+	}
+
+	resolver.entries[key] = resolver.order.PushFront(&cacheEntry{key: key, name: name})
+	for resolver.order.Len() > resolver.size {
+		oldest := resolver.order.Back()
+		resolver.order.Remove(oldest)
+		delete(resolver.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
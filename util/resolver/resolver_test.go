@@ -0,0 +1,164 @@
+package resolver_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/util/resolver"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resolver", func() {
+	var (
+		resolver     *Resolver
+		size         int
+		spaceNames   map[string]string
+		lookedUp     [][]string
+		lookupErr    error
+		spaceLookup  Lookup
+		orgLookupHit int
+	)
+
+	BeforeEach(func() {
+		size = 10
+		spaceNames = map[string]string{
+			"space-guid-1": "space-1",
+			"space-guid-2": "space-2",
+			"space-guid-3": "space-3",
+		}
+		lookedUp = nil
+		lookupErr = nil
+		orgLookupHit = 0
+
+		spaceLookup = func(guids []string) (map[string]string, error) {
+			lookedUp = append(lookedUp, guids)
+			if lookupErr != nil {
+				return nil, lookupErr
+			}
+			names := map[string]string{}
+			for _, guid := range guids {
+				if name, ok := spaceNames[guid]; ok {
+					names[guid] = name
+				}
+			}
+			return names, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		resolver = New(size, map[string]Lookup{
+			"space": spaceLookup,
+			"organization": func(guids []string) (map[string]string, error) {
+				orgLookupHit++
+				return map[string]string{"space-guid-1": "some-org"}, nil
+			},
+		})
+	})
+
+	It("resolves a GUID and memoizes the name", func() {
+		name, err := resolver.Resolve("space", "space-guid-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("space-1"))
+
+		name, err = resolver.Resolve("space", "space-guid-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("space-1"))
+
+		Expect(lookedUp).To(Equal([][]string{{"space-guid-1"}}))
+	})
+
+	It("caches names separately per type", func() {
+		name, err := resolver.Resolve("organization", "space-guid-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("some-org"))
+
+		name, err = resolver.Resolve("space", "space-guid-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("space-1"))
+
+		Expect(orgLookupHit).To(Equal(1))
+		Expect(lookedUp).To(HaveLen(1))
+	})
+
+	It("batches queued GUIDs into the next lookup of their type", func() {
+		resolver.Queue("space", "space-guid-1", "space-guid-2", "space-guid-3")
+
+		for _, guid := range []string{"space-guid-1", "space-guid-2", "space-guid-3"} {
+			_, err := resolver.Resolve("space", guid)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(lookedUp).To(HaveLen(1))
+		Expect(lookedUp[0]).To(ConsistOf("space-guid-1", "space-guid-2", "space-guid-3"))
+		Expect(lookedUp[0][0]).To(Equal("space-guid-1"))
+	})
+
+	It("does not queue GUIDs that are already cached", func() {
+		_, err := resolver.Resolve("space", "space-guid-1")
+		Expect(err).ToNot(HaveOccurred())
+
+		resolver.Queue("space", "space-guid-1", "space-guid-2")
+		_, err = resolver.Resolve("space", "space-guid-3")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(lookedUp).To(HaveLen(2))
+		Expect(lookedUp[1]).To(ConsistOf("space-guid-3", "space-guid-2"))
+	})
+
+	Context("when the cache is full", func() {
+		BeforeEach(func() {
+			size = 2
+		})
+
+		It("evicts the least recently used name", func() {
+			_, err := resolver.Resolve("space", "space-guid-1")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = resolver.Resolve("space", "space-guid-2")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = resolver.Resolve("space", "space-guid-1")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = resolver.Resolve("space", "space-guid-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(HaveLen(3))
+
+			_, err = resolver.Resolve("space", "space-guid-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(HaveLen(3))
+
+			_, err = resolver.Resolve("space", "space-guid-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(HaveLen(4))
+		})
+	})
+
+	Context("when the GUID does not match a resource", func() {
+		It("returns a NameNotFoundError", func() {
+			_, err := resolver.Resolve("space", "bogus-guid")
+			Expect(err).To(MatchError(NameNotFoundError{Type: "space", GUID: "bogus-guid"}))
+		})
+	})
+
+	Context("when the type has no lookup", func() {
+		It("returns an UnknownResourceTypeError", func() {
+			_, err := resolver.Resolve("user", "some-user-guid")
+			Expect(err).To(MatchError(UnknownResourceTypeError{Type: "user"}))
+		})
+	})
+
+	Context("when the lookup fails", func() {
+		BeforeEach(func() {
+			lookupErr = errors.New("lookup failed")
+		})
+
+		It("returns the error and does not cache anything", func() {
+			_, err := resolver.Resolve("space", "space-guid-1")
+			Expect(err).To(MatchError("lookup failed"))
+
+			lookupErr = nil
+			name, err := resolver.Resolve("space", "space-guid-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("space-1"))
+		})
+	})
+})
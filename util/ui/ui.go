@@ -78,6 +78,11 @@ type UI struct {
 	TerminalWidth int
 
 	TimezoneLocation *time.Location
+
+	// jsonOut is where structured output is flushed to. It is nil unless JSON
+	// output has been enabled.
+	jsonOut          io.Writer
+	structuredOutput map[string]interface{}
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -135,6 +140,10 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
 	interactivePrompt.Input = ui.In
 	interactivePrompt.Output = ui.Out
+	if ui.jsonOut != nil {
+		// keep the prompt visible without corrupting the JSON on ui.Out
+		interactivePrompt.Output = ui.Err
+	}
 	err := interactivePrompt.Resolve(&response)
 	return response, err
 }
@@ -157,6 +166,15 @@ func (ui *UI) DisplayError(err error) {
 	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
+// DisplayGUID outputs the GUID to ui.Out on its own line. The GUID is not
+// translated or colored so that it can be consumed by scripts.
+func (ui *UI) DisplayGUID(guid string) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Out, "%s\n", guid)
+}
+
 // DisplayHeader translates the header, bolds and adds the default color to the
 // header, and outputs the result to ui.Out.
 func (ui *UI) DisplayHeader(text string) {
@@ -268,10 +286,18 @@ func (ui *UI) DisplayOK() {
 	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
 }
 
+// DisplayTableWithHeader outputs a matrix of strings as a table to UI.Out,
+// bolding the first row as the header. When UI.Out is a TTY narrower than the
+// table, the widest columns are narrowed and their overly long cells are
+// truncated with an ellipsis; header cells are never truncated. Output that is
+// not a TTY is displayed at full width.
 func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {
 	if len(table) == 0 {
 		return
 	}
+	if ui.IsTTY && ui.TerminalWidth > 0 {
+		table = truncateTable(table, ui.TerminalWidth-wordSize(prefix), padding)
+	}
 	for i, str := range table[0] {
 		table[0][i] = ui.modifyColor(str, color.New(color.Bold))
 	}
@@ -329,6 +355,20 @@ func (ui *UI) DisplayWarnings(warnings []string) {
 	}
 }
 
+// DisplayWarningsDeduplicated translates the warnings and outputs each unique
+// warning to ui.Err once, in the order they were first seen. It is used for
+// listings, whose paged requests often return the same warning many times.
+func (ui *UI) DisplayWarningsDeduplicated(warnings []string) {
+	seen := map[string]bool{}
+	for _, warning := range warnings {
+		if seen[warning] {
+			continue
+		}
+		seen[warning] = true
+		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(warning))
+	}
+}
+
 // RequestLoggerFileWriter returns a RequestLoggerFileWriter that cannot
 // overwrite another RequestLoggerFileWriter.
 func (ui *UI) RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWriter {
@@ -448,6 +488,64 @@ func sum(intSlice []int) int {
 	return sum
 }
 
+// minTruncatedColumnWidth is the narrowest a column is shrunk to when a table
+// is truncated, unless its header is narrower.
+const minTruncatedColumnWidth = 4
+
+// truncateTable returns a copy of table whose rows fit in width, shrinking the
+// widest columns first and truncating their cells with an ellipsis. Columns
+// are never narrower than their header or minTruncatedColumnWidth, so the
+// table may still be wider than width.
+func truncateTable(table [][]string, width int, padding int) [][]string {
+	columns := len(table[0])
+
+	columnWidths := make([]int, columns)
+	minWidths := make([]int, columns)
+	for col := 0; col < columns; col++ {
+		for _, row := range table {
+			if cellWidth := wordSize(row[col]); columnWidths[col] < cellWidth {
+				columnWidths[col] = cellWidth
+			}
+		}
+
+		minWidths[col] = wordSize(table[0][col])
+		if minWidths[col] < minTruncatedColumnWidth {
+			minWidths[col] = minTruncatedColumnWidth
+		}
+		if minWidths[col] > columnWidths[col] {
+			minWidths[col] = columnWidths[col]
+		}
+	}
+
+	total := sum(columnWidths) + padding*(columns-1)
+	for total > width {
+		widest := -1
+		for col := 0; col < columns; col++ {
+			if columnWidths[col] > minWidths[col] && (widest == -1 || columnWidths[col] > columnWidths[widest]) {
+				widest = col
+			}
+		}
+		if widest == -1 {
+			break
+		}
+		columnWidths[widest]--
+		total--
+	}
+
+	truncated := [][]string{table[0]}
+	for _, row := range table[1:] {
+		var truncatedRow []string
+		for col, cell := range row {
+			if wordSize(cell) > columnWidths[col] {
+				cell = strings.TrimRight(runewidth.Truncate(vtclean.Clean(cell, false), columnWidths[col]-1, ""), " ") + "…"
+			}
+			truncatedRow = append(truncatedRow, cell)
+		}
+		truncated = append(truncated, truncatedRow)
+	}
+	return truncated
+}
+
 func wordSize(str string) int {
 	cleanStr := vtclean.Clean(str, false)
 	return runewidth.StringWidth(cleanStr)
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// EnableJSONOutput suppresses all human readable output written to ui.Out.
+// Instead, values passed to DisplayStructuredOutput are buffered and written
+// to the original ui.Out as a single JSON object by FlushStructuredOutput.
+func (ui *UI) EnableJSONOutput() {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if ui.jsonOut != nil {
+		return
+	}
+
+	ui.jsonOut = ui.Out
+	ui.Out = ioutil.Discard
+	ui.structuredOutput = map[string]interface{}{}
+}
+
+// DisplayStructuredOutput buffers value under key. It is a no-op unless JSON
+// output has been enabled.
+func (ui *UI) DisplayStructuredOutput(key string, value interface{}) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if ui.jsonOut == nil {
+		return
+	}
+
+	ui.structuredOutput[key] = value
+}
+
+// FlushStructuredOutput writes the buffered structured output as a single
+// JSON object and clears the buffer. It is a no-op unless JSON output has been
+// enabled. It is safe to call after a command fails, in which case the JSON
+// contains whatever was buffered before the failure.
+func (ui *UI) FlushStructuredOutput() error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if ui.jsonOut == nil {
+		return nil
+	}
+
+	output, err := json.Marshal(ui.structuredOutput)
+	if err != nil {
+		return err
+	}
+	ui.structuredOutput = map[string]interface{}{}
+
+	_, err = fmt.Fprintf(ui.jsonOut, "%s\n", output)
+	return err
+}
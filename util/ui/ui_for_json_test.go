@@ -0,0 +1,74 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("UI JSON output", func() {
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+		errBuff    *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		errBuff = NewBuffer()
+		ui.Out = out
+		ui.Err = errBuff
+	})
+
+	Context("when JSON output is not enabled", func() {
+		It("displays text and ignores structured output", func() {
+			ui.DisplayText("some-text")
+			ui.DisplayStructuredOutput("some-key", "some-value")
+			Expect(ui.FlushStructuredOutput()).To(Succeed())
+
+			Expect(out).To(Say("some-text"))
+			Expect(out).ToNot(Say("some-key"))
+		})
+	})
+
+	Context("when JSON output is enabled", func() {
+		BeforeEach(func() {
+			ui.EnableJSONOutput()
+		})
+
+		It("suppresses text and outputs the structured output once when flushed", func() {
+			ui.DisplayText("some-text")
+			ui.DisplayOK()
+			ui.DisplayStructuredOutput("some-key", "some-value")
+			ui.DisplayStructuredOutput("some-other-key", 2)
+
+			Expect(out.Contents()).To(BeEmpty())
+
+			Expect(ui.FlushStructuredOutput()).To(Succeed())
+			Expect(out.Contents()).To(MatchJSON(`{"some-key":"some-value","some-other-key":2}`))
+		})
+
+		It("outputs an empty JSON object when nothing was buffered", func() {
+			Expect(ui.FlushStructuredOutput()).To(Succeed())
+			Expect(out.Contents()).To(MatchJSON(`{}`))
+		})
+
+		It("displays prompts on ui.Err", func() {
+			ui.In = NewBuffer()
+			_, _ = ui.DisplayBoolPrompt(false, "some-prompt")
+			Expect(errBuff).To(Say("some-prompt"))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+})
@@ -143,6 +143,13 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayGUID", func() {
+		It("displays only the guid to ui.Out", func() {
+			ui.DisplayGUID("some-{{.Guid}}-guid")
+			Expect(out.Contents()).To(Equal([]byte("some-{{.Guid}}-guid\n")))
+		})
+	})
+
 	Describe("DisplayHeader", func() {
 		It("displays the header colorized and bolded to ui.Out", func() {
 			ui.DisplayHeader("some-header")
@@ -289,6 +296,36 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Context("when color is disabled", func() {
+		BeforeEach(func() {
+			fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+			var err error
+			ui, err = NewUI(fakeConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			out = NewBuffer()
+			ui.Out = out
+		})
+
+		It("does not colorize DisplayOK", func() {
+			ui.DisplayOK()
+			Expect(out.Contents()).To(Equal([]byte("OK\n")))
+		})
+
+		It("does not colorize DisplayHeader", func() {
+			ui.DisplayHeader("some-header")
+			Expect(out.Contents()).To(Equal([]byte("some-header\n")))
+		})
+
+		It("does not colorize DisplayTextWithFlavor", func() {
+			ui.DisplayTextWithFlavor("template with {{.SomeMapValue}}", map[string]interface{}{
+				"SomeMapValue": "map-value",
+			})
+			Expect(out.Contents()).To(Equal([]byte("template with map-value\n")))
+		})
+	})
+
 	Describe("DisplayTableWithHeader", func() {
 		It("makes the first row bold", func() {
 			ui.DisplayTableWithHeader(" ",
@@ -302,6 +339,65 @@ var _ = Describe("UI", func() {
 			Expect(ui.Out).To(Say("\x1b\\[1mheader3\x1b\\[0m"))
 			Expect(ui.Out).To(Say("#0  data1    data2    data3"))
 		})
+
+		Context("when the output is a TTY narrower than the table", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+				ui.TerminalWidth = 30
+			})
+
+			It("truncates the widest column's long cells with an ellipsis", func() {
+				ui.DisplayTableWithHeader("",
+					[][]string{
+						{"name", "description"},
+						{"short", "a description that is far too long"},
+						{"other", "fits"},
+					},
+					2)
+				Expect(ui.Out).To(Say("\x1b\\[1mname\x1b\\[0m   \x1b\\[1mdescription\x1b\\[0m\n"))
+				Expect(ui.Out).To(Say("short  a description that is…\n"))
+				Expect(ui.Out).To(Say("other  fits\n"))
+			})
+
+			It("measures multibyte characters by their display width", func() {
+				ui.DisplayTableWithHeader("",
+					[][]string{
+						{"name", "description"},
+						{"日本語", "日本語の説明がとても長いのでこれは切り詰められます"},
+					},
+					2)
+				Expect(ui.Out).To(Say("日本語  日本語の説明がとても…\n"))
+			})
+
+			It("does not truncate the header", func() {
+				ui.TerminalWidth = 5
+				ui.DisplayTableWithHeader("",
+					[][]string{
+						{"name", "description"},
+						{"short", "a description"},
+					},
+					2)
+				Expect(ui.Out).To(Say("description"))
+				Expect(ui.Out).To(Say("sho…  a descript…\n"))
+			})
+		})
+
+		Context("when the output is not a TTY", func() {
+			BeforeEach(func() {
+				ui.IsTTY = false
+				ui.TerminalWidth = 30
+			})
+
+			It("displays the full width", func() {
+				ui.DisplayTableWithHeader("",
+					[][]string{
+						{"name", "description"},
+						{"short", "a description that is far too long"},
+					},
+					2)
+				Expect(ui.Out).To(Say("short  a description that is far too long\n"))
+			})
+		})
 	})
 
 	// Covers the happy paths, additional cases are tested in TranslateText
@@ -504,6 +600,18 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayWarningsDeduplicated", func() {
+		It("displays each unique warning once in the order first seen", func() {
+			ui.DisplayWarningsDeduplicated([]string{"warning-1", "warning-2", "warning-1", "warning-3", "warning-2", "warning-1"})
+			Expect(ui.Err).To(Say("^warning-1\nwarning-2\nwarning-3\n$"))
+		})
+
+		It("displays nothing when there are no warnings", func() {
+			ui.DisplayWarningsDeduplicated(nil)
+			Expect(ui.Err.(*Buffer).Contents()).To(BeEmpty())
+		})
+	})
+
 	Describe("RequestLoggerFileWriter", func() {
 		It("returns a RequestLoggerFileWriter with the consistent filewriting mutex", func() {
 			logger1 := ui.RequestLoggerFileWriter(nil)
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// UnlimitedUsage is displayed in place of a quota or percentage when the quota
+// is 0 or -1 (unlimited).
+const UnlimitedUsage = "unlimited"
+
+// FormatCPU formats a CPU utilization fraction as a percentage, for example
+// 0.256 is "25.6%".
+func FormatCPU(cpu float64) string {
+	return fmt.Sprintf("%.1f%%", cpu*100)
+}
+
+// FormatUsage formats bytes used out of a quota in bytes, for example
+// "512M of 1G". A quota of 0 or less is displayed as "unlimited".
+func FormatUsage(used int64, quota int64) string {
+	if quota <= 0 {
+		return fmt.Sprintf("%s of %s", formatBytes(used), UnlimitedUsage)
+	}
+	return fmt.Sprintf("%s of %s", formatBytes(used), formatBytes(quota))
+}
+
+// FormatUsagePercentage formats bytes used out of a quota in bytes as a
+// percentage, for example "50.0%". A quota of 0 or less is displayed as
+// "unlimited".
+func FormatUsagePercentage(used int64, quota int64) string {
+	if quota <= 0 {
+		return UnlimitedUsage
+	}
+	return fmt.Sprintf("%.1f%%", float64(used)/float64(quota)*100)
+}
+
+func formatBytes(bytes int64) string {
+	if bytes < 0 {
+		bytes = 0
+	}
+	return bytefmt.ByteSize(uint64(bytes))
+}
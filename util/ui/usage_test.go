@@ -0,0 +1,46 @@
+package ui_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bytefmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage formatting", func() {
+	Describe("FormatCPU", func() {
+		It("formats the fraction as a percentage with one decimal place", func() {
+			Expect(FormatCPU(0.256)).To(Equal("25.6%"))
+			Expect(FormatCPU(0)).To(Equal("0.0%"))
+		})
+	})
+
+	DescribeTable("FormatUsage",
+		func(used int64, quota int64, expected string) {
+			Expect(FormatUsage(used, quota)).To(Equal(expected))
+		},
+
+		Entry("no usage", int64(0), int64(bytefmt.GIGABYTE), "0 of 1G"),
+		Entry("bytes", int64(1023), int64(bytefmt.KILOBYTE), "1023B of 1K"),
+		Entry("kilobyte boundary", int64(bytefmt.KILOBYTE), int64(bytefmt.MEGABYTE), "1K of 1M"),
+		Entry("fractional kilobytes", int64(1536), int64(bytefmt.MEGABYTE), "1.5K of 1M"),
+		Entry("megabyte boundary", int64(bytefmt.MEGABYTE), int64(bytefmt.GIGABYTE), "1M of 1G"),
+		Entry("megabytes", int64(512*bytefmt.MEGABYTE), int64(bytefmt.GIGABYTE), "512M of 1G"),
+		Entry("gigabyte boundary", int64(bytefmt.GIGABYTE), int64(2*bytefmt.GIGABYTE), "1G of 2G"),
+		Entry("zero quota", int64(512*bytefmt.MEGABYTE), int64(0), "512M of unlimited"),
+		Entry("unlimited quota", int64(512*bytefmt.MEGABYTE), int64(-1), "512M of unlimited"),
+	)
+
+	DescribeTable("FormatUsagePercentage",
+		func(used int64, quota int64, expected string) {
+			Expect(FormatUsagePercentage(used, quota)).To(Equal(expected))
+		},
+
+		Entry("no usage", int64(0), int64(bytefmt.GIGABYTE), "0.0%"),
+		Entry("half used", int64(512*bytefmt.MEGABYTE), int64(bytefmt.GIGABYTE), "50.0%"),
+		Entry("fully used", int64(bytefmt.GIGABYTE), int64(bytefmt.GIGABYTE), "100.0%"),
+		Entry("zero quota", int64(512*bytefmt.MEGABYTE), int64(0), "unlimited"),
+		Entry("unlimited quota", int64(512*bytefmt.MEGABYTE), int64(-1), "unlimited"),
+	)
+})
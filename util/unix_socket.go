@@ -0,0 +1,96 @@
+package util
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UnixSocketScheme is the scheme of URLs for HTTP servers listening on a unix
+// domain socket, such as unix:///var/run/cc.sock.
+const UnixSocketScheme = "unix"
+
+// unixSocketHostSuffix marks hosts that encode the path of a unix socket. The
+// .invalid top level domain is reserved, so it never resolves to a real host.
+const unixSocketHostSuffix = ".unix.invalid"
+
+// IsUnixSocketScheme returns true if path is a unix:// URL.
+func IsUnixSocketScheme(path string) bool {
+	return strings.HasPrefix(strings.ToLower(path), UnixSocketScheme+"://")
+}
+
+// UnixSocketRequest rewrites a request for a unix:// URL into an http://
+// request that UnixSocketDialContext sends over the socket. The socket path
+// ends at the first path segment with a .sock extension, so
+// unix:///var/run/cc.sock/v2/info requests /v2/info from /var/run/cc.sock.
+// Requests for other schemes are left unchanged.
+func UnixSocketRequest(request *http.Request) error {
+	if !strings.EqualFold(request.URL.Scheme, UnixSocketScheme) {
+		return nil
+	}
+
+	if request.URL.Host != "" && request.URL.Host != "localhost" {
+		return fmt.Errorf("unix URL %s refers to a remote host", request.URL)
+	}
+
+	segments := strings.Split(request.URL.Path, "/")
+	for i, segment := range segments {
+		if !strings.HasSuffix(segment, ".sock") {
+			continue
+		}
+
+		socketPath := strings.Join(segments[:i+1], "/")
+		request.URL.Scheme = "http"
+		request.URL.Host = hex.EncodeToString([]byte(socketPath)) + unixSocketHostSuffix
+		request.URL.Path = "/" + strings.Join(segments[i+1:], "/")
+		request.URL.RawPath = ""
+		request.Host = "localhost"
+		return nil
+	}
+
+	return fmt.Errorf("unix URL %s does not name a .sock file", request.URL)
+}
+
+// UnixSocketDialContext wraps dial so that requests rewritten by
+// UnixSocketRequest connect to their unix socket. Other addresses are passed
+// to dial unchanged.
+func UnixSocketDialContext(dial func(ctx context.Context, network string, address string) (net.Conn, error)) func(ctx context.Context, network string, address string) (net.Conn, error) {
+	return func(ctx context.Context, network string, address string) (net.Conn, error) {
+		if socketPath, ok := unixSocketPath(address); ok {
+			return dial(ctx, "unix", socketPath)
+		}
+		return dial(ctx, network, address)
+	}
+}
+
+// UnixSocketProxy wraps proxy so that requests rewritten by UnixSocketRequest
+// are never sent through a proxy.
+func UnixSocketProxy(proxy func(*http.Request) (*url.URL, error)) func(*http.Request) (*url.URL, error) {
+	return func(request *http.Request) (*url.URL, error) {
+		if _, ok := unixSocketPath(request.URL.Host); ok {
+			return nil, nil
+		}
+		return proxy(request)
+	}
+}
+
+func unixSocketPath(address string) (string, bool) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	if !strings.HasSuffix(host, unixSocketHostSuffix) {
+		return "", false
+	}
+
+	socketPath, err := hex.DecodeString(strings.TrimSuffix(host, unixSocketHostSuffix))
+	if err != nil {
+		return "", false
+	}
+	return string(socketPath), true
+}
@@ -0,0 +1,133 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("unix sockets", func() {
+	DescribeTable("IsUnixSocketScheme",
+		func(path string, isUnixSocketScheme bool) {
+			Expect(IsUnixSocketScheme(path)).To(Equal(isUnixSocketScheme))
+		},
+
+		Entry("unix URL", "unix:///var/run/cc.sock", true),
+		Entry("uppercase unix URL", "UNIX:///var/run/cc.sock", true),
+		Entry("HTTPS URL", "https://example.com", false),
+		Entry("socket path", "/var/run/cc.sock", false),
+	)
+
+	Describe("UnixSocketRequest", func() {
+		var (
+			request *http.Request
+			err     error
+		)
+
+		newRequest := func(rawURL string) {
+			request, err = http.NewRequest(http.MethodGet, rawURL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			err = UnixSocketRequest(request)
+		}
+
+		Context("when the URL names a socket", func() {
+			BeforeEach(func() {
+				newRequest("unix:///var/run/cc.sock/v2/info?q=name:foo")
+			})
+
+			It("rewrites it to an http request for the path after the socket", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(request.URL.Scheme).To(Equal("http"))
+				Expect(request.URL.Path).To(Equal("/v2/info"))
+				Expect(request.URL.RawQuery).To(Equal("q=name:foo"))
+				Expect(request.Host).To(Equal("localhost"))
+			})
+
+			It("dials the socket for the rewritten host", func() {
+				var dialedNetwork, dialedAddress string
+				dial := UnixSocketDialContext(func(_ context.Context, network string, address string) (net.Conn, error) {
+					dialedNetwork, dialedAddress = network, address
+					return nil, errors.New("not dialing")
+				})
+
+				_, _ = dial(context.Background(), "tcp", request.URL.Host+":80")
+				Expect(dialedNetwork).To(Equal("unix"))
+				Expect(dialedAddress).To(Equal("/var/run/cc.sock"))
+			})
+
+			It("does not proxy the rewritten request", func() {
+				proxy := UnixSocketProxy(func(*http.Request) (*url.URL, error) {
+					return url.Parse("http://proxy.example.com")
+				})
+
+				proxyURL, proxyErr := proxy(request)
+				Expect(proxyErr).ToNot(HaveOccurred())
+				Expect(proxyURL).To(BeNil())
+			})
+		})
+
+		Context("when the URL is only the socket", func() {
+			BeforeEach(func() {
+				newRequest("unix:///var/run/cc.sock")
+			})
+
+			It("requests the root path", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(request.URL.Path).To(Equal("/"))
+			})
+		})
+
+		Context("when the URL does not name a .sock file", func() {
+			BeforeEach(func() {
+				newRequest("unix:///var/run/cc/v2/info")
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("unix URL unix:///var/run/cc/v2/info does not name a .sock file"))
+			})
+		})
+
+		Context("when the URL refers to a remote host", func() {
+			BeforeEach(func() {
+				newRequest("unix://example.com/var/run/cc.sock")
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("unix URL unix://example.com/var/run/cc.sock refers to a remote host"))
+			})
+		})
+
+		Context("when the URL is not a unix URL", func() {
+			BeforeEach(func() {
+				newRequest("https://example.com/v2/info")
+			})
+
+			It("leaves the request unchanged", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(request.URL.String()).To(Equal("https://example.com/v2/info"))
+			})
+		})
+	})
+
+	Describe("UnixSocketDialContext", func() {
+		It("dials other addresses unchanged", func() {
+			var dialedNetwork, dialedAddress string
+			dial := UnixSocketDialContext(func(_ context.Context, network string, address string) (net.Conn, error) {
+				dialedNetwork, dialedAddress = network, address
+				return nil, errors.New("not dialing")
+			})
+
+			_, _ = dial(context.Background(), "tcp", "example.com:443")
+			Expect(dialedNetwork).To(Equal("tcp"))
+			Expect(dialedAddress).To(Equal("example.com:443"))
+		})
+	})
+})
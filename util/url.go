@@ -1,11 +1,46 @@
 package util
 
-import "strings"
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+var windowsDrivePath = regexp.MustCompile(`^/[a-zA-Z]:`)
 
 func IsHTTPScheme(path string) bool {
 	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
 }
 
+// IsFileScheme returns true if path is a file:// URL.
+func IsFileScheme(path string) bool {
+	return strings.HasPrefix(strings.ToLower(path), "file://")
+}
+
 func IsUnsupportedURLScheme(path string) bool {
-	return strings.Contains(path, "://") && !IsHTTPScheme(path)
+	return strings.Contains(path, "://") && !IsHTTPScheme(path) && !IsFileScheme(path)
+}
+
+// FileURLToPath converts a file:// URL into a local filesystem path. Only
+// URLs without a host, or with the host "localhost", are supported. On
+// Windows, file:///C:/some/path is converted to C:\some\path.
+func FileURLToPath(fileURL string) (string, error) {
+	parsedURL, err := url.Parse(fileURL)
+	if err != nil {
+		return "", err
+	}
+
+	if parsedURL.Host != "" && parsedURL.Host != "localhost" {
+		return "", fmt.Errorf("file URL %s refers to a remote host", fileURL)
+	}
+
+	path := parsedURL.Path
+	if runtime.GOOS == "windows" && windowsDrivePath.MatchString(path) {
+		path = path[1:]
+	}
+
+	return filepath.FromSlash(path), nil
 }
@@ -33,8 +33,27 @@ var _ = Describe("util", func() {
 		Entry("proper HTTPS URL", "https://example.com", false),
 		Entry("not proper HTTP URL", "http//example.com", false),
 		Entry("proper FTP URL", "ftp://example.com", true),
+		Entry("file URL", "file:///some/path", false),
 		Entry("local file name", "some-path", false),
 		Entry("UNIX path", "/some/path", false),
 		Entry("Windows path", "C:\\some\\path", false),
 	)
+
+	DescribeTable("IsFileScheme",
+		func(path string, isFileScheme bool) {
+			Expect(IsFileScheme(path)).To(Equal(isFileScheme))
+		},
+
+		Entry("file URL", "file:///some/path", true),
+		Entry("upper case file URL", "FILE:///some/path", true),
+		Entry("proper HTTP URL", "http://example.com", false),
+		Entry("UNIX path", "/some/path", false),
+	)
+
+	Describe("FileURLToPath", func() {
+		It("returns an error for a file URL with a remote host", func() {
+			_, err := FileURLToPath("file://example.com/some/path")
+			Expect(err).To(MatchError("file URL file://example.com/some/path refers to a remote host"))
+		})
+	})
 })
@@ -0,0 +1,25 @@
+// +build !windows
+
+package util_test
+
+import (
+	. "code.cloudfoundry.org/cli/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("util", func() {
+	DescribeTable("FileURLToPath",
+		func(fileURL string, expectedPath string) {
+			path, err := FileURLToPath(fileURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal(expectedPath))
+		},
+
+		Entry("absolute path", "file:///some/path/plugin", "/some/path/plugin"),
+		Entry("localhost", "file://localhost/some/path/plugin", "/some/path/plugin"),
+		Entry("escaped characters", "file:///some%20path/plugin", "/some path/plugin"),
+	)
+})
@@ -0,0 +1,23 @@
+package util_test
+
+import (
+	. "code.cloudfoundry.org/cli/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("util", func() {
+	DescribeTable("FileURLToPath",
+		func(fileURL string, expectedPath string) {
+			path, err := FileURLToPath(fileURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal(expectedPath))
+		},
+
+		Entry("drive letter", "file:///C:/some/path/plugin.exe", `C:\some\path\plugin.exe`),
+		Entry("localhost", "file://localhost/C:/some/path/plugin.exe", `C:\some\path\plugin.exe`),
+		Entry("escaped characters", "file:///C:/some%20path/plugin.exe", `C:\some path\plugin.exe`),
+	)
+})